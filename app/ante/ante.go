@@ -3,6 +3,8 @@ package ante
 import (
 	blobante "github.com/celestiaorg/celestia-app/v3/x/blob/ante"
 	blob "github.com/celestiaorg/celestia-app/v3/x/blob/keeper"
+	"github.com/celestiaorg/celestia-app/v3/x/minfee"
+	nsregante "github.com/celestiaorg/celestia-app/v3/x/nsreg/ante"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
@@ -22,6 +24,8 @@ func NewAnteHandler(
 	channelKeeper *ibckeeper.Keeper,
 	paramKeeper paramkeeper.Keeper,
 	msgVersioningGateKeeper *MsgVersioningGateKeeper,
+	minfeeKeeper minfee.Keeper,
+	namespaceKeeper nsregante.NamespaceKeeper,
 ) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
 		// Wraps the panic with the string format of the transaction
@@ -71,6 +75,10 @@ func NewAnteHandler(
 		// available to blob data in a data square. Only applies to app version
 		// >= 2.
 		blobante.NewBlobShareDecorator(blobKeeper),
+		// Ensure that the signer of a PFB is allowed to pay for blobs in
+		// every registered namespace of the PFB. Only applies to app version
+		// > 2.
+		nsregante.NewBlobSignerDecorator(namespaceKeeper),
 		// Ensure that tx's with a MsgSubmitProposal have at least one proposal
 		// message.
 		NewGovProposalDecorator(),
@@ -78,6 +86,10 @@ func NewAnteHandler(
 		ante.NewIncrementSequenceDecorator(accountKeeper),
 		// Ensure that the tx is not a IBC packet or update message that has already been processed.
 		ibcante.NewRedundantRelayDecorator(channelKeeper),
+		// Record the shares occupied by the tx so that the network min gas
+		// price can be adjusted at the end of the block. Only applies to app
+		// version > 2.
+		minfee.NewSquareUsageDecorator(minfeeKeeper),
 	)
 }
 
@@ -5,6 +5,7 @@ import (
 	"cosmossdk.io/math"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	v1 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v1"
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
 	"github.com/celestiaorg/celestia-app/v3/x/minfee"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerror "github.com/cosmos/cosmos-sdk/types/errors"
@@ -28,6 +29,9 @@ func ValidateTxFeeWrapper(paramKeeper params.Keeper) ante.TxFeeChecker {
 // ValidateTxFee implements default fee validation logic for transactions.
 // It ensures that the provided transaction fee meets a minimum threshold for the node
 // as well as a network minimum threshold and computes the tx priority based on the gas price.
+// From app version 3 onwards fees may also be paid in the fee denoms allowlisted
+// in the minfee module, which are converted to utia using their exchange rates.
+// Coins of other denoms don't count towards the fee.
 func ValidateTxFee(ctx sdk.Context, tx sdk.Tx, paramKeeper params.Keeper) (sdk.Coins, int64, error) {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
@@ -37,6 +41,15 @@ func ValidateTxFee(ctx sdk.Context, tx sdk.Tx, paramKeeper params.Keeper) (sdk.C
 	fee := feeTx.GetFee().AmountOf(appconsts.BondDenom)
 	gas := feeTx.GetGas()
 
+	multiDenom := ctx.BlockHeader().Version.App > v2.Version
+	if multiDenom {
+		subspace, exists := paramKeeper.GetSubspace(minfee.ModuleName)
+		if !exists {
+			return nil, 0, errors.Wrap(sdkerror.ErrInvalidRequest, "minfee is not a registered subspace")
+		}
+		fee = minfee.ConvertFee(feeTx.GetFee(), minfee.GetFeeDenoms(ctx, subspace))
+	}
+
 	// Ensure that the provided fee meets a minimum threshold for the node.
 	// This is only for local mempool purposes, and thus
 	// is only ran on check tx.
@@ -73,7 +86,15 @@ func ValidateTxFee(ctx sdk.Context, tx sdk.Tx, paramKeeper params.Keeper) (sdk.C
 		}
 	}
 
-	priority := getTxPriority(feeTx.GetFee(), int64(gas))
+	var priority int64
+	if multiDenom {
+		// The priority is based on the value of the fee in utia so that it is
+		// consistent regardless of the denoms in which the fee is paid.
+		priority = getTxPriority(sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, fee)), int64(gas))
+	} else {
+		priority = getTxPriority(feeTx.GetFee(), int64(gas))
+	}
+	// The fee is deducted in the denoms in which it was paid.
 	return feeTx.GetFee(), priority, nil
 }
 
@@ -91,6 +112,7 @@ func verifyMinFee(fee math.Int, gas uint64, minGasPrice sdk.Dec, errMsg string)
 // getTxPriority returns a naive tx priority based on the amount of the smallest denomination of the gas price
 // provided in a transaction.
 // NOTE: This implementation should not be used for txs with multiple coins.
+// From app version 3 onwards it is only called with the fee converted to utia.
 func getTxPriority(fee sdk.Coins, gas int64) int64 {
 	var priority int64
 	for _, c := range fee {
@@ -159,6 +159,103 @@ func TestValidateTxFee(t *testing.T) {
 	}
 }
 
+func TestValidateTxFeeMultiDenom(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	builder := encCfg.TxConfig.NewTxBuilder()
+	err := builder.SetMsgs(banktypes.NewMsgSend(
+		testnode.RandomAddress().(sdk.AccAddress),
+		testnode.RandomAddress().(sdk.AccAddress),
+		sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 10))),
+	)
+	require.NoError(t, err)
+
+	paramsKeeper, stateStore := setUp(t)
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	feeDenoms := []minfee.FeeDenom{{Denom: ibcDenom, ExchangeRate: sdk.NewDec(2)}}
+	gasLimit := uint64(1_000_000)
+	// The network min gas price requires a fee of 2000 utia.
+	networkMinGasPrice := sdk.MustNewDecFromStr("0.002")
+
+	testCases := []struct {
+		name        string
+		fee         sdk.Coins
+		appVersion  uint64
+		expErr      bool
+		expPriority int64
+	}{
+		{
+			name:        "good tx; fee in utia",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 2000)),
+			appVersion:  3,
+			expPriority: 2000,
+		},
+		{
+			name:        "good tx; fee in allowlisted denom",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 1000)),
+			appVersion:  3,
+			expPriority: 2000,
+		},
+		{
+			name:        "good tx; fee in utia and allowlisted denom",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1000), sdk.NewInt64Coin(ibcDenom, 750)),
+			appVersion:  3,
+			expPriority: 2500,
+		},
+		{
+			name:       "bad tx; fee in allowlisted denom below required minimum",
+			fee:        sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 999)),
+			appVersion: 3,
+			expErr:     true,
+		},
+		{
+			name:        "good tx; denom that is not allowlisted doesn't count",
+			fee:         sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 2000), sdk.NewInt64Coin("ibc/unknown", 1)),
+			appVersion:  3,
+			expPriority: 2000,
+		},
+		{
+			name:       "bad tx; fee only in denom that is not allowlisted",
+			fee:        sdk.NewCoins(sdk.NewInt64Coin("ibc/unknown", 2000)),
+			appVersion: 3,
+			expErr:     true,
+		},
+		{
+			name:       "bad tx; allowlisted denom is not accepted in v2",
+			fee:        sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 1000)),
+			appVersion: 2,
+			expErr:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder.SetGasLimit(gasLimit)
+			builder.SetFeeAmount(tc.fee)
+			tx := builder.GetTx()
+
+			ctx := sdk.NewContext(stateStore, tmproto.Header{
+				Version: version.Consensus{
+					App: tc.appVersion,
+				},
+			}, false, nil)
+
+			subspace, _ := paramsKeeper.GetSubspace(minfee.ModuleName)
+			subspace = minfee.RegisterMinFeeParamTable(subspace)
+			subspace.Set(ctx, minfee.KeyNetworkMinGasPrice, networkMinGasPrice)
+			subspace.Set(ctx, minfee.KeyFeeDenoms, feeDenoms)
+
+			fee, priority, err := ante.ValidateTxFee(ctx, tx, paramsKeeper)
+			if tc.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.fee, fee)
+			require.Equal(t, tc.expPriority, priority)
+		})
+	}
+}
+
 func setUp(t *testing.T) (paramkeeper.Keeper, storetypes.CommitMultiStore) {
 	storeKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
 	tStoreKey := storetypes.NewTransientStoreKey(paramtypes.TStoreKey)
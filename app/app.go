@@ -4,9 +4,12 @@ import (
 	"fmt"
 	"io"
 	"slices"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/v3/app/ante"
 	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	celestiablobstream "github.com/celestiaorg/celestia-app/v3/app/grpc/blobstream"
+	celestiadah "github.com/celestiaorg/celestia-app/v3/app/grpc/dah"
 	celestiatx "github.com/celestiaorg/celestia-app/v3/app/grpc/tx"
 	"github.com/celestiaorg/celestia-app/v3/app/module"
 	"github.com/celestiaorg/celestia-app/v3/app/posthandler"
@@ -14,6 +17,7 @@ import (
 	appv1 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v1"
 	appv2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
 	appv3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	"github.com/celestiaorg/celestia-app/v3/pkg/edsstore"
 	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
 	blobkeeper "github.com/celestiaorg/celestia-app/v3/x/blob/keeper"
 	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
@@ -22,6 +26,8 @@ import (
 	"github.com/celestiaorg/celestia-app/v3/x/minfee"
 	mintkeeper "github.com/celestiaorg/celestia-app/v3/x/mint/keeper"
 	minttypes "github.com/celestiaorg/celestia-app/v3/x/mint/types"
+	nsregkeeper "github.com/celestiaorg/celestia-app/v3/x/nsreg/keeper"
+	nsregtypes "github.com/celestiaorg/celestia-app/v3/x/nsreg/types"
 	"github.com/celestiaorg/celestia-app/v3/x/paramfilter"
 	"github.com/celestiaorg/celestia-app/v3/x/signal"
 	signaltypes "github.com/celestiaorg/celestia-app/v3/x/signal/types"
@@ -105,6 +111,8 @@ var maccPerms = map[string][]string{
 	stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 	ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 	icatypes.ModuleName:            nil,
+	minfee.ModuleName:              {authtypes.Burner},
+	nsregtypes.ModuleName:          {authtypes.Burner},
 }
 
 const (
@@ -159,7 +167,11 @@ type App struct {
 	ICAHostKeeper       icahostkeeper.Keeper
 	PacketForwardKeeper *packetforwardkeeper.Keeper
 	BlobKeeper          blobkeeper.Keeper
+	MinFeeKeeper        minfee.Keeper
+	NsregKeeper         nsregkeeper.Keeper
 	BlobstreamKeeper    blobstreamkeeper.Keeper
+	TokenFilterKeeper   tokenfilter.Keeper
+	ParamFilterKeeper   paramfilter.Keeper
 
 	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper // This keeper is public for test purposes
 	ScopedTransferKeeper capabilitykeeper.ScopedKeeper // This keeper is public for test purposes
@@ -173,6 +185,9 @@ type App struct {
 	// MsgGateKeeper is used to define which messages are accepted for a given
 	// app version.
 	MsgGateKeeper *ante.MsgVersioningGateKeeper
+	// edsStore keeps the extended data squares of the last blocks accepted by
+	// ProcessProposal. It is nil if disabled.
+	edsStore *edsstore.Store
 }
 
 // New returns a reference to an uninitialized app. Callers must subsequently
@@ -200,7 +215,7 @@ func New(
 	baseApp.SetInterfaceRegistry(interfaceRegistry)
 
 	keys := sdk.NewKVStoreKeys(allStoreKeys()...)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, minfee.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &App{
@@ -303,7 +318,9 @@ func New(
 		app.MsgServiceRouter(),
 	)
 
-	paramBlockList := paramfilter.NewParamBlockList(app.BlockedParams()...)
+	app.ParamFilterKeeper = paramfilter.NewKeeper(appCodec, keys[paramfilter.StoreKey], app.ParamsKeeper)
+	paramBlockList := paramfilter.NewParamBlockList(app.BlockedParams()...).
+		WithKeeper(app.ParamFilterKeeper)
 
 	// Register the proposal types.
 	govRouter := oldgovtypes.NewRouter()
@@ -312,7 +329,12 @@ func New(
 		AddRoute(ibcclienttypes.RouterKey, NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
 
 	// Create Transfer Keepers.
-	tokenFilterKeeper := tokenfilter.NewKeeper(app.IBCKeeper.ChannelKeeper)
+	app.TokenFilterKeeper = tokenfilter.NewKeeper(
+		appCodec,
+		keys[tokenfilter.StoreKey],
+		app.IBCKeeper.ChannelKeeper,
+		app.GetSubspace(tokenfilter.ModuleName),
+	)
 
 	app.PacketForwardKeeper = packetforwardkeeper.NewKeeper(
 		appCodec,
@@ -322,7 +344,7 @@ func New(
 		app.IBCKeeper.ChannelKeeper,
 		app.DistrKeeper,
 		app.BankKeeper,
-		tokenFilterKeeper,
+		app.TokenFilterKeeper,
 	)
 
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
@@ -346,7 +368,7 @@ func New(
 	// PacketForwardMiddleware is used only for version >= 2.
 	transferStack = module.NewVersionedIBCModule(packetForwardMiddleware, transferStack, v2, v3)
 	// Token filter wraps packet forward middleware and is thus the first module in the transfer stack.
-	tokenFilterMiddelware := tokenfilter.NewIBCMiddleware(transferStack)
+	tokenFilterMiddelware := tokenfilter.NewIBCMiddleware(transferStack, app.TokenFilterKeeper)
 	transferStack = module.NewVersionedIBCModule(tokenFilterMiddelware, transferStack, v1, v3)
 
 	app.EvidenceKeeper = *evidencekeeper.NewKeeper(
@@ -373,6 +395,24 @@ func New(
 		app.GetSubspace(blobtypes.ModuleName),
 	)
 
+	app.MinFeeKeeper = minfee.NewKeeper(
+		appCodec,
+		keys[minfee.StoreKey],
+		tkeys[minfee.TStoreKey],
+		app.ParamsKeeper,
+		app.BankKeeper,
+		app.BlobKeeper,
+	)
+	app.GovKeeper.SetHooks(govtypes.NewMultiGovHooks(app.MinFeeKeeper.Hooks(), app.ParamFilterKeeper.Hooks()))
+	app.ParamFilterKeeper.SetHooks(app.MinFeeKeeper.Hooks())
+
+	app.NsregKeeper = *nsregkeeper.NewKeeper(
+		appCodec,
+		keys[nsregtypes.StoreKey],
+		app.GetSubspace(nsregtypes.ModuleName),
+		app.BankKeeper,
+	)
+
 	app.PacketForwardKeeper.SetTransferKeeper(app.TransferKeeper)
 	ibcRouter := ibcporttypes.NewRouter()                                                   // Create static IBC router
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)                          // Add transfer route
@@ -394,12 +434,18 @@ func New(
 	// order begin block, end block and init genesis
 	app.setModuleOrder()
 
-	app.QueryRouter().AddRoute(proof.TxInclusionQueryPath, proof.QueryTxInclusionProof)
-	app.QueryRouter().AddRoute(proof.ShareInclusionQueryPath, proof.QueryShareInclusionProof)
+	app.edsStore, err = newEDSStore(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	app.QueryRouter().AddRoute(proof.TxInclusionQueryPath, proof.NewTxInclusionProofQuerier(app.edsStore))
+	app.QueryRouter().AddRoute(proof.ShareInclusionQueryPath, proof.NewShareInclusionProofQuerier(app.edsStore))
 
 	app.manager.RegisterInvariants(&app.CrisisKeeper)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	app.manager.RegisterServices(app.configurator)
+	tokenfilter.RegisterQueryServer(app.GRPCQueryRouter(), tokenfilter.NewQueryServerImpl(app.TokenFilterKeeper))
+	paramfilter.RegisterQueryServer(app.GRPCQueryRouter(), paramfilter.NewQueryServerImpl(paramBlockList, app.ParamsKeeper))
 
 	// extract the accepted message list from the configurator and create a gatekeeper
 	// which will be used both as the antehandler and as part of the circuit breaker in
@@ -425,8 +471,10 @@ func New(
 		app.IBCKeeper,
 		app.ParamsKeeper,
 		app.MsgGateKeeper,
+		app.MinFeeKeeper,
+		app.NsregKeeper,
 	))
-	app.SetPostHandler(posthandler.New())
+	app.SetPostHandler(posthandler.New(app.MinFeeKeeper))
 
 	app.SetMigrateStoreFn(app.migrateCommitStore)
 	app.SetMigrateModuleFn(app.migrateModules)
@@ -451,7 +499,9 @@ func (app *App) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.R
 	if req.Header.Height == app.upgradeHeightV2 {
 		app.BaseApp.Logger().Info("upgraded from app version 1 to 2")
 	}
-	return app.manager.BeginBlock(ctx, req)
+	res := app.manager.BeginBlock(ctx, req)
+	app.ParamFilterKeeper.ApplyPendingChanges(ctx)
+	return res
 }
 
 // EndBlocker executes application updates at the end of every block.
@@ -463,12 +513,7 @@ func (app *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.Respo
 		// check that we are at the height before the upgrade
 		if req.Height == app.upgradeHeightV2-1 {
 			app.BaseApp.Logger().Info(fmt.Sprintf("upgrading from app version %v to 2", currentVersion))
-			app.SetInitialAppVersionInConsensusParams(ctx, v2)
-			app.SetAppVersion(ctx, v2)
-
-			// The blobstream module was disabled in v2 so the following line
-			// removes the params subspace for blobstream.
-			if err := app.ParamsKeeper.DeleteSubspace(blobstreamtypes.ModuleName); err != nil {
+			if err := app.upgradeAppVersion(ctx, currentVersion, v2); err != nil {
 				panic(err)
 			}
 		}
@@ -476,13 +521,31 @@ func (app *App) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.Respo
 	} else if shouldUpgrade, newVersion := app.SignalKeeper.ShouldUpgrade(ctx); shouldUpgrade {
 		// Version changes must be increasing. Downgrades are not permitted
 		if newVersion > currentVersion {
-			app.SetAppVersion(ctx, newVersion)
-			app.SignalKeeper.ResetTally(ctx)
+			if err := app.upgradeAppVersion(ctx, currentVersion, newVersion); err != nil {
+				panic(err)
+			}
 		}
 	}
 	return res
 }
 
+// upgradeAppVersion sets the app version to toVersion and applies the side
+// effects of the upgrade that take place in the EndBlocker. The store and
+// module migrations are run afterwards when the block is committed.
+func (app *App) upgradeAppVersion(ctx sdk.Context, fromVersion, toVersion uint64) error {
+	if fromVersion == v1 {
+		app.SetInitialAppVersionInConsensusParams(ctx, toVersion)
+		app.SetAppVersion(ctx, toVersion)
+
+		// The blobstream module was disabled in v2 so the following line
+		// removes the params subspace for blobstream.
+		return app.ParamsKeeper.DeleteSubspace(blobstreamtypes.ModuleName)
+	}
+	app.SetAppVersion(ctx, toVersion)
+	app.SignalKeeper.ResetTally(ctx)
+	return nil
+}
+
 // migrateCommitStore tells the baseapp during a version upgrade, which stores to add and which
 // stores to remove
 func (app *App) migrateCommitStore(fromVersion, toVersion uint64) (baseapp.StoreMigrations, error) {
@@ -731,12 +794,17 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, _ config.APIConfig) {
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 	celestiatx.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	celestiablobstream.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	celestiadah.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+	tokenfilter.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 }
 
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *App) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.BaseApp.Simulate, app.interfaceRegistry)
 	celestiatx.RegisterTxService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.interfaceRegistry)
+	celestiablobstream.RegisterBlobstreamService(app.BaseApp.GRPCQueryRouter(), app.StakingKeeper, app.BlobstreamKeeper)
+	celestiadah.RegisterDataAvailabilityHeadersService(app.BaseApp.GRPCQueryRouter(), clientCtx, app.edsStore)
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -763,6 +831,35 @@ func (app *App) BlockedParams() [][2]string {
 	}
 }
 
+// DefaultParamPolicies returns the default restrictions on how params can be
+// changed via governance from app version 3 onwards. They are stored in the
+// paramfilter module's state.
+func DefaultParamPolicies() []paramfilter.ParamPolicy {
+	minSquareSize := sdk.OneDec()
+	maxSquareSize := sdk.NewDec(int64(appconsts.SquareSizeUpperBound(appconsts.LatestVersion)))
+	maxRelativeChange := sdk.OneDec()
+	return []paramfilter.ParamPolicy{
+		// blob.GovMaxSquareSize can change by at most 100% per proposal and
+		// only takes effect after a week so that nodes can prepare for it.
+		{
+			Subspace:          blobtypes.ModuleName,
+			Key:               string(blobtypes.KeyGovMaxSquareSize),
+			Min:               &minSquareSize,
+			Max:               &maxSquareSize,
+			MaxRelativeChange: &maxRelativeChange,
+			Delay:             7 * 24 * time.Hour,
+		},
+		// minfee.NetworkMinGasPrice can change by at most 100% per proposal
+		// and only takes effect after a day so that clients can adjust.
+		{
+			Subspace:          minfee.ModuleName,
+			Key:               string(minfee.KeyNetworkMinGasPrice),
+			MaxRelativeChange: &maxRelativeChange,
+			Delay:             24 * time.Hour,
+		},
+	}
+}
+
 // initParamsKeeper initializes the params keeper and its subspaces.
 func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino, key, tkey storetypes.StoreKey) paramskeeper.Keeper {
 	paramsKeeper := paramskeeper.NewKeeper(appCodec, legacyAmino, key, tkey)
@@ -781,7 +878,9 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(blobtypes.ModuleName)
 	paramsKeeper.Subspace(blobstreamtypes.ModuleName)
 	paramsKeeper.Subspace(minfee.ModuleName)
+	paramsKeeper.Subspace(nsregtypes.ModuleName)
 	paramsKeeper.Subspace(packetforwardtypes.ModuleName)
+	paramsKeeper.Subspace(tokenfilter.ModuleName).WithKeyTable(tokenfilter.ParamKeyTable())
 
 	return paramsKeeper
 }
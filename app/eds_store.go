@@ -0,0 +1,48 @@
+package app
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/edsstore"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	// FlagEDSStoreSize is the flag to specify the number of heights for which
+	// the extended data squares are kept. Zero disables the store.
+	FlagEDSStoreSize = "eds-store-size"
+	// FlagEDSStoreDisk is the flag to also keep the extended data squares on
+	// disk, in the data/eds directory of the node's home.
+	FlagEDSStoreDisk = "eds-store-disk"
+	// DefaultEDSStoreSize is the default number of heights for which the
+	// extended data squares are kept. An extended data square of the largest
+	// square size takes 32 MiB.
+	DefaultEDSStoreSize = 8
+)
+
+// newEDSStore returns the store of the extended data squares of the last
+// blocks configured by appOpts. It returns nil if the store is disabled.
+func newEDSStore(appOpts servertypes.AppOptions) (*edsstore.Store, error) {
+	size := cast.ToInt(appOpts.Get(FlagEDSStoreSize))
+	if size <= 0 {
+		return nil, nil
+	}
+	dir := ""
+	if cast.ToBool(appOpts.Get(FlagEDSStoreDisk)) {
+		home := cast.ToString(appOpts.Get(flags.FlagHome))
+		if home == "" {
+			return nil, fmt.Errorf("%s requires the home directory to be set", FlagEDSStoreDisk)
+		}
+		dir = filepath.Join(home, "data", "eds")
+	}
+	return edsstore.NewStore(size, dir)
+}
+
+// EDSStore returns the store of the extended data squares of the last blocks
+// accepted by ProcessProposal. It returns nil if the store is disabled.
+func (app *App) EDSStore() *edsstore.Store {
+	return app.edsStore
+}
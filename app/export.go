@@ -30,6 +30,11 @@ func (app *App) ExportAppStateAndValidators(forZeroHeight bool, jailAllowedAddrs
 	if err != nil {
 		return servertypes.ExportedApp{}, err
 	}
+	// Set the app version on the context so that modules can export state
+	// that only exists in some app versions.
+	header := ctx.BlockHeader()
+	header.Version.App = app.AppVersion()
+	ctx = ctx.WithBlockHeader(header)
 
 	if forZeroHeight {
 		app.prepForZeroHeightGenesis(ctx, jailAllowedAddrs)
@@ -22,7 +22,7 @@ func ExtendBlock(data coretypes.Data, appVersion uint64) (*rsmt2d.ExtendedDataSq
 		return nil, err
 	}
 
-	return da.ExtendShares(share.ToBytes(dataSquare))
+	return da.ExtendSharesForVersion(share.ToBytes(dataSquare), appVersion)
 }
 
 // EmptyBlock returns true if the given block data is considered empty by the
@@ -0,0 +1,1299 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/core/v1/blobstream/blobstream.proto
+
+package blobstream
+
+import (
+	context "context"
+	fmt "fmt"
+	proof "github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DataRootTupleRootRequest is the request type for the DataRootTupleRoot gRPC
+// method.
+type DataRootTupleRootRequest struct {
+	// begin_block is the first block of the range.
+	BeginBlock uint64 `protobuf:"varint,1,opt,name=begin_block,json=beginBlock,proto3" json:"begin_block,omitempty"`
+	// end_block is the end exclusive last block of the range.
+	EndBlock uint64 `protobuf:"varint,2,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+}
+
+func (m *DataRootTupleRootRequest) Reset()         { *m = DataRootTupleRootRequest{} }
+func (m *DataRootTupleRootRequest) String() string { return proto.CompactTextString(m) }
+func (*DataRootTupleRootRequest) ProtoMessage()    {}
+func (*DataRootTupleRootRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2a9786c73280ed2, []int{0}
+}
+func (m *DataRootTupleRootRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataRootTupleRootRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataRootTupleRootRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataRootTupleRootRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataRootTupleRootRequest.Merge(m, src)
+}
+func (m *DataRootTupleRootRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataRootTupleRootRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataRootTupleRootRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataRootTupleRootRequest proto.InternalMessageInfo
+
+func (m *DataRootTupleRootRequest) GetBeginBlock() uint64 {
+	if m != nil {
+		return m.BeginBlock
+	}
+	return 0
+}
+
+func (m *DataRootTupleRootRequest) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
+// DataRootTupleRootResponse is the response type for the DataRootTupleRoot
+// gRPC method.
+type DataRootTupleRootResponse struct {
+	DataRootTupleRoot []byte `protobuf:"bytes,1,opt,name=data_root_tuple_root,json=dataRootTupleRoot,proto3" json:"data_root_tuple_root,omitempty"`
+}
+
+func (m *DataRootTupleRootResponse) Reset()         { *m = DataRootTupleRootResponse{} }
+func (m *DataRootTupleRootResponse) String() string { return proto.CompactTextString(m) }
+func (*DataRootTupleRootResponse) ProtoMessage()    {}
+func (*DataRootTupleRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2a9786c73280ed2, []int{1}
+}
+func (m *DataRootTupleRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataRootTupleRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataRootTupleRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataRootTupleRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataRootTupleRootResponse.Merge(m, src)
+}
+func (m *DataRootTupleRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataRootTupleRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataRootTupleRootResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataRootTupleRootResponse proto.InternalMessageInfo
+
+func (m *DataRootTupleRootResponse) GetDataRootTupleRoot() []byte {
+	if m != nil {
+		return m.DataRootTupleRoot
+	}
+	return nil
+}
+
+// DataRootInclusionProofRequest is the request type for the
+// DataRootInclusionProof gRPC method.
+type DataRootInclusionProofRequest struct {
+	// height is the height of the data root to prove.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// begin_block is the optional first block of the range.
+	BeginBlock uint64 `protobuf:"varint,2,opt,name=begin_block,json=beginBlock,proto3" json:"begin_block,omitempty"`
+	// end_block is the optional end exclusive last block of the range.
+	EndBlock uint64 `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+}
+
+func (m *DataRootInclusionProofRequest) Reset()         { *m = DataRootInclusionProofRequest{} }
+func (m *DataRootInclusionProofRequest) String() string { return proto.CompactTextString(m) }
+func (*DataRootInclusionProofRequest) ProtoMessage()    {}
+func (*DataRootInclusionProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2a9786c73280ed2, []int{2}
+}
+func (m *DataRootInclusionProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataRootInclusionProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataRootInclusionProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataRootInclusionProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataRootInclusionProofRequest.Merge(m, src)
+}
+func (m *DataRootInclusionProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataRootInclusionProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataRootInclusionProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataRootInclusionProofRequest proto.InternalMessageInfo
+
+func (m *DataRootInclusionProofRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DataRootInclusionProofRequest) GetBeginBlock() uint64 {
+	if m != nil {
+		return m.BeginBlock
+	}
+	return 0
+}
+
+func (m *DataRootInclusionProofRequest) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
+// DataRootInclusionProofResponse is the response type for the
+// DataRootInclusionProof gRPC method.
+type DataRootInclusionProofResponse struct {
+	// nonce is the nonce of the data commitment that contains the height. It is
+	// zero if the range was provided in the request.
+	Nonce uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	// begin_block is the first block of the range.
+	BeginBlock uint64 `protobuf:"varint,2,opt,name=begin_block,json=beginBlock,proto3" json:"begin_block,omitempty"`
+	// end_block is the end exclusive last block of the range.
+	EndBlock uint64 `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+	// data_root is the data root of the height.
+	DataRoot []byte `protobuf:"bytes,4,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+	// data_root_tuple_root is the merkle root of the data root tuples in the
+	// range.
+	DataRootTupleRoot []byte `protobuf:"bytes,5,opt,name=data_root_tuple_root,json=dataRootTupleRoot,proto3" json:"data_root_tuple_root,omitempty"`
+	// proof is the merkle proof of the data root tuple of the height into the
+	// data root tuple root.
+	Proof *proof.Proof `protobuf:"bytes,6,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *DataRootInclusionProofResponse) Reset()         { *m = DataRootInclusionProofResponse{} }
+func (m *DataRootInclusionProofResponse) String() string { return proto.CompactTextString(m) }
+func (*DataRootInclusionProofResponse) ProtoMessage()    {}
+func (*DataRootInclusionProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c2a9786c73280ed2, []int{3}
+}
+func (m *DataRootInclusionProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataRootInclusionProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataRootInclusionProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataRootInclusionProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataRootInclusionProofResponse.Merge(m, src)
+}
+func (m *DataRootInclusionProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataRootInclusionProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataRootInclusionProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataRootInclusionProofResponse proto.InternalMessageInfo
+
+func (m *DataRootInclusionProofResponse) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *DataRootInclusionProofResponse) GetBeginBlock() uint64 {
+	if m != nil {
+		return m.BeginBlock
+	}
+	return 0
+}
+
+func (m *DataRootInclusionProofResponse) GetEndBlock() uint64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
+func (m *DataRootInclusionProofResponse) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+func (m *DataRootInclusionProofResponse) GetDataRootTupleRoot() []byte {
+	if m != nil {
+		return m.DataRootTupleRoot
+	}
+	return nil
+}
+
+func (m *DataRootInclusionProofResponse) GetProof() *proof.Proof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*DataRootTupleRootRequest)(nil), "celestia.core.v1.blobstream.DataRootTupleRootRequest")
+	proto.RegisterType((*DataRootTupleRootResponse)(nil), "celestia.core.v1.blobstream.DataRootTupleRootResponse")
+	proto.RegisterType((*DataRootInclusionProofRequest)(nil), "celestia.core.v1.blobstream.DataRootInclusionProofRequest")
+	proto.RegisterType((*DataRootInclusionProofResponse)(nil), "celestia.core.v1.blobstream.DataRootInclusionProofResponse")
+}
+
+func init() {
+	proto.RegisterFile("celestia/core/v1/blobstream/blobstream.proto", fileDescriptor_c2a9786c73280ed2)
+}
+
+var fileDescriptor_c2a9786c73280ed2 = []byte{
+	// 489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcf, 0x6b, 0x13, 0x41,
+	0x14, 0xce, 0xa4, 0x4d, 0xa8, 0xaf, 0x5e, 0x3a, 0x94, 0x12, 0x53, 0xbb, 0x96, 0x3d, 0xf5, 0xa0,
+	0x33, 0xb4, 0x45, 0x05, 0x3d, 0x88, 0x41, 0x10, 0x51, 0x41, 0xa3, 0x07, 0xf1, 0x12, 0x66, 0x37,
+	0xe3, 0x66, 0x71, 0x3b, 0x6f, 0xdd, 0x9d, 0xed, 0x25, 0xe4, 0xe2, 0x5f, 0x20, 0xf8, 0x27, 0x79,
+	0xf1, 0x58, 0xf0, 0xa0, 0x78, 0x92, 0xc4, 0xff, 0xc1, 0xab, 0xec, 0xcc, 0xee, 0x1a, 0xf2, 0x8b,
+	0xa8, 0x87, 0x84, 0x37, 0xf3, 0xde, 0x9b, 0x6f, 0xbe, 0xef, 0x7d, 0xb3, 0x70, 0xdd, 0x97, 0x91,
+	0x4c, 0x75, 0x28, 0xb8, 0x8f, 0x89, 0xe4, 0xe7, 0xc7, 0xdc, 0x8b, 0xd0, 0x4b, 0x75, 0x22, 0xc5,
+	0xd9, 0x54, 0xc8, 0xe2, 0x04, 0x35, 0xd2, 0xfd, 0xb2, 0x9a, 0xe5, 0xd5, 0xec, 0xfc, 0x98, 0xfd,
+	0x29, 0x69, 0x5f, 0x0d, 0x10, 0x83, 0x48, 0x72, 0x11, 0x87, 0x5c, 0x28, 0x85, 0x5a, 0xe8, 0x10,
+	0x55, 0x6a, 0x5b, 0xdb, 0xee, 0x1c, 0x50, 0x9c, 0x20, 0xbe, 0xb1, 0xff, 0xb6, 0xc6, 0x7d, 0x05,
+	0xad, 0x07, 0x42, 0x8b, 0x2e, 0xa2, 0x7e, 0x99, 0xc5, 0x91, 0xcc, 0x83, 0xae, 0x7c, 0x97, 0xc9,
+	0x54, 0xd3, 0x6b, 0xb0, 0xed, 0xc9, 0x20, 0x54, 0x3d, 0x2f, 0x42, 0xff, 0x6d, 0x8b, 0x1c, 0x92,
+	0xa3, 0xcd, 0x2e, 0x98, 0xad, 0x4e, 0xbe, 0x43, 0xf7, 0xe1, 0x92, 0x54, 0xfd, 0x22, 0x5d, 0x37,
+	0xe9, 0x2d, 0xa9, 0xfa, 0x26, 0xe9, 0x3e, 0x81, 0x2b, 0x0b, 0x4e, 0x4e, 0x63, 0x54, 0xa9, 0xa4,
+	0x1c, 0x76, 0xfb, 0x42, 0x8b, 0x5e, 0x82, 0xa8, 0x7b, 0x3a, 0x4f, 0x9b, 0xd0, 0x60, 0x5c, 0xee,
+	0xee, 0xf4, 0x67, 0x1b, 0xdd, 0x0c, 0x0e, 0xca, 0xd3, 0x1e, 0x29, 0x3f, 0xca, 0xd2, 0x10, 0xd5,
+	0xb3, 0x9c, 0x47, 0x79, 0xd9, 0x3d, 0x68, 0x0e, 0x64, 0x18, 0x0c, 0x74, 0x71, 0xcf, 0x62, 0x35,
+	0x4b, 0xa2, 0xbe, 0x9a, 0xc4, 0xc6, 0x0c, 0x89, 0x5f, 0x04, 0x9c, 0x65, 0xb8, 0x05, 0x95, 0x5d,
+	0x68, 0x28, 0x54, 0xbe, 0x2c, 0x70, 0xed, 0xe2, 0xff, 0x60, 0xf3, 0x64, 0x25, 0x4f, 0x6b, 0xd3,
+	0x68, 0xb2, 0x55, 0x6a, 0xb2, 0x54, 0xbb, 0xc6, 0x12, 0xed, 0xe8, 0x29, 0x34, 0xcc, 0xc8, 0x5b,
+	0xcd, 0x43, 0x72, 0xb4, 0x7d, 0x72, 0xc0, 0xe6, 0x2c, 0x65, 0xd2, 0xcc, 0xf2, 0xb2, 0xb5, 0x27,
+	0x9f, 0x36, 0x00, 0x3a, 0x95, 0xd3, 0xe8, 0x57, 0x02, 0x3b, 0x73, 0xe3, 0xa4, 0x37, 0xd9, 0x0a,
+	0x77, 0xb2, 0x65, 0xc6, 0x6a, 0xdf, 0xfa, 0xdb, 0x36, 0x2b, 0xb5, 0xfb, 0xe2, 0xfd, 0x97, 0x9f,
+	0x1f, 0xeb, 0x4f, 0xe9, 0x63, 0xbe, 0xea, 0x09, 0x2d, 0x12, 0x87, 0x0f, 0xa7, 0xa6, 0x31, 0xe2,
+	0xc3, 0x4a, 0xfa, 0x11, 0xfd, 0x4e, 0x60, 0x6f, 0xf1, 0x88, 0xe9, 0x9d, 0xb5, 0xee, 0xb9, 0xd0,
+	0x8f, 0xed, 0xbb, 0xff, 0xd4, 0x5b, 0x10, 0x7d, 0x68, 0x88, 0xde, 0xa7, 0xf7, 0xd6, 0x24, 0x1a,
+	0x96, 0xc7, 0xf4, 0xec, 0x0b, 0x1f, 0x5a, 0xf3, 0x8f, 0x3a, 0xcf, 0x3f, 0x8f, 0x1d, 0x72, 0x31,
+	0x76, 0xc8, 0x8f, 0xb1, 0x43, 0x3e, 0x4c, 0x9c, 0xda, 0xc5, 0xc4, 0xa9, 0x7d, 0x9b, 0x38, 0xb5,
+	0xd7, 0xb7, 0x83, 0x50, 0x0f, 0x32, 0x8f, 0xf9, 0x78, 0x56, 0x81, 0x60, 0x12, 0x54, 0xf1, 0x0d,
+	0x11, 0xc7, 0x3c, 0xff, 0x05, 0x49, 0xec, 0x4f, 0xa1, 0x7a, 0x4d, 0xf3, 0xe1, 0x38, 0xfd, 0x3d,
+	0x00, 0xff, 0x97, 0x17, 0x9b, 0xc7, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// BlobstreamClient is the client API for Blobstream service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlobstreamClient interface {
+	// DataRootTupleRoot returns the merkle root of the data root tuples in the
+	// end exclusive range [begin_block, end_block).
+	DataRootTupleRoot(ctx context.Context, in *DataRootTupleRootRequest, opts ...grpc.CallOption) (*DataRootTupleRootResponse, error)
+	// DataRootInclusionProof returns the merkle proof that the data root tuple
+	// of a height is included in the data root tuple root of a range. If no
+	// range is provided, the range of the data commitment that contains the
+	// height is used.
+	DataRootInclusionProof(ctx context.Context, in *DataRootInclusionProofRequest, opts ...grpc.CallOption) (*DataRootInclusionProofResponse, error)
+}
+
+type blobstreamClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewBlobstreamClient(cc grpc1.ClientConn) BlobstreamClient {
+	return &blobstreamClient{cc}
+}
+
+func (c *blobstreamClient) DataRootTupleRoot(ctx context.Context, in *DataRootTupleRootRequest, opts ...grpc.CallOption) (*DataRootTupleRootResponse, error) {
+	out := new(DataRootTupleRootResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.blobstream.Blobstream/DataRootTupleRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobstreamClient) DataRootInclusionProof(ctx context.Context, in *DataRootInclusionProofRequest, opts ...grpc.CallOption) (*DataRootInclusionProofResponse, error) {
+	out := new(DataRootInclusionProofResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.blobstream.Blobstream/DataRootInclusionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlobstreamServer is the server API for Blobstream service.
+type BlobstreamServer interface {
+	// DataRootTupleRoot returns the merkle root of the data root tuples in the
+	// end exclusive range [begin_block, end_block).
+	DataRootTupleRoot(context.Context, *DataRootTupleRootRequest) (*DataRootTupleRootResponse, error)
+	// DataRootInclusionProof returns the merkle proof that the data root tuple
+	// of a height is included in the data root tuple root of a range. If no
+	// range is provided, the range of the data commitment that contains the
+	// height is used.
+	DataRootInclusionProof(context.Context, *DataRootInclusionProofRequest) (*DataRootInclusionProofResponse, error)
+}
+
+// UnimplementedBlobstreamServer can be embedded to have forward compatible implementations.
+type UnimplementedBlobstreamServer struct {
+}
+
+func (*UnimplementedBlobstreamServer) DataRootTupleRoot(ctx context.Context, req *DataRootTupleRootRequest) (*DataRootTupleRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataRootTupleRoot not implemented")
+}
+func (*UnimplementedBlobstreamServer) DataRootInclusionProof(ctx context.Context, req *DataRootInclusionProofRequest) (*DataRootInclusionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataRootInclusionProof not implemented")
+}
+
+func RegisterBlobstreamServer(s grpc1.Server, srv BlobstreamServer) {
+	s.RegisterService(&_Blobstream_serviceDesc, srv)
+}
+
+func _Blobstream_DataRootTupleRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataRootTupleRootRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobstreamServer).DataRootTupleRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.blobstream.Blobstream/DataRootTupleRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobstreamServer).DataRootTupleRoot(ctx, req.(*DataRootTupleRootRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Blobstream_DataRootInclusionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataRootInclusionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobstreamServer).DataRootInclusionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.blobstream.Blobstream/DataRootInclusionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobstreamServer).DataRootInclusionProof(ctx, req.(*DataRootInclusionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Blobstream_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.blobstream.Blobstream",
+	HandlerType: (*BlobstreamServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DataRootTupleRoot",
+			Handler:    _Blobstream_DataRootTupleRoot_Handler,
+		},
+		{
+			MethodName: "DataRootInclusionProof",
+			Handler:    _Blobstream_DataRootInclusionProof_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/core/v1/blobstream/blobstream.proto",
+}
+
+func (m *DataRootTupleRootRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataRootTupleRootRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataRootTupleRootRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndBlock != 0 {
+		i = encodeVarintBlobstream(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BeginBlock != 0 {
+		i = encodeVarintBlobstream(dAtA, i, uint64(m.BeginBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataRootTupleRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataRootTupleRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataRootTupleRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DataRootTupleRoot) > 0 {
+		i -= len(m.DataRootTupleRoot)
+		copy(dAtA[i:], m.DataRootTupleRoot)
+		i = encodeVarintBlobstream(dAtA, i, uint64(len(m.DataRootTupleRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataRootInclusionProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataRootInclusionProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataRootInclusionProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndBlock != 0 {
+		i = encodeVarintBlobstream(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BeginBlock != 0 {
+		i = encodeVarintBlobstream(dAtA, i, uint64(m.BeginBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintBlobstream(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataRootInclusionProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataRootInclusionProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataRootInclusionProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBlobstream(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DataRootTupleRoot) > 0 {
+		i -= len(m.DataRootTupleRoot)
+		copy(dAtA[i:], m.DataRootTupleRoot)
+		i = encodeVarintBlobstream(dAtA, i, uint64(len(m.DataRootTupleRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintBlobstream(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndBlock != 0 {
+		i = encodeVarintBlobstream(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BeginBlock != 0 {
+		i = encodeVarintBlobstream(dAtA, i, uint64(m.BeginBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintBlobstream(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBlobstream(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBlobstream(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DataRootTupleRootRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeginBlock != 0 {
+		n += 1 + sovBlobstream(uint64(m.BeginBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovBlobstream(uint64(m.EndBlock))
+	}
+	return n
+}
+
+func (m *DataRootTupleRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DataRootTupleRoot)
+	if l > 0 {
+		n += 1 + l + sovBlobstream(uint64(l))
+	}
+	return n
+}
+
+func (m *DataRootInclusionProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovBlobstream(uint64(m.Height))
+	}
+	if m.BeginBlock != 0 {
+		n += 1 + sovBlobstream(uint64(m.BeginBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovBlobstream(uint64(m.EndBlock))
+	}
+	return n
+}
+
+func (m *DataRootInclusionProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovBlobstream(uint64(m.Nonce))
+	}
+	if m.BeginBlock != 0 {
+		n += 1 + sovBlobstream(uint64(m.BeginBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovBlobstream(uint64(m.EndBlock))
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovBlobstream(uint64(l))
+	}
+	l = len(m.DataRootTupleRoot)
+	if l > 0 {
+		n += 1 + l + sovBlobstream(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovBlobstream(uint64(l))
+	}
+	return n
+}
+
+func sovBlobstream(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBlobstream(x uint64) (n int) {
+	return sovBlobstream(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DataRootTupleRootRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobstream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataRootTupleRootRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataRootTupleRootRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+			}
+			m.BeginBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobstream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeginBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobstream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobstream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobstream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataRootTupleRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobstream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataRootTupleRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataRootTupleRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRootTupleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobstream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlobstream
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobstream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRootTupleRoot = append(m.DataRootTupleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRootTupleRoot == nil {
+				m.DataRootTupleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobstream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobstream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataRootInclusionProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobstream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataRootInclusionProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataRootInclusionProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobstream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+			}
+			m.BeginBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobstream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeginBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobstream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobstream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobstream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataRootInclusionProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlobstream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataRootInclusionProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataRootInclusionProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobstream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BeginBlock", wireType)
+			}
+			m.BeginBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobstream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BeginBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobstream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobstream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlobstream
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobstream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRootTupleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobstream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlobstream
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobstream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRootTupleRoot = append(m.DataRootTupleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRootTupleRoot == nil {
+				m.DataRootTupleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlobstream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBlobstream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlobstream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &proof.Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlobstream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlobstream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBlobstream(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBlobstream
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlobstream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBlobstream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBlobstream
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBlobstream
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBlobstream
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBlobstream        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBlobstream          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBlobstream = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,139 @@
+package blobstream
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	blobstreamtypes "github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// RegisterBlobstreamService registers the blobstream service on the gRPC
+// router.
+func RegisterBlobstreamService(qrt gogogrpc.Server, stakingKeeper StakingKeeper, blobstreamKeeper BlobstreamKeeper) {
+	RegisterBlobstreamServer(qrt, NewBlobstreamServer(stakingKeeper, blobstreamKeeper))
+}
+
+// RegisterGRPCGatewayRoutes mounts the blobstream service's GRPC-gateway
+// routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	err := RegisterBlobstreamHandlerClient(context.Background(), mux, NewBlobstreamClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// StakingKeeper returns the block headers recorded by the staking module's
+// historical info.
+type StakingKeeper interface {
+	GetHistoricalInfo(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool)
+}
+
+// BlobstreamKeeper returns the data commitment ranges stored by the blobstream
+// module.
+type BlobstreamKeeper interface {
+	GetDataCommitmentForHeight(ctx sdk.Context, height uint64) (blobstreamtypes.DataCommitment, error)
+}
+
+var _ BlobstreamServer = &blobstreamServer{}
+
+// blobstreamServer serves the data root tuples from the app's state so that it
+// doesn't depend on the celestia-core RPC.
+type blobstreamServer struct {
+	stakingKeeper    StakingKeeper
+	blobstreamKeeper BlobstreamKeeper
+}
+
+func NewBlobstreamServer(stakingKeeper StakingKeeper, blobstreamKeeper BlobstreamKeeper) BlobstreamServer {
+	return &blobstreamServer{stakingKeeper: stakingKeeper, blobstreamKeeper: blobstreamKeeper}
+}
+
+// DataRootTupleRoot implements the BlobstreamServer.DataRootTupleRoot method.
+func (s *blobstreamServer) DataRootTupleRoot(ctx context.Context, req *DataRootTupleRootRequest) (*DataRootTupleRootResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	tuples, err := s.dataRootTuples(ctx, req.BeginBlock, req.EndBlock)
+	if err != nil {
+		return nil, err
+	}
+	return &DataRootTupleRootResponse{DataRootTupleRoot: blobstreamtypes.DataRootTupleRoot(tuples)}, nil
+}
+
+// DataRootInclusionProof implements the BlobstreamServer.DataRootInclusionProof
+// method.
+func (s *blobstreamServer) DataRootInclusionProof(ctx context.Context, req *DataRootInclusionProofRequest) (*DataRootInclusionProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+
+	res := &DataRootInclusionProofResponse{BeginBlock: req.BeginBlock, EndBlock: req.EndBlock}
+	if req.BeginBlock == 0 && req.EndBlock == 0 {
+		dataCommitment, err := s.blobstreamKeeper.GetDataCommitmentForHeight(sdk.UnwrapSDKContext(ctx), req.Height)
+		if err != nil {
+			return nil, err
+		}
+		res.Nonce = dataCommitment.Nonce
+		res.BeginBlock = dataCommitment.BeginBlock
+		res.EndBlock = dataCommitment.EndBlock
+	}
+	if req.Height < res.BeginBlock || req.Height >= res.EndBlock {
+		return nil, status.Errorf(codes.InvalidArgument, "height %d should be in the end exclusive interval first_block %d last_block %d", req.Height, res.BeginBlock, res.EndBlock)
+	}
+
+	tuples, err := s.dataRootTuples(ctx, res.BeginBlock, res.EndBlock)
+	if err != nil {
+		return nil, err
+	}
+	tupleProof, err := blobstreamtypes.DataRootTupleInclusionProof(tuples, req.Height)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	dataRoot := tuples[req.Height-res.BeginBlock].DataRoot
+	res.DataRoot = dataRoot[:]
+	res.DataRootTupleRoot = blobstreamtypes.DataRootTupleRoot(tuples)
+	res.Proof = &proof.Proof{
+		Total:    tupleProof.Total,
+		Index:    tupleProof.Index,
+		LeafHash: tupleProof.LeafHash,
+		Aunts:    tupleProof.Aunts,
+	}
+	return res, nil
+}
+
+// dataRootTuples returns the data root tuples of the blocks in the end
+// exclusive range [beginBlock, endBlock) ordered by height. The data roots are
+// read from the block headers recorded by the staking module's historical
+// info, so the range must not be older than its historical entries.
+func (s *blobstreamServer) dataRootTuples(ctx context.Context, beginBlock, endBlock uint64) ([]blobstreamtypes.DataRootTuple, error) {
+	if err := blobstreamtypes.ValidateDataRootTupleRange(beginBlock, endBlock); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if endBlock-1 > uint64(sdkCtx.BlockHeight()) {
+		return nil, status.Errorf(codes.InvalidArgument, "end block %d is higher than current chain height %d", endBlock, sdkCtx.BlockHeight())
+	}
+
+	tuples := make([]blobstreamtypes.DataRootTuple, 0, endBlock-beginBlock)
+	for height := beginBlock; height < endBlock; height++ {
+		info, found := s.stakingKeeper.GetHistoricalInfo(sdkCtx, int64(height))
+		if !found {
+			return nil, status.Errorf(codes.NotFound, "no header recorded at height %d, it may have been pruned", height)
+		}
+		if len(info.Header.DataHash) != 32 {
+			return nil, fmt.Errorf("invalid data root length %d at height %d", len(info.Header.DataHash), height)
+		}
+		tuples = append(tuples, blobstreamtypes.DataRootTuple{
+			Height:   height,
+			DataRoot: *(*[32]byte)(info.Header.DataHash),
+		})
+	}
+	return tuples, nil
+}
@@ -0,0 +1,82 @@
+package blobstream_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/grpc/blobstream"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	blobstreamtypes "github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlobstreamService(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping blobstream service test in short mode.")
+	}
+
+	cfg := testnode.DefaultConfig().WithConsensusParams(app.DefaultInitialConsensusParams())
+	cctx, _, _ := testnode.NewNetwork(t, cfg)
+	require.NoError(t, cctx.WaitForBlocks(30))
+
+	client := blobstream.NewBlobstreamClient(cctx.GRPCClient)
+	ctx := cctx.GoContext()
+
+	t.Run("data root tuple root matches the core RPC", func(t *testing.T) {
+		res, err := client.DataRootTupleRoot(ctx, &blobstream.DataRootTupleRootRequest{BeginBlock: 1, EndBlock: 26})
+		require.NoError(t, err)
+
+		want, err := cctx.Client.DataCommitment(ctx, 1, 26)
+		require.NoError(t, err)
+		assert.Equal(t, want.DataCommitment.Bytes(), res.DataRootTupleRoot)
+	})
+
+	t.Run("data root inclusion proof matches the core RPC", func(t *testing.T) {
+		height := int64(10)
+		res, err := client.DataRootInclusionProof(ctx, &blobstream.DataRootInclusionProofRequest{Height: uint64(height), BeginBlock: 1, EndBlock: 26})
+		require.NoError(t, err)
+
+		want, err := cctx.Client.DataRootInclusionProof(ctx, uint64(height), 1, 26)
+		require.NoError(t, err)
+		assert.Equal(t, want.Proof.Total, res.Proof.Total)
+		assert.Equal(t, want.Proof.Index, res.Proof.Index)
+		assert.Equal(t, want.Proof.LeafHash, res.Proof.LeafHash)
+		assert.Equal(t, want.Proof.Aunts, res.Proof.Aunts)
+
+		block, err := cctx.Client.Block(ctx, &height)
+		require.NoError(t, err)
+		assert.Equal(t, block.Block.DataHash.Bytes(), res.DataRoot)
+
+		tuple := blobstreamtypes.DataRootTuple{Height: uint64(height), DataRoot: *(*[32]byte)(res.DataRoot)}
+		assert.NoError(t, res.Proof.Verify(res.DataRootTupleRoot, tuple.Encode()))
+	})
+
+	t.Run("uses the data commitment range for the height", func(t *testing.T) {
+		_, err := cctx.WaitForHeight(402)
+		require.NoError(t, err)
+
+		res, err := client.DataRootInclusionProof(ctx, &blobstream.DataRootInclusionProofRequest{Height: 10})
+		require.NoError(t, err)
+
+		queryClient := blobstreamtypes.NewQueryClient(cctx.GRPCClient)
+		dcRes, err := queryClient.DataCommitmentRangeForHeight(ctx, &blobstreamtypes.QueryDataCommitmentRangeForHeightRequest{Height: 10})
+		require.NoError(t, err)
+		assert.Equal(t, dcRes.DataCommitment.Nonce, res.Nonce)
+		assert.Equal(t, dcRes.DataCommitment.BeginBlock, res.BeginBlock)
+		assert.Equal(t, dcRes.DataCommitment.EndBlock, res.EndBlock)
+
+		want, err := cctx.Client.DataCommitment(ctx, res.BeginBlock, res.EndBlock)
+		require.NoError(t, err)
+		assert.Equal(t, want.DataCommitment.Bytes(), res.DataRootTupleRoot)
+	})
+
+	t.Run("rejects invalid ranges", func(t *testing.T) {
+		_, err := client.DataRootInclusionProof(ctx, &blobstream.DataRootInclusionProofRequest{Height: 30, BeginBlock: 1, EndBlock: 26})
+		assert.Error(t, err)
+		_, err = client.DataRootTupleRoot(ctx, &blobstream.DataRootTupleRootRequest{BeginBlock: 0, EndBlock: 26})
+		assert.Error(t, err)
+		_, err = client.DataRootTupleRoot(ctx, &blobstream.DataRootTupleRootRequest{BeginBlock: 1, EndBlock: 100_000})
+		assert.Error(t, err)
+	})
+}
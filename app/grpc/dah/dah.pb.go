@@ -0,0 +1,1518 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/core/v1/dah/dah.proto
+
+package dah
+
+import (
+	context "context"
+	fmt "fmt"
+	proof "github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	da "github.com/celestiaorg/celestia-app/v3/proto/celestia/core/v1/da"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DataAvailabilityHeaderRequest is the request type for the
+// DataAvailabilityHeader gRPC method.
+type DataAvailabilityHeaderRequest struct {
+	// height is the height of the block. The latest block is used if it is zero.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *DataAvailabilityHeaderRequest) Reset()         { *m = DataAvailabilityHeaderRequest{} }
+func (m *DataAvailabilityHeaderRequest) String() string { return proto.CompactTextString(m) }
+func (*DataAvailabilityHeaderRequest) ProtoMessage()    {}
+func (*DataAvailabilityHeaderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ccb294a713b782, []int{0}
+}
+func (m *DataAvailabilityHeaderRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataAvailabilityHeaderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataAvailabilityHeaderRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataAvailabilityHeaderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataAvailabilityHeaderRequest.Merge(m, src)
+}
+func (m *DataAvailabilityHeaderRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataAvailabilityHeaderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataAvailabilityHeaderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataAvailabilityHeaderRequest proto.InternalMessageInfo
+
+func (m *DataAvailabilityHeaderRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// DataAvailabilityHeaderResponse is the response type for the
+// DataAvailabilityHeader and SubscribeDataAvailabilityHeaders gRPC methods.
+type DataAvailabilityHeaderResponse struct {
+	// height is the height of the block.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// data_root is the data root of the block, i.e. the hash of the data
+	// availability header.
+	DataRoot []byte `protobuf:"bytes,2,opt,name=data_root,json=dataRoot,proto3" json:"data_root,omitempty"`
+	// data_availability_header contains the row and column roots of the block's
+	// extended data square.
+	DataAvailabilityHeader *da.DataAvailabilityHeader `protobuf:"bytes,3,opt,name=data_availability_header,json=dataAvailabilityHeader,proto3" json:"data_availability_header,omitempty"`
+}
+
+func (m *DataAvailabilityHeaderResponse) Reset()         { *m = DataAvailabilityHeaderResponse{} }
+func (m *DataAvailabilityHeaderResponse) String() string { return proto.CompactTextString(m) }
+func (*DataAvailabilityHeaderResponse) ProtoMessage()    {}
+func (*DataAvailabilityHeaderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ccb294a713b782, []int{1}
+}
+func (m *DataAvailabilityHeaderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DataAvailabilityHeaderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DataAvailabilityHeaderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DataAvailabilityHeaderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DataAvailabilityHeaderResponse.Merge(m, src)
+}
+func (m *DataAvailabilityHeaderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DataAvailabilityHeaderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DataAvailabilityHeaderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DataAvailabilityHeaderResponse proto.InternalMessageInfo
+
+func (m *DataAvailabilityHeaderResponse) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DataAvailabilityHeaderResponse) GetDataRoot() []byte {
+	if m != nil {
+		return m.DataRoot
+	}
+	return nil
+}
+
+func (m *DataAvailabilityHeaderResponse) GetDataAvailabilityHeader() *da.DataAvailabilityHeader {
+	if m != nil {
+		return m.DataAvailabilityHeader
+	}
+	return nil
+}
+
+// SubscribeDataAvailabilityHeadersRequest is the request type for the
+// SubscribeDataAvailabilityHeaders gRPC method.
+type SubscribeDataAvailabilityHeadersRequest struct {
+}
+
+func (m *SubscribeDataAvailabilityHeadersRequest) Reset() {
+	*m = SubscribeDataAvailabilityHeadersRequest{}
+}
+func (m *SubscribeDataAvailabilityHeadersRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeDataAvailabilityHeadersRequest) ProtoMessage()    {}
+func (*SubscribeDataAvailabilityHeadersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ccb294a713b782, []int{2}
+}
+func (m *SubscribeDataAvailabilityHeadersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeDataAvailabilityHeadersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeDataAvailabilityHeadersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeDataAvailabilityHeadersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeDataAvailabilityHeadersRequest.Merge(m, src)
+}
+func (m *SubscribeDataAvailabilityHeadersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeDataAvailabilityHeadersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeDataAvailabilityHeadersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeDataAvailabilityHeadersRequest proto.InternalMessageInfo
+
+// GetSampleRequest is the request type for the GetSample gRPC method.
+type GetSampleRequest struct {
+	// height is the height of the block.
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// row is the row index of the share in the extended data square.
+	Row uint32 `protobuf:"varint,2,opt,name=row,proto3" json:"row,omitempty"`
+	// col is the column index of the share in the extended data square.
+	Col uint32 `protobuf:"varint,3,opt,name=col,proto3" json:"col,omitempty"`
+}
+
+func (m *GetSampleRequest) Reset()         { *m = GetSampleRequest{} }
+func (m *GetSampleRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSampleRequest) ProtoMessage()    {}
+func (*GetSampleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ccb294a713b782, []int{3}
+}
+func (m *GetSampleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSampleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSampleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSampleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSampleRequest.Merge(m, src)
+}
+func (m *GetSampleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSampleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSampleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSampleRequest proto.InternalMessageInfo
+
+func (m *GetSampleRequest) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GetSampleRequest) GetRow() uint32 {
+	if m != nil {
+		return m.Row
+	}
+	return 0
+}
+
+func (m *GetSampleRequest) GetCol() uint32 {
+	if m != nil {
+		return m.Col
+	}
+	return 0
+}
+
+// GetSampleResponse is the response type for the GetSample gRPC method.
+type GetSampleResponse struct {
+	// share is the share at the requested row and column. Shares outside of the
+	// original data square are parity shares.
+	Share []byte `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	// proof is the NMT inclusion proof of the share to the row root. Parity
+	// shares are proven under the parity shares namespace.
+	Proof *proof.NMTProof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof,omitempty"`
+	// row_root is the root of the share's row.
+	RowRoot []byte `protobuf:"bytes,3,opt,name=row_root,json=rowRoot,proto3" json:"row_root,omitempty"`
+	// width is the width of the extended data square.
+	Width uint32 `protobuf:"varint,4,opt,name=width,proto3" json:"width,omitempty"`
+	// app_version is the app version of the block. It determines the NMT hasher
+	// that the proof is verified with.
+	AppVersion uint64 `protobuf:"varint,5,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+}
+
+func (m *GetSampleResponse) Reset()         { *m = GetSampleResponse{} }
+func (m *GetSampleResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSampleResponse) ProtoMessage()    {}
+func (*GetSampleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a2ccb294a713b782, []int{4}
+}
+func (m *GetSampleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetSampleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetSampleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetSampleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSampleResponse.Merge(m, src)
+}
+func (m *GetSampleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetSampleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSampleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSampleResponse proto.InternalMessageInfo
+
+func (m *GetSampleResponse) GetShare() []byte {
+	if m != nil {
+		return m.Share
+	}
+	return nil
+}
+
+func (m *GetSampleResponse) GetProof() *proof.NMTProof {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *GetSampleResponse) GetRowRoot() []byte {
+	if m != nil {
+		return m.RowRoot
+	}
+	return nil
+}
+
+func (m *GetSampleResponse) GetWidth() uint32 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
+}
+
+func (m *GetSampleResponse) GetAppVersion() uint64 {
+	if m != nil {
+		return m.AppVersion
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DataAvailabilityHeaderRequest)(nil), "celestia.core.v1.dah.DataAvailabilityHeaderRequest")
+	proto.RegisterType((*DataAvailabilityHeaderResponse)(nil), "celestia.core.v1.dah.DataAvailabilityHeaderResponse")
+	proto.RegisterType((*SubscribeDataAvailabilityHeadersRequest)(nil), "celestia.core.v1.dah.SubscribeDataAvailabilityHeadersRequest")
+	proto.RegisterType((*GetSampleRequest)(nil), "celestia.core.v1.dah.GetSampleRequest")
+	proto.RegisterType((*GetSampleResponse)(nil), "celestia.core.v1.dah.GetSampleResponse")
+}
+
+func init() { proto.RegisterFile("celestia/core/v1/dah/dah.proto", fileDescriptor_a2ccb294a713b782) }
+
+var fileDescriptor_a2ccb294a713b782 = []byte{
+	// 561 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xee, 0x34, 0x4d, 0x6d, 0x27, 0x2d, 0xd4, 0x21, 0xc4, 0x35, 0xea, 0xba, 0xec, 0xa1, 0x8d,
+	0x88, 0xbb, 0x4d, 0x22, 0x8a, 0x07, 0x0f, 0x8a, 0xa0, 0x08, 0x16, 0xd9, 0x8a, 0x07, 0x2f, 0x61,
+	0xb2, 0x3b, 0xee, 0x0e, 0x6c, 0xf3, 0xc6, 0xd9, 0x49, 0x82, 0x84, 0x5c, 0xfc, 0x05, 0x82, 0x07,
+	0xf1, 0x37, 0x78, 0xf4, 0xea, 0x0f, 0xf0, 0x58, 0xf0, 0xe2, 0x51, 0x12, 0xff, 0x86, 0x20, 0x3b,
+	0x13, 0x43, 0xb1, 0x9b, 0x46, 0x3d, 0xcc, 0xf2, 0xe6, 0xbd, 0xf7, 0x7d, 0xfb, 0xde, 0xf7, 0xde,
+	0x2e, 0xb6, 0x43, 0x96, 0xb2, 0x4c, 0x71, 0xea, 0x87, 0x20, 0x99, 0x3f, 0x68, 0xfa, 0x11, 0x4d,
+	0xf2, 0xe3, 0x09, 0x09, 0x0a, 0x48, 0xf5, 0x77, 0xdc, 0xcb, 0xe3, 0xde, 0xa0, 0xe9, 0x45, 0x34,
+	0xa9, 0x5f, 0x8e, 0x01, 0xe2, 0x94, 0xf9, 0x54, 0x70, 0x9f, 0xf6, 0x7a, 0xa0, 0xa8, 0xe2, 0xd0,
+	0xcb, 0x0c, 0xa6, 0xde, 0x2a, 0xe0, 0xf4, 0x23, 0xaa, 0x68, 0x87, 0x0e, 0x28, 0x4f, 0x69, 0x97,
+	0xa7, 0x5c, 0xbd, 0xee, 0x24, 0x8c, 0x46, 0x4c, 0xce, 0x30, 0xee, 0x29, 0x8c, 0x90, 0x00, 0x2f,
+	0xcd, 0xd3, 0xe4, 0xb8, 0xb7, 0xf1, 0x95, 0x07, 0x54, 0xd1, 0x7b, 0x27, 0x48, 0x1e, 0x69, 0x8e,
+	0x80, 0xbd, 0xea, 0xb3, 0x4c, 0x91, 0x1a, 0x5e, 0x4f, 0x18, 0x8f, 0x13, 0x65, 0x21, 0x07, 0x35,
+	0xd6, 0x82, 0xd9, 0xcd, 0xfd, 0x8c, 0xb0, 0xbd, 0x08, 0x99, 0x09, 0xe8, 0x65, 0x6c, 0x11, 0x94,
+	0x5c, 0xc2, 0x9b, 0xba, 0x72, 0x09, 0xa0, 0xac, 0x55, 0x07, 0x35, 0xb6, 0x82, 0x8d, 0xdc, 0x11,
+	0x00, 0x28, 0xc2, 0xb0, 0xb5, 0xa8, 0x2d, 0xab, 0xe4, 0xa0, 0x46, 0xa5, 0x75, 0xdd, 0x2b, 0xd0,
+	0xcf, 0x5b, 0x50, 0x4b, 0x2d, 0x2a, 0xf4, 0xbb, 0xd7, 0xf0, 0xde, 0x61, 0xbf, 0x9b, 0x85, 0x92,
+	0x77, 0x59, 0x31, 0x34, 0x9b, 0x29, 0xe0, 0x1e, 0xe0, 0x9d, 0x87, 0x4c, 0x1d, 0xd2, 0x23, 0x91,
+	0xb2, 0x25, 0xaa, 0x90, 0x1d, 0x5c, 0x92, 0x30, 0xd4, 0x4d, 0x6d, 0x07, 0xb9, 0x99, 0x7b, 0x42,
+	0x48, 0x75, 0xe9, 0xdb, 0x41, 0x6e, 0xba, 0x9f, 0x10, 0x3e, 0x7f, 0x82, 0x70, 0x26, 0x56, 0x15,
+	0x97, 0xb3, 0x84, 0x4a, 0xa6, 0x09, 0xb7, 0x02, 0x73, 0x21, 0xb7, 0x70, 0x59, 0x4f, 0x4b, 0x33,
+	0x56, 0x5a, 0xce, 0xe9, 0xd6, 0xcd, 0x30, 0x0f, 0x9e, 0x3c, 0x7b, 0x9a, 0x1b, 0x81, 0x49, 0x27,
+	0x17, 0xf1, 0x86, 0x84, 0xa1, 0x51, 0xb8, 0xa4, 0x09, 0xcf, 0x49, 0x18, 0x6a, 0x81, 0xab, 0xb8,
+	0x3c, 0xe4, 0x91, 0x4a, 0xac, 0x35, 0x5d, 0x92, 0xb9, 0x90, 0xab, 0xb8, 0x42, 0x85, 0xe8, 0x0c,
+	0x98, 0xcc, 0x38, 0xf4, 0xac, 0xb2, 0xee, 0x0a, 0x53, 0x21, 0x9e, 0x1b, 0x4f, 0xeb, 0x67, 0x09,
+	0x5f, 0x58, 0x20, 0x14, 0xf9, 0x88, 0x70, 0xad, 0x38, 0x46, 0xda, 0x45, 0xc3, 0x4a, 0xbc, 0x33,
+	0x77, 0xae, 0x7e, 0xf3, 0xdf, 0x40, 0x46, 0x41, 0x77, 0xf7, 0xcd, 0xd7, 0x1f, 0xef, 0x56, 0x1d,
+	0x62, 0xfb, 0x85, 0xdf, 0xdf, 0xc8, 0x8c, 0x68, 0x4c, 0x3e, 0x20, 0xec, 0x2c, 0x9b, 0x3d, 0xb9,
+	0x5b, 0x5c, 0xc2, 0x5f, 0xee, 0xcc, 0xff, 0x75, 0xb0, 0x8f, 0xc8, 0x7b, 0x84, 0x37, 0xe7, 0xbb,
+	0x41, 0x76, 0x8b, 0x59, 0xfe, 0xdc, 0xc6, 0xfa, 0xde, 0xd2, 0xbc, 0x99, 0x44, 0x77, 0xb4, 0x44,
+	0x6d, 0xd2, 0x3c, 0x5b, 0x22, 0x3f, 0xd3, 0x30, 0x7f, 0x24, 0x61, 0x38, 0xf6, 0x47, 0x21, 0xa4,
+	0xe3, 0xfb, 0x8f, 0xbf, 0x4c, 0x6c, 0x74, 0x3c, 0xb1, 0xd1, 0xf7, 0x89, 0x8d, 0xde, 0x4e, 0xed,
+	0x95, 0xe3, 0xa9, 0xbd, 0xf2, 0x6d, 0x6a, 0xaf, 0xbc, 0xd8, 0x8f, 0xb9, 0x4a, 0xfa, 0x5d, 0x2f,
+	0x84, 0xa3, 0x39, 0x2d, 0xc8, 0x78, 0x6e, 0xdf, 0xa0, 0x42, 0xf8, 0xf9, 0x89, 0xa5, 0x08, 0xf3,
+	0xf7, 0x74, 0xd7, 0xf5, 0xbf, 0xa7, 0xfd, 0x6b, 0x00, 0x07, 0xb0, 0x9f, 0x1d, 0x29, 0x05, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DataAvailabilityHeadersClient is the client API for DataAvailabilityHeaders service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DataAvailabilityHeadersClient interface {
+	// DataAvailabilityHeader returns the data availability header of the block
+	// at a height.
+	DataAvailabilityHeader(ctx context.Context, in *DataAvailabilityHeaderRequest, opts ...grpc.CallOption) (*DataAvailabilityHeaderResponse, error)
+	// SubscribeDataAvailabilityHeaders streams the data availability header of
+	// each new block as it is committed.
+	SubscribeDataAvailabilityHeaders(ctx context.Context, in *SubscribeDataAvailabilityHeadersRequest, opts ...grpc.CallOption) (DataAvailabilityHeaders_SubscribeDataAvailabilityHeadersClient, error)
+	// GetSample returns the share at a row and column of the extended data
+	// square of the block at a height and its NMT inclusion proof to the row
+	// root.
+	GetSample(ctx context.Context, in *GetSampleRequest, opts ...grpc.CallOption) (*GetSampleResponse, error)
+}
+
+type dataAvailabilityHeadersClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewDataAvailabilityHeadersClient(cc grpc1.ClientConn) DataAvailabilityHeadersClient {
+	return &dataAvailabilityHeadersClient{cc}
+}
+
+func (c *dataAvailabilityHeadersClient) DataAvailabilityHeader(ctx context.Context, in *DataAvailabilityHeaderRequest, opts ...grpc.CallOption) (*DataAvailabilityHeaderResponse, error) {
+	out := new(DataAvailabilityHeaderResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.dah.DataAvailabilityHeaders/DataAvailabilityHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataAvailabilityHeadersClient) SubscribeDataAvailabilityHeaders(ctx context.Context, in *SubscribeDataAvailabilityHeadersRequest, opts ...grpc.CallOption) (DataAvailabilityHeaders_SubscribeDataAvailabilityHeadersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_DataAvailabilityHeaders_serviceDesc.Streams[0], "/celestia.core.v1.dah.DataAvailabilityHeaders/SubscribeDataAvailabilityHeaders", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &dataAvailabilityHeadersSubscribeDataAvailabilityHeadersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DataAvailabilityHeaders_SubscribeDataAvailabilityHeadersClient interface {
+	Recv() (*DataAvailabilityHeaderResponse, error)
+	grpc.ClientStream
+}
+
+type dataAvailabilityHeadersSubscribeDataAvailabilityHeadersClient struct {
+	grpc.ClientStream
+}
+
+func (x *dataAvailabilityHeadersSubscribeDataAvailabilityHeadersClient) Recv() (*DataAvailabilityHeaderResponse, error) {
+	m := new(DataAvailabilityHeaderResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *dataAvailabilityHeadersClient) GetSample(ctx context.Context, in *GetSampleRequest, opts ...grpc.CallOption) (*GetSampleResponse, error) {
+	out := new(GetSampleResponse)
+	err := c.cc.Invoke(ctx, "/celestia.core.v1.dah.DataAvailabilityHeaders/GetSample", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DataAvailabilityHeadersServer is the server API for DataAvailabilityHeaders service.
+type DataAvailabilityHeadersServer interface {
+	// DataAvailabilityHeader returns the data availability header of the block
+	// at a height.
+	DataAvailabilityHeader(context.Context, *DataAvailabilityHeaderRequest) (*DataAvailabilityHeaderResponse, error)
+	// SubscribeDataAvailabilityHeaders streams the data availability header of
+	// each new block as it is committed.
+	SubscribeDataAvailabilityHeaders(*SubscribeDataAvailabilityHeadersRequest, DataAvailabilityHeaders_SubscribeDataAvailabilityHeadersServer) error
+	// GetSample returns the share at a row and column of the extended data
+	// square of the block at a height and its NMT inclusion proof to the row
+	// root.
+	GetSample(context.Context, *GetSampleRequest) (*GetSampleResponse, error)
+}
+
+// UnimplementedDataAvailabilityHeadersServer can be embedded to have forward compatible implementations.
+type UnimplementedDataAvailabilityHeadersServer struct {
+}
+
+func (*UnimplementedDataAvailabilityHeadersServer) DataAvailabilityHeader(ctx context.Context, req *DataAvailabilityHeaderRequest) (*DataAvailabilityHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DataAvailabilityHeader not implemented")
+}
+func (*UnimplementedDataAvailabilityHeadersServer) SubscribeDataAvailabilityHeaders(req *SubscribeDataAvailabilityHeadersRequest, srv DataAvailabilityHeaders_SubscribeDataAvailabilityHeadersServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeDataAvailabilityHeaders not implemented")
+}
+func (*UnimplementedDataAvailabilityHeadersServer) GetSample(ctx context.Context, req *GetSampleRequest) (*GetSampleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSample not implemented")
+}
+
+func RegisterDataAvailabilityHeadersServer(s grpc1.Server, srv DataAvailabilityHeadersServer) {
+	s.RegisterService(&_DataAvailabilityHeaders_serviceDesc, srv)
+}
+
+func _DataAvailabilityHeaders_DataAvailabilityHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DataAvailabilityHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataAvailabilityHeadersServer).DataAvailabilityHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.dah.DataAvailabilityHeaders/DataAvailabilityHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataAvailabilityHeadersServer).DataAvailabilityHeader(ctx, req.(*DataAvailabilityHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataAvailabilityHeaders_SubscribeDataAvailabilityHeaders_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeDataAvailabilityHeadersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DataAvailabilityHeadersServer).SubscribeDataAvailabilityHeaders(m, &dataAvailabilityHeadersSubscribeDataAvailabilityHeadersServer{stream})
+}
+
+type DataAvailabilityHeaders_SubscribeDataAvailabilityHeadersServer interface {
+	Send(*DataAvailabilityHeaderResponse) error
+	grpc.ServerStream
+}
+
+type dataAvailabilityHeadersSubscribeDataAvailabilityHeadersServer struct {
+	grpc.ServerStream
+}
+
+func (x *dataAvailabilityHeadersSubscribeDataAvailabilityHeadersServer) Send(m *DataAvailabilityHeaderResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DataAvailabilityHeaders_GetSample_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSampleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataAvailabilityHeadersServer).GetSample(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.core.v1.dah.DataAvailabilityHeaders/GetSample",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataAvailabilityHeadersServer).GetSample(ctx, req.(*GetSampleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _DataAvailabilityHeaders_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.core.v1.dah.DataAvailabilityHeaders",
+	HandlerType: (*DataAvailabilityHeadersServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "DataAvailabilityHeader",
+			Handler:    _DataAvailabilityHeaders_DataAvailabilityHeader_Handler,
+		},
+		{
+			MethodName: "GetSample",
+			Handler:    _DataAvailabilityHeaders_GetSample_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeDataAvailabilityHeaders",
+			Handler:       _DataAvailabilityHeaders_SubscribeDataAvailabilityHeaders_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "celestia/core/v1/dah/dah.proto",
+}
+
+func (m *DataAvailabilityHeaderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataAvailabilityHeaderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataAvailabilityHeaderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintDah(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataAvailabilityHeaderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataAvailabilityHeaderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataAvailabilityHeaderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DataAvailabilityHeader != nil {
+		{
+			size, err := m.DataAvailabilityHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDah(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DataRoot) > 0 {
+		i -= len(m.DataRoot)
+		copy(dAtA[i:], m.DataRoot)
+		i = encodeVarintDah(dAtA, i, uint64(len(m.DataRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintDah(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeDataAvailabilityHeadersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeDataAvailabilityHeadersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeDataAvailabilityHeadersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSampleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSampleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSampleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Col != 0 {
+		i = encodeVarintDah(dAtA, i, uint64(m.Col))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Row != 0 {
+		i = encodeVarintDah(dAtA, i, uint64(m.Row))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintDah(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetSampleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetSampleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetSampleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AppVersion != 0 {
+		i = encodeVarintDah(dAtA, i, uint64(m.AppVersion))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Width != 0 {
+		i = encodeVarintDah(dAtA, i, uint64(m.Width))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.RowRoot) > 0 {
+		i -= len(m.RowRoot)
+		copy(dAtA[i:], m.RowRoot)
+		i = encodeVarintDah(dAtA, i, uint64(len(m.RowRoot)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDah(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Share) > 0 {
+		i -= len(m.Share)
+		copy(dAtA[i:], m.Share)
+		i = encodeVarintDah(dAtA, i, uint64(len(m.Share)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDah(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDah(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DataAvailabilityHeaderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDah(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *DataAvailabilityHeaderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDah(uint64(m.Height))
+	}
+	l = len(m.DataRoot)
+	if l > 0 {
+		n += 1 + l + sovDah(uint64(l))
+	}
+	if m.DataAvailabilityHeader != nil {
+		l = m.DataAvailabilityHeader.Size()
+		n += 1 + l + sovDah(uint64(l))
+	}
+	return n
+}
+
+func (m *SubscribeDataAvailabilityHeadersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetSampleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDah(uint64(m.Height))
+	}
+	if m.Row != 0 {
+		n += 1 + sovDah(uint64(m.Row))
+	}
+	if m.Col != 0 {
+		n += 1 + sovDah(uint64(m.Col))
+	}
+	return n
+}
+
+func (m *GetSampleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Share)
+	if l > 0 {
+		n += 1 + l + sovDah(uint64(l))
+	}
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovDah(uint64(l))
+	}
+	l = len(m.RowRoot)
+	if l > 0 {
+		n += 1 + l + sovDah(uint64(l))
+	}
+	if m.Width != 0 {
+		n += 1 + sovDah(uint64(m.Width))
+	}
+	if m.AppVersion != 0 {
+		n += 1 + sovDah(uint64(m.AppVersion))
+	}
+	return n
+}
+
+func sovDah(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDah(x uint64) (n int) {
+	return sovDah(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DataAvailabilityHeaderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDah
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataAvailabilityHeaderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataAvailabilityHeaderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDah
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDah(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDah
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataAvailabilityHeaderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDah
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataAvailabilityHeaderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataAvailabilityHeaderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDah
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDah
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDah
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDah
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataRoot = append(m.DataRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.DataRoot == nil {
+				m.DataRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataAvailabilityHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDah
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDah
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDah
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataAvailabilityHeader == nil {
+				m.DataAvailabilityHeader = &da.DataAvailabilityHeader{}
+			}
+			if err := m.DataAvailabilityHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDah(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDah
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeDataAvailabilityHeadersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDah
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeDataAvailabilityHeadersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeDataAvailabilityHeadersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDah(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDah
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSampleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDah
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSampleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSampleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDah
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Row", wireType)
+			}
+			m.Row = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDah
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Row |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Col", wireType)
+			}
+			m.Col = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDah
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Col |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDah(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDah
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetSampleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDah
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetSampleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetSampleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDah
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDah
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDah
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Share = append(m.Share[:0], dAtA[iNdEx:postIndex]...)
+			if m.Share == nil {
+				m.Share = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDah
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDah
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDah
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Proof == nil {
+				m.Proof = &proof.NMTProof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDah
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDah
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDah
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RowRoot = append(m.RowRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.RowRoot == nil {
+				m.RowRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Width", wireType)
+			}
+			m.Width = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDah
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Width |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			m.AppVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDah
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDah(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDah
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDah(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDah
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDah
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDah
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDah
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDah
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDah
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDah        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDah          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDah = fmt.Errorf("proto: unexpected end of group")
+)
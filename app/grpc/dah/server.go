@@ -0,0 +1,224 @@
+package dah
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/cosmos/cosmos-sdk/client"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	coretypes "github.com/tendermint/tendermint/types"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// RegisterDataAvailabilityHeadersService registers the data availability
+// headers service on the gRPC router. The extended data squares of the blocks
+// are read from edsGetter if it has them instead of being recomputed from the
+// blocks' data. edsGetter may be nil.
+func RegisterDataAvailabilityHeadersService(qrt gogogrpc.Server, clientCtx client.Context, edsGetter proof.EDSGetter) {
+	RegisterDataAvailabilityHeadersServer(qrt, NewDataAvailabilityHeadersServer(clientCtx, edsGetter))
+}
+
+// RegisterGRPCGatewayRoutes mounts the data availability headers service's
+// GRPC-gateway routes on the given Mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	err := RegisterDataAvailabilityHeadersHandlerClient(context.Background(), mux, NewDataAvailabilityHeadersClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// subscriberCount is used to give each subscription a unique subscriber name.
+var subscriberCount atomic.Uint64
+
+var _ DataAvailabilityHeadersServer = &dataAvailabilityHeadersServer{}
+
+type dataAvailabilityHeadersServer struct {
+	clientCtx client.Context
+	edsGetter proof.EDSGetter
+}
+
+func NewDataAvailabilityHeadersServer(clientCtx client.Context, edsGetter proof.EDSGetter) DataAvailabilityHeadersServer {
+	return &dataAvailabilityHeadersServer{clientCtx: clientCtx, edsGetter: edsGetter}
+}
+
+// DataAvailabilityHeader implements the
+// DataAvailabilityHeadersServer.DataAvailabilityHeader method.
+func (s *dataAvailabilityHeadersServer) DataAvailabilityHeader(ctx context.Context, req *DataAvailabilityHeaderRequest) (*DataAvailabilityHeaderResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	block, err := s.block(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	return s.dataAvailabilityHeader(block)
+}
+
+// GetSample implements the DataAvailabilityHeadersServer.GetSample method.
+func (s *dataAvailabilityHeadersServer) GetSample(ctx context.Context, req *GetSampleRequest) (*GetSampleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	if req.Height == 0 {
+		return nil, status.Error(codes.InvalidArgument, "height cannot be zero")
+	}
+	block, err := s.block(ctx, req.Height)
+	if err != nil {
+		return nil, err
+	}
+	eds, appVersion, _, err := s.extendedDataSquare(block)
+	if err != nil {
+		return nil, err
+	}
+	if uint(req.Row) >= eds.Width() || uint(req.Col) >= eds.Width() {
+		return nil, status.Errorf(codes.InvalidArgument, "coordinates (%d, %d) are out of the bounds of the extended data square of width %d", req.Row, req.Col, eds.Width())
+	}
+
+	sh, shareProof, err := proof.NewSampleProof(eds, appVersion, uint(req.Row), uint(req.Col))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	rowRoots, err := eds.RowRoots()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &GetSampleResponse{
+		Share:      sh,
+		Proof:      shareProof,
+		RowRoot:    rowRoots[req.Row],
+		Width:      uint32(eds.Width()),
+		AppVersion: appVersion,
+	}, nil
+}
+
+// SubscribeDataAvailabilityHeaders implements the
+// DataAvailabilityHeadersServer.SubscribeDataAvailabilityHeaders method.
+func (s *dataAvailabilityHeadersServer) SubscribeDataAvailabilityHeaders(req *SubscribeDataAvailabilityHeadersRequest, stream DataAvailabilityHeaders_SubscribeDataAvailabilityHeadersServer) error {
+	if req == nil {
+		return status.Error(codes.InvalidArgument, "request cannot be nil")
+	}
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return err
+	}
+
+	ctx := stream.Context()
+	subscriber := fmt.Sprintf("dah-subscriber-%d", subscriberCount.Add(1))
+	query := coretypes.EventQueryNewBlock.String()
+	events, err := node.Subscribe(ctx, subscriber, query)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	defer func() {
+		// the stream's context may already be canceled at this point
+		_ = node.Unsubscribe(context.Background(), subscriber, query)
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-events:
+			if !ok {
+				return status.Error(codes.Unavailable, "subscription was canceled")
+			}
+			data, ok := event.Data.(coretypes.EventDataNewBlock)
+			if !ok {
+				continue
+			}
+			res, err := s.dataAvailabilityHeader(data.Block)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(res); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// block returns the block at height or the latest block if height is zero.
+func (s *dataAvailabilityHeadersServer) block(ctx context.Context, height uint64) (*coretypes.Block, error) {
+	node, err := s.clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	var h *int64
+	if height != 0 {
+		hInt := int64(height)
+		h = &hInt
+	}
+	res, err := node.Block(ctx, h)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return res.Block, nil
+}
+
+// dataAvailabilityHeader returns the data availability header of block.
+func (s *dataAvailabilityHeadersServer) dataAvailabilityHeader(block *coretypes.Block) (*DataAvailabilityHeaderResponse, error) {
+	_, _, dah, err := s.extendedDataSquare(block)
+	if err != nil {
+		return nil, err
+	}
+	dahProto, err := dah.ToProto()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &DataAvailabilityHeaderResponse{
+		Height:                 uint64(block.Height),
+		DataRoot:               dah.Hash(),
+		DataAvailabilityHeader: dahProto,
+	}, nil
+}
+
+// extendedDataSquare returns the extended data square of block, the app
+// version it was extended with and its data availability header. The square
+// is read from the edsGetter if it has it or computed from the block's data
+// with the block's app version otherwise.
+func (s *dataAvailabilityHeadersServer) extendedDataSquare(block *coretypes.Block) (*rsmt2d.ExtendedDataSquare, uint64, da.DataAvailabilityHeader, error) {
+	eds, appVersion, err := s.getOrExtend(block)
+	if err != nil {
+		return nil, 0, da.DataAvailabilityHeader{}, status.Error(codes.Internal, err.Error())
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return nil, 0, da.DataAvailabilityHeader{}, status.Error(codes.Internal, err.Error())
+	}
+	if !bytes.Equal(dah.Hash(), block.DataHash) {
+		return nil, 0, da.DataAvailabilityHeader{}, status.Errorf(codes.Internal, "computed data root %X differs from the data root %X of block %d", dah.Hash(), block.DataHash, block.Height)
+	}
+	return eds, appVersion, dah, nil
+}
+
+func (s *dataAvailabilityHeadersServer) getOrExtend(block *coretypes.Block) (*rsmt2d.ExtendedDataSquare, uint64, error) {
+	if s.edsGetter != nil {
+		if eds, appVersion, ok := s.edsGetter.Get(block.DataHash); ok {
+			return eds, appVersion, nil
+		}
+	}
+	appVersion := block.Version.App
+	dataSquare, err := square.Construct(
+		block.Data.Txs.ToSliceOfBytes(),
+		appconsts.SquareSizeUpperBound(appVersion),
+		appconsts.SubtreeRootThreshold(appVersion),
+	)
+	if err != nil {
+		return nil, 0, err
+	}
+	eds, err := da.ExtendSharesForVersion(share.ToBytes(dataSquare), appVersion)
+	if err != nil {
+		return nil, 0, err
+	}
+	return eds, appVersion, nil
+}
@@ -0,0 +1,97 @@
+package dah_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/grpc/dah"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDataAvailabilityHeadersService(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping data availability headers service test in short mode.")
+	}
+
+	cfg := testnode.DefaultConfig().WithConsensusParams(app.DefaultInitialConsensusParams())
+	cctx, _, _ := testnode.NewNetwork(t, cfg)
+	require.NoError(t, cctx.WaitForNextBlock())
+
+	client := dah.NewDataAvailabilityHeadersClient(cctx.GRPCClient)
+	ctx := cctx.GoContext()
+
+	t.Run("data availability header matches the block's data root", func(t *testing.T) {
+		height := int64(2)
+		res, err := client.DataAvailabilityHeader(ctx, &dah.DataAvailabilityHeaderRequest{Height: uint64(height)})
+		require.NoError(t, err)
+
+		block, err := cctx.Client.Block(ctx, &height)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(height), res.Height)
+		assert.Equal(t, block.Block.DataHash.Bytes(), res.DataRoot)
+
+		header, err := da.DataAvailabilityHeaderFromProto(res.DataAvailabilityHeader)
+		require.NoError(t, err)
+		assert.Equal(t, res.DataRoot, header.Hash())
+	})
+
+	t.Run("returns the latest block if the height is not set", func(t *testing.T) {
+		res, err := client.DataAvailabilityHeader(ctx, &dah.DataAvailabilityHeaderRequest{})
+		require.NoError(t, err)
+		assert.Greater(t, res.Height, uint64(1))
+	})
+
+	t.Run("returns an error for a future height", func(t *testing.T) {
+		_, err := client.DataAvailabilityHeader(ctx, &dah.DataAvailabilityHeaderRequest{Height: 1_000_000})
+		assert.Error(t, err)
+	})
+
+	t.Run("samples verify against the data availability header", func(t *testing.T) {
+		height := uint64(2)
+		dahRes, err := client.DataAvailabilityHeader(ctx, &dah.DataAvailabilityHeaderRequest{Height: height})
+		require.NoError(t, err)
+		width := uint32(len(dahRes.DataAvailabilityHeader.RowRoots))
+		h := int64(height)
+		block, err := cctx.Client.Block(ctx, &h)
+		require.NoError(t, err)
+
+		// sample the original data square and the parity quadrants
+		for _, coords := range [][2]uint32{{0, 0}, {0, width - 1}, {width - 1, 0}, {width - 1, width - 1}} {
+			res, err := client.GetSample(ctx, &dah.GetSampleRequest{Height: height, Row: coords[0], Col: coords[1]})
+			require.NoError(t, err)
+			assert.Equal(t, width, res.Width)
+			// the square is extended with the app version of the block
+			assert.Equal(t, block.Block.Version.App, res.AppVersion)
+			assert.Equal(t, dahRes.DataAvailabilityHeader.RowRoots[coords[0]], res.RowRoot)
+			assert.True(t, proof.VerifySampleProof(res.Share, res.Proof, res.AppVersion, uint(coords[0]), uint(coords[1]), uint(width), res.RowRoot))
+		}
+
+		_, err = client.GetSample(ctx, &dah.GetSampleRequest{Height: height, Row: width})
+		assert.Error(t, err)
+	})
+
+	t.Run("subscription streams the headers of new blocks", func(t *testing.T) {
+		stream, err := client.SubscribeDataAvailabilityHeaders(ctx, &dah.SubscribeDataAvailabilityHeadersRequest{})
+		require.NoError(t, err)
+
+		var previous uint64
+		for i := 0; i < 3; i++ {
+			res, err := stream.Recv()
+			require.NoError(t, err)
+			if previous != 0 {
+				assert.Equal(t, previous+1, res.Height)
+			}
+			previous = res.Height
+
+			height := int64(res.Height)
+			block, err := cctx.Client.Block(ctx, &height)
+			require.NoError(t, err)
+			assert.Equal(t, block.Block.DataHash.Bytes(), res.DataRoot)
+		}
+		require.NoError(t, stream.CloseSend())
+	})
+}
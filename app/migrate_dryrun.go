@@ -0,0 +1,110 @@
+package app
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/celestiaorg/celestia-app/v3/app/module"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+// MigrationReport summarizes the effect of migrating the latest committed
+// state from one app version to another.
+type MigrationReport struct {
+	// Height is the height of the state that was migrated.
+	Height      int64
+	FromVersion uint64
+	ToVersion   uint64
+	// AddedStores and DeletedStores are the names of the KV stores that are
+	// mounted and unmounted respectively by the upgrade.
+	AddedStores   []string
+	DeletedStores []string
+	// Modules contains an entry for every module that was migrated or added.
+	Modules []module.MigrationResult
+	// AppHash is the app hash of the state after the upgrade. It only
+	// includes the effects of the upgrade itself and not those of the other
+	// transactions and begin and end blockers of the upgrade block, so it
+	// differs from the app hash of the upgrade block on a live network.
+	AppHash []byte
+}
+
+// DryRunMigration runs the upgrade from fromVersion to toVersion on the latest
+// committed state the same way the EndBlocker and Commit do, i.e. the upgrade
+// side effects of the EndBlocker followed by the store and module migrations,
+// and reports on the outcome. If fromVersion is 0, the app version of the latest
+// committed state is used.
+//
+// NOTE: the migrated state is committed to the underlying database in order to
+// compute the resulting app hash. This method must therefore only be called on
+// an app that was created with a copy of a node's database.
+func (app *App) DryRunMigration(fromVersion, toVersion uint64) (MigrationReport, error) {
+	height := app.LastBlockHeight()
+	if height == 0 {
+		return MigrationReport{}, fmt.Errorf("no committed state to migrate")
+	}
+
+	ctx, err := app.CreateQueryContext(height, false)
+	if err != nil {
+		return MigrationReport{}, err
+	}
+	appVersion := app.GetAppVersionFromParamStore(ctx)
+	if appVersion == 0 {
+		appVersion = v1
+	}
+	if fromVersion == 0 {
+		fromVersion = appVersion
+	}
+	if fromVersion != appVersion {
+		return MigrationReport{}, fmt.Errorf("state at height %d has app version %d, not %d", height, appVersion, fromVersion)
+	}
+	if toVersion <= fromVersion {
+		return MigrationReport{}, fmt.Errorf("to version %d must be greater than from version %d", toVersion, fromVersion)
+	}
+	if !app.IsSealed() {
+		app.mountKeysAndInit(fromVersion)
+	}
+
+	storeMigrations, err := app.migrateCommitStore(fromVersion, toVersion)
+	if err != nil {
+		return MigrationReport{}, err
+	}
+	app.MountKVStores(storeMigrations.Added)
+	if err := app.CommitMultiStore().LoadLatestVersionAndUpgrade(storeMigrations.ToStoreUpgrades()); err != nil {
+		return MigrationReport{}, fmt.Errorf("upgrading stores: %w", err)
+	}
+
+	header := tmproto.Header{Height: height + 1}
+	header.Version.App = toVersion
+	cacheMultiStore := app.CommitMultiStore().CacheMultiStore()
+	ctx = sdk.NewContext(cacheMultiStore, header, false, app.Logger())
+	if err := app.upgradeAppVersion(ctx, fromVersion, toVersion); err != nil {
+		return MigrationReport{}, fmt.Errorf("upgrading app version: %w", err)
+	}
+
+	results, err := app.manager.RunMigrationsWithResults(ctx, app.configurator, fromVersion, toVersion)
+	if err != nil {
+		return MigrationReport{}, fmt.Errorf("migrating modules: %w", err)
+	}
+	cacheMultiStore.Write()
+	commitID := app.CommitMultiStore().Commit()
+
+	return MigrationReport{
+		Height:        height,
+		FromVersion:   fromVersion,
+		ToVersion:     toVersion,
+		AddedStores:   sortedKeys(storeMigrations.Added),
+		DeletedStores: sortedKeys(storeMigrations.Deleted),
+		Modules:       results,
+		AppHash:       commitID.Hash,
+	}, nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
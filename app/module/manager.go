@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"slices"
+	"time"
 
 	sdkmodule "github.com/cosmos/cosmos-sdk/types/module"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -220,9 +221,17 @@ func (m *Manager) assertNoForgottenModules(setOrderFnName string, moduleNames []
 // RunMigrations performs in-place store migrations for all modules. This
 // function MUST be called when the state machine changes appVersion
 func (m Manager) RunMigrations(ctx sdk.Context, cfg sdkmodule.Configurator, fromVersion, toVersion uint64) error {
+	_, err := m.RunMigrationsWithResults(ctx, cfg, fromVersion, toVersion)
+	return err
+}
+
+// RunMigrationsWithResults performs the same migrations as RunMigrations but
+// additionally returns the gas consumed and time taken by every module that
+// was either migrated or added as part of the upgrade.
+func (m Manager) RunMigrationsWithResults(ctx sdk.Context, cfg sdkmodule.Configurator, fromVersion, toVersion uint64) ([]MigrationResult, error) {
 	c, ok := cfg.(Configurator)
 	if !ok {
-		return sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", Configurator{}, cfg)
+		return nil, sdkerrors.ErrInvalidType.Wrapf("expected %T, got %T", Configurator{}, cfg)
 	}
 	modules := m.OrderMigrations
 	if modules == nil {
@@ -230,17 +239,24 @@ func (m Manager) RunMigrations(ctx sdk.Context, cfg sdkmodule.Configurator, from
 	}
 	currentVersionModules, exists := m.versionedModules[fromVersion]
 	if !exists {
-		return sdkerrors.ErrInvalidVersion.Wrapf("fromVersion %d not supported", fromVersion)
+		return nil, sdkerrors.ErrInvalidVersion.Wrapf("fromVersion %d not supported", fromVersion)
 	}
 	nextVersionModules, exists := m.versionedModules[toVersion]
 	if !exists {
-		return sdkerrors.ErrInvalidVersion.Wrapf("toVersion %d not supported", toVersion)
+		return nil, sdkerrors.ErrInvalidVersion.Wrapf("toVersion %d not supported", toVersion)
 	}
 
+	results := make([]MigrationResult, 0)
 	for _, moduleName := range modules {
 		currentModule, currentModuleExists := currentVersionModules[moduleName]
 		nextModule, nextModuleExists := nextVersionModules[moduleName]
 
+		// each module is metered separately so that the cost of every
+		// migration can be reported.
+		gasMeter := sdk.NewInfiniteGasMeter()
+		moduleCtx := ctx.WithGasMeter(gasMeter)
+		start := time.Now()
+
 		// if the module exists for both upgrades
 		if currentModuleExists && nextModuleExists {
 			// by using consensus version instead of app version we support the SDK's legacy method
@@ -250,23 +266,39 @@ func (m Manager) RunMigrations(ctx sdk.Context, cfg sdkmodule.Configurator, from
 			// version.
 			fromModuleVersion := currentModule.ConsensusVersion()
 			toModuleVersion := nextModule.ConsensusVersion()
-			err := c.runModuleMigrations(ctx, moduleName, fromModuleVersion, toModuleVersion)
+			err := c.runModuleMigrations(moduleCtx, moduleName, fromModuleVersion, toModuleVersion)
 			if err != nil {
-				return err
+				return nil, err
+			}
+			if fromModuleVersion != toModuleVersion {
+				results = append(results, MigrationResult{
+					ModuleName:  moduleName,
+					FromVersion: fromModuleVersion,
+					ToVersion:   toModuleVersion,
+					GasUsed:     gasMeter.GasConsumed(),
+					Duration:    time.Since(start),
+				})
 			}
 		} else if !currentModuleExists && nextModuleExists {
 			ctx.Logger().Info(fmt.Sprintf("adding a new module: %s", moduleName))
-			moduleValUpdates := nextModule.InitGenesis(ctx, c.cdc, nextModule.DefaultGenesis(c.cdc))
+			moduleValUpdates := nextModule.InitGenesis(moduleCtx, c.cdc, nextModule.DefaultGenesis(c.cdc))
 			// The module manager assumes only one module will update the
 			// validator set, and it can't be a new module.
 			if len(moduleValUpdates) > 0 {
-				return sdkerrors.ErrLogic.Wrap("validator InitGenesis update is already set by another module")
+				return nil, sdkerrors.ErrLogic.Wrap("validator InitGenesis update is already set by another module")
 			}
+			results = append(results, MigrationResult{
+				ModuleName: moduleName,
+				ToVersion:  nextModule.ConsensusVersion(),
+				Added:      true,
+				GasUsed:    gasMeter.GasConsumed(),
+				Duration:   time.Since(start),
+			})
 		}
 		// TODO: handle the case where a module is no longer supported (i.e. removed from the state machine)
 	}
 
-	return nil
+	return results, nil
 }
 
 // BeginBlock performs begin block functionality for all modules. It creates a
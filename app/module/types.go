@@ -1,6 +1,8 @@
 package module
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkmodule "github.com/cosmos/cosmos-sdk/types/module"
 )
@@ -16,3 +18,17 @@ type VersionedModule struct {
 
 // MigrationHandler is the migration function that each module registers.
 type MigrationHandler func(sdk.Context) error
+
+// MigrationResult describes the migration of a single module during an app
+// version upgrade.
+type MigrationResult struct {
+	ModuleName string
+	// FromVersion and ToVersion are the consensus versions of the module
+	// before and after the migration. FromVersion is 0 if the module was added.
+	FromVersion, ToVersion uint64
+	// Added is true if the module did not exist in the previous app version
+	// and was initialized from its default genesis.
+	Added    bool
+	GasUsed  uint64
+	Duration time.Duration
+}
@@ -12,8 +12,12 @@ import (
 	"github.com/celestiaorg/celestia-app/v3/x/minfee"
 	"github.com/celestiaorg/celestia-app/v3/x/mint"
 	minttypes "github.com/celestiaorg/celestia-app/v3/x/mint/types"
+	"github.com/celestiaorg/celestia-app/v3/x/nsreg"
+	nsregtypes "github.com/celestiaorg/celestia-app/v3/x/nsreg/types"
+	"github.com/celestiaorg/celestia-app/v3/x/paramfilter"
 	"github.com/celestiaorg/celestia-app/v3/x/signal"
 	signaltypes "github.com/celestiaorg/celestia-app/v3/x/signal/types"
+	"github.com/celestiaorg/celestia-app/v3/x/tokenfilter"
 	sdkmodule "github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -82,6 +86,8 @@ var (
 		blobstream.AppModuleBasic{},
 		signal.AppModuleBasic{},
 		minfee.AppModuleBasic{},
+		nsreg.AppModuleBasic{},
+		paramfilter.AppModuleBasic{DefaultPolicies: DefaultParamPolicies()},
 		packetforward.AppModuleBasic{},
 		icaModule{},
 	)
@@ -175,9 +181,17 @@ func (app *App) setupModuleManager(skipGenesisInvariants bool) error {
 			FromVersion: v2, ToVersion: v3,
 		},
 		{
-			Module:      minfee.NewAppModule(app.ParamsKeeper),
+			Module:      minfee.NewAppModule(app.MinFeeKeeper),
 			FromVersion: v2, ToVersion: v3,
 		},
+		{
+			Module:      nsreg.NewAppModule(app.NsregKeeper),
+			FromVersion: v3, ToVersion: v3,
+		},
+		{
+			Module:      paramfilter.NewAppModule(app.ParamFilterKeeper, DefaultParamPolicies()...),
+			FromVersion: v3, ToVersion: v3,
+		},
 		{
 			Module:      packetforward.NewAppModule(app.PacketForwardKeeper),
 			FromVersion: v2, ToVersion: v3,
@@ -220,6 +234,8 @@ func (app *App) setModuleOrder() {
 		vestingtypes.ModuleName,
 		signaltypes.ModuleName,
 		minfee.ModuleName,
+		nsregtypes.ModuleName,
+		paramfilter.ModuleName,
 		icatypes.ModuleName,
 		packetforwardtypes.ModuleName,
 	)
@@ -246,6 +262,8 @@ func (app *App) setModuleOrder() {
 		vestingtypes.ModuleName,
 		signaltypes.ModuleName,
 		minfee.ModuleName,
+		nsregtypes.ModuleName,
+		paramfilter.ModuleName,
 		packetforwardtypes.ModuleName,
 		icatypes.ModuleName,
 	)
@@ -279,6 +297,8 @@ func (app *App) setModuleOrder() {
 		paramstypes.ModuleName,
 		authz.ModuleName,
 		signaltypes.ModuleName,
+		nsregtypes.ModuleName,
+		paramfilter.ModuleName,
 		packetforwardtypes.ModuleName,
 		icatypes.ModuleName,
 	)
@@ -297,6 +317,10 @@ func allStoreKeys() []string {
 		icahosttypes.StoreKey,
 		signaltypes.StoreKey,
 		blobtypes.StoreKey,
+		minfee.StoreKey,
+		nsregtypes.StoreKey,
+		tokenfilter.StoreKey,
+		paramfilter.StoreKey,
 	}
 }
 
@@ -341,7 +365,7 @@ func versionedStoreKeys() map[uint64][]string {
 			stakingtypes.StoreKey,
 			upgradetypes.StoreKey,
 		},
-		v3: { // same as v2
+		v3: {
 			authtypes.StoreKey,
 			authzkeeper.StoreKey,
 			banktypes.StoreKey,
@@ -354,11 +378,15 @@ func versionedStoreKeys() map[uint64][]string {
 			ibchost.StoreKey,
 			ibctransfertypes.StoreKey,
 			icahosttypes.StoreKey,
+			minfee.StoreKey, // added in v3
 			minttypes.StoreKey,
+			nsregtypes.StoreKey, // added in v3
 			packetforwardtypes.StoreKey,
+			paramfilter.StoreKey, // added in v3
 			signaltypes.StoreKey,
 			slashingtypes.StoreKey,
 			stakingtypes.StoreKey,
+			tokenfilter.StoreKey, // added in v3
 			upgradetypes.StoreKey,
 		},
 	}
@@ -0,0 +1,53 @@
+package posthandler
+
+import (
+	"cosmossdk.io/errors"
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/celestia-app/v3/x/minfee"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeeBurnDecorator burns the governance set fraction of the fee of every
+// PayForBlobs transaction after its messages have been executed. It only
+// applies to app versions > 2.
+type FeeBurnDecorator struct {
+	k minfee.Keeper
+}
+
+func NewFeeBurnDecorator(k minfee.Keeper) FeeBurnDecorator {
+	return FeeBurnDecorator{k}
+}
+
+// AnteHandle implements the Cosmos SDK AnteHandler function signature.
+func (d FeeBurnDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.BlockHeader().Version.App <= v2.Version {
+		return next(ctx, tx, simulate)
+	}
+
+	var blobSizes []uint32
+	isPFB := false
+	for _, m := range tx.GetMsgs() {
+		if pfb, ok := m.(*blobtypes.MsgPayForBlobs); ok {
+			isPFB = true
+			blobSizes = append(blobSizes, pfb.BlobSizes...)
+		}
+	}
+	if !isPFB {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+	// Burning is protocol bookkeeping so it doesn't consume the gas of the
+	// transaction which would otherwise exceed the estimates of PayForBlobs.
+	burnCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	if err := d.k.BurnFees(burnCtx, feeTx, blobSizes); err != nil {
+		return ctx, errors.Wrap(err, "burning fees")
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -1,12 +1,17 @@
 package posthandler
 
 import (
+	"github.com/celestiaorg/celestia-app/v3/x/minfee"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // New returns a new posthandler chain. Note: the Cosmos SDK does not export a
 // type for PostHandler so the AnteHandler type is used.
-func New() sdk.AnteHandler {
-	postDecorators := []sdk.AnteDecorator{}
+func New(minfeeKeeper minfee.Keeper) sdk.AnteHandler {
+	postDecorators := []sdk.AnteDecorator{
+		// Burn the governance set fraction of PayForBlobs fees. Only applies
+		// to app version > 2.
+		NewFeeBurnDecorator(minfeeKeeper),
+	}
 	return sdk.ChainAnteDecorators(postDecorators...)
 }
@@ -43,14 +43,15 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 		app.IBCKeeper,
 		app.ParamsKeeper,
 		app.MsgGateKeeper,
+		app.MinFeeKeeper,
+		app.NsregKeeper,
 	)
 
-	// Filter out invalid transactions.
-	txs := FilterTxs(app.Logger(), sdkCtx, handler, app.txConfig, req.BlockData.Txs)
-
-	// Build the square from the set of valid and prioritised transactions.
-	// The txs returned are the ones used in the square and block.
+	// Filter out invalid transactions and build the square from the set of
+	// valid and prioritised transactions. The txs returned are the ones used
+	// in the square and block.
 	var (
+		txs             [][]byte
 		dataSquareBytes [][]byte
 		err             error
 		size            uint64
@@ -58,13 +59,14 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 	switch app.AppVersion() {
 	case v3:
 		var dataSquare squarev2.Square
-		dataSquare, txs, err = squarev2.Build(txs,
+		dataSquare, txs, err = FilterTxsAndBuildSquare(app.Logger(), sdkCtx, handler, app.txConfig, req.BlockData.Txs,
 			app.MaxEffectiveSquareSize(sdkCtx),
 			appconsts.SubtreeRootThreshold(app.GetBaseApp().AppVersion()),
 		)
 		dataSquareBytes = sharev2.ToBytes(dataSquare)
 		size = uint64(dataSquare.Size())
 	case v2, v1:
+		txs = FilterTxs(app.Logger(), sdkCtx, handler, app.txConfig, req.BlockData.Txs)
 		var dataSquare square.Square
 		dataSquare, txs, err = square.Build(txs,
 			app.MaxEffectiveSquareSize(sdkCtx),
@@ -82,7 +84,7 @@ func (app *App) PrepareProposal(req abci.RequestPrepareProposal) abci.ResponsePr
 	// Erasure encode the data square to create the extended data square (eds).
 	// Note: uses the nmt wrapper to construct the tree. See
 	// pkg/wrapper/nmt_wrapper.go for more information.
-	eds, err := da.ExtendShares(dataSquareBytes)
+	eds, err := da.ExtendSharesForVersion(dataSquareBytes, app.AppVersion())
 	if err != nil {
 		app.Logger().Error(
 			"failure to erasure the data square while creating a proposal block",
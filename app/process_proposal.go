@@ -50,6 +50,8 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.Resp
 		app.IBCKeeper,
 		app.ParamsKeeper,
 		app.MsgGateKeeper,
+		app.MinFeeKeeper,
+		app.NsregKeeper,
 	)
 	sdkCtx := app.NewProposalContext(req.Header)
 	subtreeRootThreshold := appconsts.SubtreeRootThreshold(app.GetBaseApp().AppVersion())
@@ -157,7 +159,7 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.Resp
 		return reject()
 	}
 
-	eds, err := da.ExtendShares(dataSquareBytes)
+	eds, err := da.ExtendSharesForVersion(dataSquareBytes, app.AppVersion())
 	if err != nil {
 		logInvalidPropBlockError(app.Logger(), req.Header, "failure to erasure the data square", err)
 		return reject()
@@ -176,6 +178,12 @@ func (app *App) ProcessProposal(req abci.RequestProcessProposal) (resp abci.Resp
 		return reject()
 	}
 
+	// keep the extended data square so that it doesn't have to be recomputed
+	// to serve proofs. This doesn't affect whether the proposal is accepted.
+	if err := app.edsStore.Put(req.Header.Height, app.AppVersion(), dah.Hash(), eds); err != nil {
+		app.Logger().Error("failed to store the extended data square", "height", req.Header.Height, "err", err)
+	}
+
 	return accept()
 }
 
@@ -0,0 +1,47 @@
+package app_test
+
+import (
+	"testing"
+
+	blobstreamtypes "github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	"github.com/celestiaorg/celestia-app/v3/x/minfee"
+	signaltypes "github.com/celestiaorg/celestia-app/v3/x/signal/types"
+	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v6/packetforward/types"
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDryRunMigration(t *testing.T) {
+	t.Run("should report the v1 to v2 migration", func(t *testing.T) {
+		testApp, _ := SetupTestAppWithUpgradeHeight(t, 3)
+
+		report, err := testApp.DryRunMigration(0, 2)
+		require.NoError(t, err)
+
+		assert.EqualValues(t, 1, report.Height)
+		assert.EqualValues(t, 1, report.FromVersion)
+		assert.EqualValues(t, 2, report.ToVersion)
+		assert.Equal(t, []string{icahosttypes.StoreKey, packetforwardtypes.StoreKey, signaltypes.StoreKey}, report.AddedStores)
+		assert.Equal(t, []string{blobstreamtypes.StoreKey}, report.DeletedStores)
+		assert.NotEmpty(t, report.AppHash)
+
+		// the upgrade side effects of the EndBlocker are applied as well
+		_, found := testApp.ParamsKeeper.GetSubspace(blobstreamtypes.ModuleName)
+		assert.False(t, found)
+
+		added := make(map[string]bool)
+		for _, result := range report.Modules {
+			added[result.ModuleName] = result.Added
+		}
+		assert.True(t, added[minfee.ModuleName])
+		assert.True(t, added[signaltypes.ModuleName])
+		assert.True(t, added[packetforwardtypes.ModuleName])
+	})
+	t.Run("should reject a from version that does not match the state", func(t *testing.T) {
+		testApp, _ := SetupTestAppWithUpgradeHeight(t, 3)
+
+		_, err := testApp.DryRunMigration(2, 3)
+		require.Error(t, err)
+	})
+}
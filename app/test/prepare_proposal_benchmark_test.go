@@ -0,0 +1,87 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/user"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	square "github.com/celestiaorg/go-square/v2"
+)
+
+// saturatedMempool returns a test app along with blob transactions that
+// together take roughly twice the shares of the default max square.
+func saturatedMempool(b *testing.B) (*app.App, [][]byte) {
+	encConf := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := testfactory.GenerateAccounts(400)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	txs := make([][]byte, len(accounts))
+	for i, name := range accounts {
+		addr := testfactory.GetAddress(kr, name)
+		acc := testutil.DirectQueryAccount(testApp, addr)
+		account := user.NewAccount(name, acc.GetAccountNumber(), acc.GetSequence())
+		signer, err := user.NewSigner(kr, encConf.TxConfig, testutil.ChainID, testApp.AppVersion(), account)
+		require.NoError(b, err)
+		_, blobs := blobfactory.RandMsgPayForBlobsWithSigner(tmrand.NewRand(), addr.String(), 10_000, 1)
+		txs[i], _, err = signer.CreatePayForBlobs(name, blobs, blobfactory.DefaultTxOpts()...)
+		require.NoError(b, err)
+	}
+	return testApp, txs
+}
+
+func BenchmarkPrepareProposalSaturatedMempool(b *testing.B) {
+	testApp, txs := saturatedMempool(b)
+	req := abci.RequestPrepareProposal{
+		BlockData: &tmproto.Data{Txs: txs},
+		ChainId:   testutil.ChainID,
+		Height:    testApp.LastBlockHeight() + 1,
+		Time:      time.Now(),
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		resp := testApp.PrepareProposal(req)
+		require.Less(b, len(resp.BlockData.Txs), len(txs))
+	}
+}
+
+func BenchmarkFilterTxsSaturatedMempool(b *testing.B) {
+	testApp, txs := saturatedMempool(b)
+	encConf := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	handler := newAnteHandler(testApp)
+	subtreeRootThreshold := appconsts.SubtreeRootThreshold(testApp.AppVersion())
+	header := tmproto.Header{
+		ChainID: testutil.ChainID,
+		Height:  testApp.LastBlockHeight() + 1,
+		Time:    time.Now(),
+		Version: version.Consensus{App: testApp.AppVersion()},
+	}
+
+	b.Run("filter then build", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ctx := testApp.NewProposalContext(header)
+			filtered := app.FilterTxs(testApp.Logger(), ctx, handler, encConf.TxConfig, append([][]byte{}, txs...))
+			_, _, err := square.Build(filtered, appconsts.DefaultGovMaxSquareSize, subtreeRootThreshold)
+			require.NoError(b, err)
+		}
+	})
+
+	b.Run("incremental", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			ctx := testApp.NewProposalContext(header)
+			_, _, err := app.FilterTxsAndBuildSquare(testApp.Logger(), ctx, handler, encConf.TxConfig, append([][]byte{}, txs...), appconsts.DefaultGovMaxSquareSize, subtreeRootThreshold)
+			require.NoError(b, err)
+		}
+	})
+}
@@ -8,17 +8,21 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto/hd"
 	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
 	coretypes "github.com/tendermint/tendermint/types"
 
 	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/ante"
 	"github.com/celestiaorg/celestia-app/v3/app/encoding"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
 	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
 	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	square "github.com/celestiaorg/go-square/v2"
 	"github.com/celestiaorg/go-square/v2/share"
 )
 
@@ -216,3 +220,73 @@ func queryAccountInfo(capp *app.App, accs []string, kr keyring.Keyring) []blobfa
 	}
 	return infos
 }
+
+func TestFilterTxsAndBuildSquareSaturatedMempool(t *testing.T) {
+	encConf := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	accounts := testfactory.GenerateAccounts(120)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+
+	// each blob tx takes roughly 43 shares so together they don't fit in the
+	// default max square of 64x64 shares
+	txs := coretypes.Txs(testutil.RandBlobTxsWithAccounts(
+		t,
+		testApp,
+		encConf.TxConfig,
+		kr,
+		20_000,
+		1,
+		false,
+		testutil.ChainID,
+		accounts,
+	)).ToSliceOfBytes()
+
+	ctx := testApp.NewProposalContext(tmproto.Header{
+		ChainID: testutil.ChainID,
+		Height:  testApp.LastBlockHeight() + 1,
+		Time:    time.Now(),
+		Version: version.Consensus{App: testApp.AppVersion()},
+	})
+	dataSquare, included, err := app.FilterTxsAndBuildSquare(
+		testApp.Logger(),
+		ctx,
+		newAnteHandler(testApp),
+		encConf.TxConfig,
+		txs,
+		appconsts.DefaultGovMaxSquareSize,
+		appconsts.SubtreeRootThreshold(testApp.AppVersion()),
+	)
+	require.NoError(t, err)
+	require.Less(t, len(included), len(txs))
+	require.Equal(t, txs[:len(included)], included)
+
+	// the square must be the one that is constructed from the included txs
+	expected, err := square.Construct(included, appconsts.DefaultGovMaxSquareSize, appconsts.SubtreeRootThreshold(testApp.AppVersion()))
+	require.NoError(t, err)
+	require.True(t, expected.Equals(dataSquare))
+
+	// only the transactions included in the square modify the state
+	for i, acc := range accounts {
+		sequence := testApp.AccountKeeper.GetAccount(ctx, testfactory.GetAddress(kr, acc)).GetSequence()
+		if i < len(included) {
+			require.EqualValues(t, 1, sequence, "account %d", i)
+		} else {
+			require.EqualValues(t, 0, sequence, "account %d", i)
+		}
+	}
+}
+
+func newAnteHandler(testApp *app.App) sdk.AnteHandler {
+	return ante.NewAnteHandler(
+		testApp.AccountKeeper,
+		testApp.BankKeeper,
+		testApp.BlobKeeper,
+		testApp.FeeGrantKeeper,
+		testApp.GetTxConfig().SignModeHandler(),
+		ante.DefaultSigVerificationGasConsumer,
+		testApp.IBCKeeper,
+		testApp.ParamsKeeper,
+		testApp.MsgGateKeeper,
+		testApp.MinFeeKeeper,
+		testApp.NsregKeeper,
+	)
+}
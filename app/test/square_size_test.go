@@ -47,7 +47,10 @@ func (s *SquareSizeIntegrationTest) SetupSuite() {
 	t.Log("setting up square size integration test")
 	s.ecfg = encoding.MakeConfig(app.ModuleEncodingRegisters...)
 	cfg := testnode.DefaultConfig().
-		WithModifiers(genesis.ImmediateProposals(s.ecfg.Codec))
+		WithModifiers(
+			genesis.ImmediateProposals(s.ecfg.Codec),
+			genesis.ImmediateParamChanges(s.ecfg.Codec),
+		)
 
 	cctx, rpcAddr, grpcAddr := testnode.NewNetwork(t, cfg)
 
@@ -1,6 +1,10 @@
 package app
 
 import (
+	"errors"
+
+	squarev2 "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
 	"github.com/celestiaorg/go-square/v2/tx"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -34,22 +38,69 @@ func separateTxs(_ client.TxConfig, rawTxs [][]byte) ([][]byte, []*tx.BlobTx) {
 // Side-effect: arranges all normal transactions before all blob transactions.
 func FilterTxs(logger log.Logger, ctx sdk.Context, handler sdk.AnteHandler, txConfig client.TxConfig, txs [][]byte) [][]byte {
 	normalTxs, blobTxs := separateTxs(txConfig, txs)
-	normalTxs, ctx = filterStdTxs(logger, txConfig.TxDecoder(), ctx, handler, normalTxs)
-	blobTxs, _ = filterBlobTxs(logger, txConfig.TxDecoder(), ctx, handler, blobTxs)
+	// Without a square builder the filters can't fail.
+	normalTxs, ctx, _ = filterStdTxs(logger, txConfig.TxDecoder(), ctx, handler, normalTxs, nil)
+	blobTxs, _, _ = filterBlobTxs(logger, txConfig.TxDecoder(), ctx, handler, blobTxs, nil)
 	return append(normalTxs, encodeBlobTxs(blobTxs)...)
 }
 
+// FilterTxsAndBuildSquare applies the antehandler to the proposed transactions
+// while incrementally building the square from the ones that pass it. A
+// transaction is only passed to the antehandler after it has been appended to
+// the square, so transactions that don't fit are dropped without being
+// evaluated and don't leave their state changes behind. Evaluation stops once
+// the square is full. It returns the square and the transactions included in
+// it.
+//
+// Side-effect: arranges all normal transactions before all blob transactions.
+func FilterTxsAndBuildSquare(
+	logger log.Logger,
+	ctx sdk.Context,
+	handler sdk.AnteHandler,
+	txConfig client.TxConfig,
+	txs [][]byte,
+	maxSquareSize int,
+	subtreeRootThreshold int,
+) (squarev2.Square, [][]byte, error) {
+	builder, err := newSquareBuilder(maxSquareSize, subtreeRootThreshold)
+	if err != nil {
+		return nil, nil, err
+	}
+	normalTxs, blobTxs := separateTxs(txConfig, txs)
+	normalTxs, ctx, err = filterStdTxs(logger, txConfig.TxDecoder(), ctx, handler, normalTxs, builder)
+	if err != nil {
+		return nil, nil, err
+	}
+	blobTxs, _, err = filterBlobTxs(logger, txConfig.TxDecoder(), ctx, handler, blobTxs, builder)
+	if err != nil {
+		return nil, nil, err
+	}
+	dataSquare, err := builder.export()
+	if err != nil {
+		return nil, nil, err
+	}
+	return dataSquare, append(normalTxs, encodeBlobTxs(blobTxs)...), nil
+}
+
 // filterStdTxs applies the provided antehandler to each transaction and removes
 // transactions that return an error. Panics are caught by the checkTxValidity
-// function used to apply the ante handler.
-func filterStdTxs(logger log.Logger, dec sdk.TxDecoder, ctx sdk.Context, handler sdk.AnteHandler, txs [][]byte) ([][]byte, sdk.Context) {
+// function used to apply the ante handler. If builder is not nil, transactions
+// that don't fit in the square are removed as well.
+func filterStdTxs(logger log.Logger, dec sdk.TxDecoder, ctx sdk.Context, handler sdk.AnteHandler, txs [][]byte, builder *squareBuilder) ([][]byte, sdk.Context, error) {
 	n := 0
 	for _, tx := range txs {
+		if builder.isFull() {
+			break
+		}
 		sdkTx, err := dec(tx)
 		if err != nil {
 			logger.Error("decoding already checked transaction", "tx", tmbytes.HexBytes(coretypes.Tx(tx).Hash()), "error", err)
 			continue
 		}
+		if !builder.appendTx(tx) {
+			telemetry.IncrCounter(1, "prepare_proposal", "unfit_std_txs")
+			continue
+		}
 		ctx, err = handler(ctx, sdkTx, false)
 		// either the transaction is invalid (ie incorrect nonce) and we
 		// simply want to remove this tx, or we're catching a panic from one
@@ -62,6 +113,9 @@ func filterStdTxs(logger log.Logger, dec sdk.TxDecoder, ctx sdk.Context, handler
 				"msgs", msgTypes(sdkTx),
 			)
 			telemetry.IncrCounter(1, "prepare_proposal", "invalid_std_txs")
+			if err := builder.revert(); err != nil {
+				return nil, ctx, err
+			}
 			continue
 		}
 		txs[n] = tx
@@ -69,20 +123,28 @@ func filterStdTxs(logger log.Logger, dec sdk.TxDecoder, ctx sdk.Context, handler
 
 	}
 
-	return txs[:n], ctx
+	return txs[:n], ctx, nil
 }
 
 // filterBlobTxs applies the provided antehandler to each transaction
 // and removes transactions that return an error. Panics are caught by the checkTxValidity
-// function used to apply the ante handler.
-func filterBlobTxs(logger log.Logger, dec sdk.TxDecoder, ctx sdk.Context, handler sdk.AnteHandler, txs []*tx.BlobTx) ([]*tx.BlobTx, sdk.Context) {
+// function used to apply the ante handler. If builder is not nil, transactions
+// that don't fit in the square are removed as well.
+func filterBlobTxs(logger log.Logger, dec sdk.TxDecoder, ctx sdk.Context, handler sdk.AnteHandler, txs []*tx.BlobTx, builder *squareBuilder) ([]*tx.BlobTx, sdk.Context, error) {
 	n := 0
 	for _, tx := range txs {
+		if builder.isFull() {
+			break
+		}
 		sdkTx, err := dec(tx.Tx)
 		if err != nil {
 			logger.Error("decoding already checked blob transaction", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()), "error", err)
 			continue
 		}
+		if !builder.appendBlobTx(tx) {
+			telemetry.IncrCounter(1, "prepare_proposal", "unfit_blob_txs")
+			continue
+		}
 		ctx, err = handler(ctx, sdkTx, false)
 		// either the transaction is invalid (ie incorrect nonce) and we
 		// simply want to remove this tx, or we're catching a panic from one
@@ -92,6 +154,9 @@ func filterBlobTxs(logger log.Logger, dec sdk.TxDecoder, ctx sdk.Context, handle
 				"filtering already checked blob transaction", "tx", tmbytes.HexBytes(coretypes.Tx(tx.Tx).Hash()), "error", err,
 			)
 			telemetry.IncrCounter(1, "prepare_proposal", "invalid_blob_txs")
+			if err := builder.revert(); err != nil {
+				return nil, ctx, err
+			}
 			continue
 		}
 		txs[n] = tx
@@ -99,7 +164,103 @@ func filterBlobTxs(logger log.Logger, dec sdk.TxDecoder, ctx sdk.Context, handle
 
 	}
 
-	return txs[:n], ctx
+	return txs[:n], ctx, nil
+}
+
+// squareBuilder incrementally builds the square from the transactions that
+// pass the antehandler. A nil squareBuilder accepts every transaction.
+type squareBuilder struct {
+	builder       *squarev2.Builder
+	maxSquareSize int
+
+	// snapshot, txCounter and pfbCounter hold the state of the builder
+	// before the last append. hasSnapshot is false if there is nothing to
+	// revert.
+	snapshot    squarev2.Builder
+	txCounter   share.CompactShareCounter
+	pfbCounter  share.CompactShareCounter
+	hasSnapshot bool
+}
+
+func newSquareBuilder(maxSquareSize, subtreeRootThreshold int) (*squareBuilder, error) {
+	builder, err := squarev2.NewBuilder(maxSquareSize, subtreeRootThreshold)
+	if err != nil {
+		return nil, err
+	}
+	return &squareBuilder{
+		builder:       builder,
+		maxSquareSize: maxSquareSize,
+	}, nil
+}
+
+// isFull returns true if the square has no space left for any transaction.
+func (b *squareBuilder) isFull() bool {
+	if b == nil {
+		return false
+	}
+	return b.builder.CurrentSize() >= b.maxSquareSize*b.maxSquareSize
+}
+
+// appendTx appends the normal transaction to the square. It returns false if
+// the transaction doesn't fit.
+func (b *squareBuilder) appendTx(rawTx []byte) bool {
+	if b == nil {
+		return true
+	}
+	b.takeSnapshot()
+	if !b.builder.AppendTx(rawTx) {
+		b.hasSnapshot = false
+		return false
+	}
+	return true
+}
+
+// appendBlobTx appends the blob transaction to the square. It returns false if
+// the transaction doesn't fit.
+func (b *squareBuilder) appendBlobTx(blobTx *tx.BlobTx) bool {
+	if b == nil {
+		return true
+	}
+	b.takeSnapshot()
+	if !b.builder.AppendBlobTx(blobTx) {
+		b.hasSnapshot = false
+		return false
+	}
+	return true
+}
+
+// revert removes the last appended transaction from the square by restoring
+// the builder to the snapshot taken before it was appended. This only happens
+// for transactions that fail the antehandler, which is rare as all of them
+// have already passed CheckTx.
+func (b *squareBuilder) revert() error {
+	if b == nil {
+		return nil
+	}
+	if !b.hasSnapshot {
+		return errors.New("reverting square builder: no appended transaction to revert")
+	}
+	*b.builder = b.snapshot
+	*b.builder.TxCounter = b.txCounter
+	*b.builder.PfbCounter = b.pfbCounter
+	b.hasSnapshot = false
+	return nil
+}
+
+// takeSnapshot copies the state of the builder so that the next append can be
+// reverted. Appends only ever grow the builder's slices so a shallow copy of
+// them is enough; the share counters are updated in place and are copied by
+// value.
+func (b *squareBuilder) takeSnapshot() {
+	b.snapshot = *b.builder
+	b.txCounter = *b.builder.TxCounter
+	b.pfbCounter = *b.builder.PfbCounter
+	b.hasSnapshot = true
+}
+
+// export constructs the square from the appended transactions.
+func (b *squareBuilder) export() (squarev2.Square, error) {
+	return b.builder.Export()
 }
 
 func msgTypes(sdkTx sdk.Tx) []string {
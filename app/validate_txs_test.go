@@ -0,0 +1,47 @@
+package app
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	squarev2 "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/go-square/v2/tx"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSquareBuilderRevert(t *testing.T) {
+	maxSquareSize := 8
+	threshold := appconsts.DefaultSubtreeRootThreshold
+	keptTx := bytes.Repeat([]byte{1}, 100)
+	revertedTx := bytes.Repeat([]byte{2}, 1000)
+	blob, err := share.NewV0Blob(share.MustNewV0Namespace(bytes.Repeat([]byte{1}, 10)), bytes.Repeat([]byte{3}, 1000))
+	require.NoError(t, err)
+	keptBlobTx := &tx.BlobTx{Tx: bytes.Repeat([]byte{4}, 100), Blobs: []*share.Blob{blob}}
+	revertedBlobTx := &tx.BlobTx{Tx: bytes.Repeat([]byte{5}, 100), Blobs: []*share.Blob{blob, blob}}
+
+	builder, err := newSquareBuilder(maxSquareSize, threshold)
+	require.NoError(t, err)
+	require.Error(t, builder.revert(), "nothing has been appended yet")
+
+	require.True(t, builder.appendTx(keptTx))
+	require.True(t, builder.appendTx(revertedTx))
+	require.NoError(t, builder.revert())
+	require.Error(t, builder.revert(), "only the last append can be reverted")
+	require.True(t, builder.appendBlobTx(keptBlobTx))
+	require.True(t, builder.appendBlobTx(revertedBlobTx))
+	require.NoError(t, builder.revert())
+
+	got, err := builder.export()
+	require.NoError(t, err)
+
+	want, err := squarev2.NewBuilder(maxSquareSize, threshold)
+	require.NoError(t, err)
+	require.True(t, want.AppendTx(keptTx))
+	require.True(t, want.AppendBlobTx(keptBlobTx))
+	wantSquare, err := want.Export()
+	require.NoError(t, err)
+	require.Equal(t, wantSquare, got)
+	require.Equal(t, want.CurrentSize(), builder.builder.CurrentSize())
+}
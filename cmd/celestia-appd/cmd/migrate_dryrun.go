@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+)
+
+const (
+	flagFromVersion = "from-version"
+	flagToVersion   = "to-version"
+	flagScratchDir  = "scratch-dir"
+
+	// copyBatchSize is the number of key-value pairs written per batch when
+	// copying the application database.
+	copyBatchSize = 10_000
+)
+
+// migrateDryRunCommand returns a command that rehearses a state migration
+// against a copy of the node's application database.
+func migrateDryRunCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-dryrun",
+		Short: "Rehearse the state migration of an app version upgrade against a copy of the node's state",
+		Long: "Copy the application database of a stopped node into a scratch directory, run the upgrade from --from-version to " +
+			"--to-version on the latest committed state and report the added and deleted stores, the migrated modules with the " +
+			"gas and time each migration took, and the resulting app hash.\n" +
+			"The app hash only includes the effects of the upgrade and not those of the rest of the upgrade block.\n" +
+			"The node's own database is only read and is never modified.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			home := serverCtx.Config.RootDir
+
+			fromVersion, err := cmd.Flags().GetUint64(flagFromVersion)
+			if err != nil {
+				return err
+			}
+			toVersion, err := cmd.Flags().GetUint64(flagToVersion)
+			if err != nil {
+				return err
+			}
+			scratchDir, err := cmd.Flags().GetString(flagScratchDir)
+			if err != nil {
+				return err
+			}
+			if scratchDir == "" {
+				scratchDir, err = os.MkdirTemp("", "celestia-appd-migrate-dryrun")
+				if err != nil {
+					return err
+				}
+				defer os.RemoveAll(scratchDir)
+			}
+
+			backend := server.GetAppDBBackend(serverCtx.Viper)
+			db, err := openDB(home, backend)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			scratchDB, err := dbm.NewDB("application", backend, scratchDir)
+			if err != nil {
+				return err
+			}
+			defer scratchDB.Close()
+
+			cmd.PrintErrf("copying application database to %s\n", filepath.Join(scratchDir, "application.db"))
+			if err := copyDB(db, scratchDB); err != nil {
+				return fmt.Errorf("copying application database: %w", err)
+			}
+
+			encodingConfig := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+			application := app.New(serverCtx.Logger, scratchDB, nil, 0, encodingConfig, 0, serverCtx.Viper)
+			report, err := application.DryRunMigration(fromVersion, toVersion)
+			if err != nil {
+				return err
+			}
+			return printMigrationReport(cmd, report)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, app.DefaultNodeHome, "The application home directory")
+	cmd.Flags().Uint64(flagFromVersion, 0, "The app version to migrate from. Defaults to the app version of the latest committed state")
+	cmd.Flags().Uint64(flagToVersion, 0, "The app version to migrate to")
+	cmd.Flags().String(flagScratchDir, "", "The directory to copy the application database into. Defaults to a temporary directory that is removed afterwards")
+	_ = cmd.MarkFlagRequired(flagToVersion)
+
+	return cmd
+}
+
+// copyDB copies every key-value pair in src to dst.
+func copyDB(src, dst dbm.DB) error {
+	iterator, err := src.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	batch := dst.NewBatch()
+	count := 0
+	for ; iterator.Valid(); iterator.Next() {
+		if err := batch.Set(iterator.Key(), iterator.Value()); err != nil {
+			return err
+		}
+		count++
+		if count%copyBatchSize == 0 {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Close()
+			batch = dst.NewBatch()
+		}
+	}
+	if err := iterator.Error(); err != nil {
+		return err
+	}
+	defer batch.Close()
+	return batch.WriteSync()
+}
+
+func printMigrationReport(cmd *cobra.Command, report app.MigrationReport) error {
+	type moduleMigration struct {
+		Module      string `json:"module"`
+		FromVersion uint64 `json:"from_version"`
+		ToVersion   uint64 `json:"to_version"`
+		Added       bool   `json:"added"`
+		GasUsed     uint64 `json:"gas_used"`
+		Duration    string `json:"duration"`
+	}
+	output := struct {
+		Height        int64             `json:"height"`
+		FromVersion   uint64            `json:"from_version"`
+		ToVersion     uint64            `json:"to_version"`
+		AddedStores   []string          `json:"added_stores"`
+		DeletedStores []string          `json:"deleted_stores"`
+		Modules       []moduleMigration `json:"modules"`
+		AppHash       string            `json:"app_hash"`
+	}{
+		Height:        report.Height,
+		FromVersion:   report.FromVersion,
+		ToVersion:     report.ToVersion,
+		AddedStores:   report.AddedStores,
+		DeletedStores: report.DeletedStores,
+		Modules:       make([]moduleMigration, len(report.Modules)),
+		AppHash:       hex.EncodeToString(report.AppHash),
+	}
+	for i, result := range report.Modules {
+		output.Modules[i] = moduleMigration{
+			Module:      result.ModuleName,
+			FromVersion: result.FromVersion,
+			ToVersion:   result.ToVersion,
+			Added:       result.Added,
+			GasUsed:     result.GasUsed,
+			Duration:    result.Duration.String(),
+		}
+	}
+
+	bz, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+	return err
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/x/tokenfilter"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
@@ -28,6 +29,7 @@ func queryCommand() *cobra.Command {
 	)
 
 	app.ModuleBasics.AddQueryCommands(command)
+	command.AddCommand(tokenfilter.GetQueryCmd())
 	command.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
 
 	return command
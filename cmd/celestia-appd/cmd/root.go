@@ -113,6 +113,7 @@ func initRootCommand(rootCommand *cobra.Command, encodingConfig encoding.Config)
 		addrbookCommand(),
 		downloadGenesisCommand(),
 		addrConversionCmd(),
+		migrateDryRunCommand(),
 		rpc.StatusCommand(),
 		queryCommand(),
 		txCommand(),
@@ -136,6 +137,8 @@ func setDefaultConsensusParams(command *cobra.Command) error {
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
 	startCmd.Flags().Int64(UpgradeHeightFlag, 0, "Upgrade height to switch from v1 to v2. Must be coordinated amongst all validators")
+	startCmd.Flags().Int(app.FlagEDSStoreSize, app.DefaultEDSStoreSize, "Number of heights for which the extended data squares are kept to serve proofs. Zero disables the store")
+	startCmd.Flags().Bool(app.FlagEDSStoreDisk, false, "Also keep the extended data squares on disk in the data/eds directory")
 }
 
 // replaceLogger optionally replaces the logger with a file logger if the flag
@@ -1,9 +1,12 @@
 package appconsts
 
 import (
+	"hash"
 	"strconv"
 
 	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/rsmt2d"
 )
 
 const (
@@ -42,6 +45,24 @@ func GasPerBlobByte(_ uint64) uint32 {
 	return v3.GasPerBlobByte
 }
 
+// Codec returns the codec used to erasure code the data square. A new codec
+// can be evaluated by returning it for a future app version.
+func Codec(_ uint64) rsmt2d.Codec {
+	return DefaultCodec()
+}
+
+// NewBaseHashFuncForVersion returns the base hash function used by the NMT
+// of the extended data square's rows and columns.
+func NewBaseHashFuncForVersion(_ uint64) func() hash.Hash {
+	return NewBaseHashFunc
+}
+
+// NamespaceSize returns the size of the namespaces that prefix the leaves of
+// the NMT of the extended data square's rows and columns.
+func NamespaceSize(_ uint64) int {
+	return share.NamespaceSize
+}
+
 var (
 	DefaultSubtreeRootThreshold = SubtreeRootThreshold(LatestVersion)
 	DefaultSquareSizeUpperBound = SquareSizeUpperBound(LatestVersion)
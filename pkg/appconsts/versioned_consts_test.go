@@ -9,6 +9,7 @@ import (
 	v1 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v1"
 	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
 	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	"github.com/celestiaorg/go-square/v2/share"
 )
 
 func TestVersionedConsts(t *testing.T) {
@@ -66,6 +67,18 @@ func TestVersionedConsts(t *testing.T) {
 			expectedConstant: v3.GasPerBlobByte,
 			got:              appconsts.GasPerBlobByte(v3.Version),
 		},
+		{
+			name:             "NamespaceSize v1",
+			version:          v1.Version,
+			expectedConstant: share.NamespaceSize,
+			got:              appconsts.NamespaceSize(v1.Version),
+		},
+		{
+			name:             "NamespaceSize v3",
+			version:          v3.Version,
+			expectedConstant: share.NamespaceSize,
+			got:              appconsts.NamespaceSize(v3.Version),
+		},
 	}
 
 	for _, tc := range testCases {
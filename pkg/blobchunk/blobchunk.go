@@ -0,0 +1,211 @@
+// Package blobchunk splits data that is too large for a single blob into
+// chunks that are paid for in separate PayForBlobs transactions and describes
+// how to reassemble them with a manifest that is submitted as a blob of its
+// own once all the chunks are included.
+package blobchunk
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2/inclusion"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/go-square/v2/tx"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	// DefaultChunkSize is the default maximum size of a chunk in bytes. It
+	// leaves room for other transactions in a square of the default governance
+	// max square size.
+	DefaultChunkSize = appconsts.DefaultMaxBytes / 2
+
+	// ManifestPrefix prefixes the data of manifest blobs to tell them apart
+	// from chunks.
+	ManifestPrefix = "celestia/blobchunk/manifest/v1"
+)
+
+// ErrInvalidData is returned when the reassembled data doesn't match its
+// manifest.
+var ErrInvalidData = errors.New("data does not match the manifest")
+
+// Split splits data into blobs of at most chunkSize bytes in the given
+// namespace. The signer is only used by share version one.
+func Split(namespace share.Namespace, data []byte, chunkSize int, shareVersion uint8, signer []byte) ([]*share.Blob, error) {
+	if len(data) == 0 {
+		return nil, errors.New("data can not be empty")
+	}
+	if chunkSize <= 0 {
+		return nil, fmt.Errorf("chunk size must be positive, got %d", chunkSize)
+	}
+	blobs := make([]*share.Blob, 0, (len(data)+chunkSize-1)/chunkSize)
+	for start := 0; start < len(data); start += chunkSize {
+		end := min(start+chunkSize, len(data))
+		blob, err := share.NewBlob(namespace, data[start:end], shareVersion, signer)
+		if err != nil {
+			return nil, err
+		}
+		blobs = append(blobs, blob)
+	}
+	return blobs, nil
+}
+
+// NewManifest returns the manifest of data that was split into chunks which
+// were included at the given heights. The share commitments of the chunks are
+// computed with the subtree root threshold of appVersion.
+func NewManifest(data []byte, chunks []*share.Blob, heights []int64, appVersion uint64) (*Manifest, error) {
+	if len(chunks) == 0 {
+		return nil, errors.New("no chunks")
+	}
+	if len(heights) != len(chunks) {
+		return nil, fmt.Errorf("got %d heights for %d chunks", len(heights), len(chunks))
+	}
+	commitments, err := inclusion.CreateCommitments(chunks, merkle.HashFromByteSlices, appconsts.SubtreeRootThreshold(appVersion))
+	if err != nil {
+		return nil, fmt.Errorf("creating commitments: %w", err)
+	}
+	hash := sha256.Sum256(data)
+	return &Manifest{
+		Namespace:        chunks[0].Namespace().Bytes(),
+		TotalSize:        uint64(len(data)),
+		Hash:             hash[:],
+		ShareCommitments: commitments,
+		Heights:          heights,
+	}, nil
+}
+
+// Blob returns the blob the manifest is submitted in.
+func (m *Manifest) Blob(shareVersion uint8, signer []byte) (*share.Blob, error) {
+	namespace, err := share.NewNamespaceFromBytes(m.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := m.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return share.NewBlob(namespace, append([]byte(ManifestPrefix), raw...), shareVersion, signer)
+}
+
+// IsManifest returns true if the blob contains a manifest.
+func IsManifest(blob *share.Blob) bool {
+	return bytes.HasPrefix(blob.Data(), []byte(ManifestPrefix))
+}
+
+// ParseManifest returns the manifest contained in the blob.
+func ParseManifest(blob *share.Blob) (*Manifest, error) {
+	if !IsManifest(blob) {
+		return nil, errors.New("blob does not contain a manifest")
+	}
+	m := new(Manifest)
+	if err := m.Unmarshal(blob.Data()[len(ManifestPrefix):]); err != nil {
+		return nil, fmt.Errorf("unmarshalling manifest: %w", err)
+	}
+	if !bytes.Equal(blob.Namespace().Bytes(), m.Namespace) {
+		return nil, errors.New("manifest namespace does not match the namespace of its blob")
+	}
+	if len(m.Heights) != len(m.ShareCommitments) {
+		return nil, fmt.Errorf("manifest has %d heights for %d share commitments", len(m.Heights), len(m.ShareCommitments))
+	}
+	return m, nil
+}
+
+// Reassemble concatenates the chunks in order and verifies the result against
+// the manifest.
+func (m *Manifest) Reassemble(chunks []*share.Blob) ([]byte, error) {
+	if len(chunks) != len(m.ShareCommitments) {
+		return nil, fmt.Errorf("%w: got %d chunks, expected %d", ErrInvalidData, len(chunks), len(m.ShareCommitments))
+	}
+	data := make([]byte, 0, m.TotalSize)
+	for i, chunk := range chunks {
+		if !bytes.Equal(chunk.Namespace().Bytes(), m.Namespace) {
+			return nil, fmt.Errorf("%w: chunk %d has namespace %X", ErrInvalidData, i, chunk.Namespace().Bytes())
+		}
+		data = append(data, chunk.Data()...)
+	}
+	if uint64(len(data)) != m.TotalSize {
+		return nil, fmt.Errorf("%w: size %d, expected %d", ErrInvalidData, len(data), m.TotalSize)
+	}
+	if hash := sha256.Sum256(data); !bytes.Equal(hash[:], m.Hash) {
+		return nil, fmt.Errorf("%w: hash %X, expected %X", ErrInvalidData, hash, m.Hash)
+	}
+	return data, nil
+}
+
+// BlockGetter returns the block at the given height.
+type BlockGetter func(ctx context.Context, height int64) (*coretypes.Block, error)
+
+// Read fetches the chunks described by the manifest from the blocks they were
+// included in and reassembles the data.
+func Read(ctx context.Context, getBlock BlockGetter, m *Manifest) ([]byte, error) {
+	if len(m.Heights) != len(m.ShareCommitments) {
+		return nil, fmt.Errorf("manifest has %d heights for %d share commitments", len(m.Heights), len(m.ShareCommitments))
+	}
+
+	// the chunks that are expected at each height by their share commitment
+	byHeight := make(map[int64]map[string][]int)
+	for i, height := range m.Heights {
+		if byHeight[height] == nil {
+			byHeight[height] = make(map[string][]int)
+		}
+		commitment := string(m.ShareCommitments[i])
+		byHeight[height][commitment] = append(byHeight[height][commitment], i)
+	}
+	heights := make([]int64, 0, len(byHeight))
+	for height := range byHeight {
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+
+	chunks := make([]*share.Blob, len(m.ShareCommitments))
+	for _, height := range heights {
+		block, err := getBlock(ctx, height)
+		if err != nil {
+			return nil, fmt.Errorf("getting block %d: %w", height, err)
+		}
+		if err := findChunks(block, m.Namespace, byHeight[height], chunks); err != nil {
+			return nil, fmt.Errorf("block %d: %w", height, err)
+		}
+	}
+	for i, chunk := range chunks {
+		if chunk == nil {
+			return nil, fmt.Errorf("chunk %d not found at height %d", i, m.Heights[i])
+		}
+	}
+	return m.Reassemble(chunks)
+}
+
+// findChunks sets the chunks of the block that have one of the wanted share
+// commitments. The share commitments are computed from the blobs themselves
+// so every chunk found is verified.
+func findChunks(block *coretypes.Block, namespace []byte, wanted map[string][]int, chunks []*share.Blob) error {
+	subtreeRootThreshold := appconsts.SubtreeRootThreshold(block.Version.App)
+	for _, rawTx := range block.Data.Txs {
+		blobTx, isBlobTx, err := tx.UnmarshalBlobTx(rawTx)
+		if !isBlobTx {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		for _, blob := range blobTx.Blobs {
+			if !bytes.Equal(blob.Namespace().Bytes(), namespace) {
+				continue
+			}
+			commitment, err := inclusion.CreateCommitment(blob, merkle.HashFromByteSlices, subtreeRootThreshold)
+			if err != nil {
+				return err
+			}
+			for _, i := range wanted[string(commitment)] {
+				chunks[i] = blob
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+package blobchunk_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/blobchunk"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func TestSplit(t *testing.T) {
+	ns := share.RandomBlobNamespace()
+	data := tmrand.Bytes(2500)
+
+	chunks, err := blobchunk.Split(ns, data, 1000, share.ShareVersionZero, nil)
+	require.NoError(t, err)
+	require.Len(t, chunks, 3)
+	assert.Equal(t, data[:1000], chunks[0].Data())
+	assert.Equal(t, data[1000:2000], chunks[1].Data())
+	assert.Equal(t, data[2000:], chunks[2].Data())
+	for _, chunk := range chunks {
+		assert.Equal(t, ns, chunk.Namespace())
+	}
+
+	_, err = blobchunk.Split(ns, nil, 1000, share.ShareVersionZero, nil)
+	assert.Error(t, err)
+	_, err = blobchunk.Split(ns, data, 0, share.ShareVersionZero, nil)
+	assert.Error(t, err)
+}
+
+func TestManifest(t *testing.T) {
+	ns := share.RandomBlobNamespace()
+	data := tmrand.Bytes(2500)
+	chunks, err := blobchunk.Split(ns, data, 1000, share.ShareVersionZero, nil)
+	require.NoError(t, err)
+
+	manifest, err := blobchunk.NewManifest(data, chunks, []int64{1, 2, 2}, appconsts.LatestVersion)
+	require.NoError(t, err)
+	assert.Len(t, manifest.ShareCommitments, 3)
+	assert.EqualValues(t, len(data), manifest.TotalSize)
+
+	blob, err := manifest.Blob(share.ShareVersionZero, nil)
+	require.NoError(t, err)
+	assert.True(t, blobchunk.IsManifest(blob))
+	for _, chunk := range chunks {
+		assert.False(t, blobchunk.IsManifest(chunk))
+	}
+	parsed, err := blobchunk.ParseManifest(blob)
+	require.NoError(t, err)
+	assert.Equal(t, manifest, parsed)
+
+	_, err = blobchunk.ParseManifest(chunks[0])
+	assert.Error(t, err)
+
+	reassembled, err := manifest.Reassemble(chunks)
+	require.NoError(t, err)
+	assert.Equal(t, data, reassembled)
+
+	_, err = manifest.Reassemble(chunks[:2])
+	assert.ErrorIs(t, err, blobchunk.ErrInvalidData)
+	_, err = manifest.Reassemble([]*share.Blob{chunks[1], chunks[0], chunks[2]})
+	assert.ErrorIs(t, err, blobchunk.ErrInvalidData)
+	other, err := share.NewV0Blob(share.RandomBlobNamespace(), chunks[2].Data())
+	require.NoError(t, err)
+	_, err = manifest.Reassemble([]*share.Blob{chunks[0], chunks[1], other})
+	assert.ErrorIs(t, err, blobchunk.ErrInvalidData)
+}
+
+func TestRead(t *testing.T) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+
+	ns := share.RandomBlobNamespace()
+	data := tmrand.Bytes(5000)
+	chunks, err := blobchunk.Split(ns, data, 2000, share.ShareVersionZero, nil)
+	require.NoError(t, err)
+	require.Len(t, chunks, 3)
+
+	// the first chunk is included at height 1 and the others at height 2
+	// along with a blob in a different namespace
+	blobTx := func(blobs ...*share.Blob) coretypes.Tx {
+		tx, _, err := signer.CreatePayForBlobs(testfactory.TestAccName, blobs)
+		require.NoError(t, err)
+		return tx
+	}
+	unrelated, err := share.NewV0Blob(share.RandomBlobNamespace(), tmrand.Bytes(100))
+	require.NoError(t, err)
+	blocks := map[int64]*coretypes.Block{
+		1: newBlock(blobTx(chunks[0])),
+		2: newBlock(blobTx(unrelated), blobTx(chunks[1], chunks[2])),
+	}
+	getBlock := func(_ context.Context, height int64) (*coretypes.Block, error) {
+		block, ok := blocks[height]
+		if !ok {
+			return nil, fmt.Errorf("no block at height %d", height)
+		}
+		return block, nil
+	}
+
+	manifest, err := blobchunk.NewManifest(data, chunks, []int64{1, 2, 2}, appconsts.LatestVersion)
+	require.NoError(t, err)
+	read, err := blobchunk.Read(context.Background(), getBlock, manifest)
+	require.NoError(t, err)
+	assert.Equal(t, data, read)
+
+	t.Run("chunk at the wrong height", func(t *testing.T) {
+		manifest, err := blobchunk.NewManifest(data, chunks, []int64{2, 2, 2}, appconsts.LatestVersion)
+		require.NoError(t, err)
+		_, err = blobchunk.Read(context.Background(), getBlock, manifest)
+		assert.ErrorContains(t, err, "chunk 0 not found")
+	})
+
+	t.Run("missing block", func(t *testing.T) {
+		manifest, err := blobchunk.NewManifest(data, chunks, []int64{1, 2, 3}, appconsts.LatestVersion)
+		require.NoError(t, err)
+		_, err = blobchunk.Read(context.Background(), getBlock, manifest)
+		assert.Error(t, err)
+	})
+}
+
+func newBlock(txs ...coretypes.Tx) *coretypes.Block {
+	return &coretypes.Block{
+		Header: coretypes.Header{Version: version.Consensus{App: appconsts.LatestVersion}},
+		Data:   coretypes.Data{Txs: txs},
+	}
+}
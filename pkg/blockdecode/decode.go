@@ -0,0 +1,270 @@
+// Package blockdecode decodes the transactions of a block into typed results
+// along with the layout of the data square they are arranged in. It is meant
+// to be used by indexers and other tools that consume celestia blocks.
+package blockdecode
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	v1 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v1"
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	squarev1 "github.com/celestiaorg/go-square/square"
+	squarev2 "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/go-square/v2/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+// Block is the decoded data of a block.
+type Block struct {
+	// SquareSize is the width of the original data square.
+	SquareSize int
+	// Txs are the normal transactions of the block in the order they appear
+	// in the square.
+	Txs []Tx
+	// PFBs are the blob transactions of the block in the order they appear in
+	// the square.
+	PFBs []PFB
+}
+
+// Tx is a decoded transaction.
+type Tx struct {
+	// Index is the index of the transaction in the block data.
+	Index int
+	// Hash is the hash of the transaction. For blob transactions this is the
+	// hash of the transaction without its blobs.
+	Hash []byte
+	// Tx is the decoded sdk transaction.
+	Tx sdk.Tx
+	// ShareRange is the range of shares the transaction occupies in the
+	// original data square.
+	ShareRange share.Range
+}
+
+// PFB is a decoded blob transaction.
+type PFB struct {
+	Tx
+	// Msg is the MsgPayForBlobs the blobs are paid for by.
+	Msg *blobtypes.MsgPayForBlobs
+	// Blobs are the blobs of the transaction in the order of the message.
+	Blobs []Blob
+}
+
+// Blob describes a blob of a blob transaction.
+type Blob struct {
+	Namespace share.Namespace
+	// ShareVersion is the share version the blob is paid for with. Compressed
+	// blobs have share version two even though they are laid out as share
+	// version zero blobs.
+	ShareVersion uint8
+	// Size is the size of the blob data in the square in bytes. For compressed
+	// blobs it is the compressed size.
+	Size uint32
+	// Data is the blob data. The data of compressed blobs is decompressed.
+	Data []byte
+	// Commitment is the share commitment of the blob.
+	Commitment []byte
+	// ShareRange is the range of shares the blob occupies in the original
+	// data square.
+	ShareRange share.Range
+}
+
+// Decoder decodes the transactions of blocks.
+type Decoder struct {
+	txDecoder sdk.TxDecoder
+}
+
+// NewDecoder returns a decoder that decodes transactions with txDecoder.
+func NewDecoder(txDecoder sdk.TxDecoder) *Decoder {
+	return &Decoder{txDecoder: txDecoder}
+}
+
+var (
+	defaultDecoder     *Decoder
+	defaultDecoderOnce sync.Once
+)
+
+// DefaultDecoder returns a decoder that decodes the transactions of all the
+// modules of the app. The decoder is created once and shared between callers.
+func DefaultDecoder() *Decoder {
+	defaultDecoderOnce.Do(func() {
+		defaultDecoder = NewDecoder(encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig.TxDecoder())
+	})
+	return defaultDecoder
+}
+
+// Decode decodes the transactions of the block data and computes the share
+// ranges they occupy in the square of the given app version.
+func (d *Decoder) Decode(data coretypes.Data, appVersion uint64) (*Block, error) {
+	rawTxs := data.Txs.ToSliceOfBytes()
+	layout, err := newLayout(rawTxs, appVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	block := &Block{SquareSize: layout.squareSize}
+	for i, rawTx := range rawTxs {
+		txRange, err := layout.txShareRange(i)
+		if err != nil {
+			return nil, fmt.Errorf("finding share range of tx %d: %w", i, err)
+		}
+
+		blobTx, isBlobTx, err := tx.UnmarshalBlobTx(rawTx)
+		if isBlobTx && err != nil {
+			return nil, fmt.Errorf("decoding blob tx %d: %w", i, err)
+		}
+		if !isBlobTx {
+			sdkTx, err := d.txDecoder(rawTx)
+			if err != nil {
+				return nil, fmt.Errorf("decoding tx %d: %w", i, err)
+			}
+			block.Txs = append(block.Txs, Tx{
+				Index:      i,
+				Hash:       coretypes.Tx(rawTx).Hash(),
+				Tx:         sdkTx,
+				ShareRange: txRange,
+			})
+			continue
+		}
+
+		sdkTx, err := d.txDecoder(blobTx.Tx)
+		if err != nil {
+			return nil, fmt.Errorf("decoding blob tx %d: %w", i, err)
+		}
+		msg, err := payForBlobs(sdkTx)
+		if err != nil {
+			return nil, fmt.Errorf("blob tx %d: %w", i, err)
+		}
+		if len(msg.ShareCommitments) != len(blobTx.Blobs) || len(msg.ShareVersions) != len(blobTx.Blobs) {
+			return nil, fmt.Errorf("blob tx %d: %d share commitments and %d share versions for %d blobs", i, len(msg.ShareCommitments), len(msg.ShareVersions), len(blobTx.Blobs))
+		}
+
+		blobs := make([]Blob, len(blobTx.Blobs))
+		for j, blob := range blobTx.Blobs {
+			blobRange, err := layout.blobShareRange(i, j)
+			if err != nil {
+				return nil, fmt.Errorf("finding share range of blob %d of tx %d: %w", j, i, err)
+			}
+			data, err := blobtypes.BlobData(msg, j, blob)
+			if err != nil {
+				return nil, fmt.Errorf("blob %d of tx %d: %w", j, i, err)
+			}
+			shareVersion := blob.ShareVersion()
+			if msg.ShareVersions[j] == uint32(blobtypes.ShareVersionTwo) {
+				shareVersion = blobtypes.ShareVersionTwo
+			}
+			blobs[j] = Blob{
+				Namespace:    blob.Namespace(),
+				ShareVersion: shareVersion,
+				Size:         uint32(len(blob.Data())),
+				Data:         data,
+				Commitment:   msg.ShareCommitments[j],
+				ShareRange:   blobRange,
+			}
+		}
+		block.PFBs = append(block.PFBs, PFB{
+			Tx: Tx{
+				Index:      i,
+				Hash:       coretypes.Tx(rawTx).Hash(),
+				Tx:         sdkTx,
+				ShareRange: txRange,
+			},
+			Msg:   msg,
+			Blobs: blobs,
+		})
+	}
+	return block, nil
+}
+
+// Decode decodes the block data with the DefaultDecoder.
+func Decode(data coretypes.Data, appVersion uint64) (*Block, error) {
+	return DefaultDecoder().Decode(data, appVersion)
+}
+
+// payForBlobs returns the MsgPayForBlobs of a blob transaction.
+func payForBlobs(sdkTx sdk.Tx) (*blobtypes.MsgPayForBlobs, error) {
+	msgs := sdkTx.GetMsgs()
+	if len(msgs) != 1 {
+		return nil, fmt.Errorf("expected 1 message, got %d", len(msgs))
+	}
+	msg, ok := msgs[0].(*blobtypes.MsgPayForBlobs)
+	if !ok {
+		return nil, fmt.Errorf("expected %s, got %s", sdk.MsgTypeURL(&blobtypes.MsgPayForBlobs{}), sdk.MsgTypeURL(msgs[0]))
+	}
+	return msg, nil
+}
+
+// layout computes the share ranges of the transactions and blobs in the
+// square of an app version.
+type layout struct {
+	squareSize     int
+	txShareRange   func(txIndex int) (share.Range, error)
+	blobShareRange func(txIndex, blobIndex int) (share.Range, error)
+}
+
+// newLayout lays out the transactions in the square the same way the app
+// version does. As the governance max square size isn't known, the upper
+// bound is used which doesn't change the layout of a valid block. It returns an
+// error for app versions whose layout is unknown.
+func newLayout(txs [][]byte, appVersion uint64) (*layout, error) {
+	maxSquareSize := appconsts.SquareSizeUpperBound(appVersion)
+	subtreeRootThreshold := appconsts.SubtreeRootThreshold(appVersion)
+	switch appVersion {
+	case v1.Version, v2.Version:
+		builder, err := squarev1.NewBuilder(maxSquareSize, subtreeRootThreshold, txs...)
+		if err != nil {
+			return nil, err
+		}
+		dataSquare, err := builder.Export()
+		if err != nil {
+			return nil, err
+		}
+		return &layout{
+			squareSize: dataSquare.Size(),
+			txShareRange: func(txIndex int) (share.Range, error) {
+				r, err := builder.FindTxShareRange(txIndex)
+				return share.NewRange(r.Start, r.End), err
+			},
+			blobShareRange: func(txIndex, blobIndex int) (share.Range, error) {
+				return blobShareRange(builder.FindBlobStartingIndex, builder.BlobShareLength, txIndex, blobIndex)
+			},
+		}, nil
+	case v3.Version:
+		builder, err := squarev2.NewBuilder(maxSquareSize, subtreeRootThreshold, txs...)
+		if err != nil {
+			return nil, err
+		}
+		dataSquare, err := builder.Export()
+		if err != nil {
+			return nil, err
+		}
+		return &layout{
+			squareSize:   dataSquare.Size(),
+			txShareRange: builder.FindTxShareRange,
+			blobShareRange: func(txIndex, blobIndex int) (share.Range, error) {
+				return blobShareRange(builder.FindBlobStartingIndex, builder.BlobShareLength, txIndex, blobIndex)
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported app version %d", appVersion)
+	}
+}
+
+func blobShareRange(startIndex, length func(pfbIndex, blobIndex int) (int, error), txIndex, blobIndex int) (share.Range, error) {
+	start, err := startIndex(txIndex, blobIndex)
+	if err != nil {
+		return share.Range{}, err
+	}
+	n, err := length(txIndex, blobIndex)
+	if err != nil {
+		return share.Range{}, err
+	}
+	return share.NewRange(start, start+n), nil
+}
@@ -0,0 +1,155 @@
+package blockdecode_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	"github.com/celestiaorg/celestia-app/v3/pkg/blockdecode"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	squarev1 "github.com/celestiaorg/go-square/square"
+	squarev2 "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/go-square/v2/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func TestDecode(t *testing.T) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	txs := coretypes.Txs(blobfactory.GenerateManyRawSendTxs(signer, 5))
+	txs = append(txs, blobfactory.RandBlobTxs(signer, tmrand.NewRand(), 5, 2, 1000)...)
+	rawTxs := txs.ToSliceOfBytes()
+
+	type shareRanges struct {
+		tx   func(txIndex int) (share.Range, error)
+		blob func(txIndex, blobIndex int) (share.Range, error)
+	}
+	testCases := []struct {
+		name       string
+		appVersion uint64
+		ranges     shareRanges
+	}{
+		{
+			name:       "v2",
+			appVersion: v2.Version,
+			ranges: shareRanges{
+				tx: func(txIndex int) (share.Range, error) {
+					r, err := squarev1.TxShareRange(rawTxs, txIndex, appconsts.SquareSizeUpperBound(v2.Version), appconsts.SubtreeRootThreshold(v2.Version))
+					return share.NewRange(r.Start, r.End), err
+				},
+				blob: func(txIndex, blobIndex int) (share.Range, error) {
+					r, err := squarev1.BlobShareRange(rawTxs, txIndex, blobIndex, appconsts.SquareSizeUpperBound(v2.Version), appconsts.SubtreeRootThreshold(v2.Version))
+					return share.NewRange(r.Start, r.End), err
+				},
+			},
+		},
+		{
+			name:       "v3",
+			appVersion: v3.Version,
+			ranges: shareRanges{
+				tx: func(txIndex int) (share.Range, error) {
+					return squarev2.TxShareRange(rawTxs, txIndex, appconsts.SquareSizeUpperBound(v3.Version), appconsts.SubtreeRootThreshold(v3.Version))
+				},
+				blob: func(txIndex, blobIndex int) (share.Range, error) {
+					return squarev2.BlobShareRange(rawTxs, txIndex, blobIndex, appconsts.SquareSizeUpperBound(v3.Version), appconsts.SubtreeRootThreshold(v3.Version))
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			block, err := blockdecode.Decode(coretypes.Data{Txs: txs}, tc.appVersion)
+			require.NoError(t, err)
+			require.Len(t, block.Txs, 5)
+			require.Len(t, block.PFBs, 5)
+
+			dataSquare, err := squarev2.Construct(rawTxs, appconsts.SquareSizeUpperBound(tc.appVersion), appconsts.SubtreeRootThreshold(tc.appVersion))
+			require.NoError(t, err)
+			assert.Equal(t, dataSquare.Size(), block.SquareSize)
+
+			for i, tx := range block.Txs {
+				assert.Equal(t, i, tx.Index)
+				assert.Equal(t, txs[i].Hash(), tx.Hash)
+				assert.NotEmpty(t, tx.Tx.GetMsgs())
+				expected, err := tc.ranges.tx(i)
+				require.NoError(t, err)
+				assert.Equal(t, expected, tx.ShareRange)
+			}
+
+			for i, pfb := range block.PFBs {
+				txIndex := len(block.Txs) + i
+				assert.Equal(t, txIndex, pfb.Index)
+				assert.Equal(t, txs[txIndex].Hash(), pfb.Hash)
+				expected, err := tc.ranges.tx(txIndex)
+				require.NoError(t, err)
+				assert.Equal(t, expected, pfb.ShareRange)
+
+				require.Len(t, pfb.Blobs, 2)
+				for j, blob := range pfb.Blobs {
+					assert.Equal(t, pfb.Msg.Namespaces[j], blob.Namespace.Bytes())
+					assert.Equal(t, pfb.Msg.BlobSizes[j], blob.Size)
+					assert.Equal(t, pfb.Msg.ShareCommitments[j], blob.Commitment)
+					assert.EqualValues(t, pfb.Msg.ShareVersions[j], blob.ShareVersion)
+					expected, err := tc.ranges.blob(txIndex, j)
+					require.NoError(t, err)
+					assert.Equal(t, expected, blob.ShareRange)
+
+					// the blob must be the only one in its share range
+					blobs, err := share.ParseBlobs(dataSquare[blob.ShareRange.Start:blob.ShareRange.End])
+					require.NoError(t, err)
+					require.Len(t, blobs, 1)
+					assert.Equal(t, blob.Namespace, blobs[0].Namespace())
+					assert.Len(t, blobs[0].Data(), int(blob.Size))
+				}
+			}
+		})
+	}
+}
+
+func TestDecodeInvalidTx(t *testing.T) {
+	_, err := blockdecode.Decode(coretypes.Data{Txs: coretypes.Txs{[]byte("not a tx")}}, v3.Version)
+	require.Error(t, err)
+}
+
+func TestDecodeUnsupportedAppVersion(t *testing.T) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	txs := coretypes.Txs(blobfactory.GenerateManyRawSendTxs(signer, 1))
+
+	_, err = blockdecode.Decode(coretypes.Data{Txs: txs}, appconsts.LatestVersion+1)
+	require.Error(t, err)
+}
+
+func TestDecodeCompressedBlob(t *testing.T) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	addr := signer.Account(testfactory.TestAccName).Address()
+	data := tmrand.Bytes(1000)
+	blob, err := blobtypes.NewV2Blob(share.RandomBlobNamespace(), data)
+	require.NoError(t, err)
+	msg, err := blobtypes.NewMsgPayForV2Blobs(addr.String(), v3.Version, blob)
+	require.NoError(t, err)
+	rawTx, err := signer.CreateTx([]sdk.Msg{msg})
+	require.NoError(t, err)
+	blobTx, err := tx.MarshalBlobTx(rawTx, blob)
+	require.NoError(t, err)
+
+	block, err := blockdecode.Decode(coretypes.Data{Txs: coretypes.Txs{blobTx}}, v3.Version)
+	require.NoError(t, err)
+	require.Len(t, block.PFBs, 1)
+	require.Len(t, block.PFBs[0].Blobs, 1)
+	decoded := block.PFBs[0].Blobs[0]
+	assert.Equal(t, blobtypes.ShareVersionTwo, decoded.ShareVersion)
+	assert.EqualValues(t, len(blob.Data()), decoded.Size)
+	assert.Equal(t, data, decoded.Data)
+}
@@ -63,7 +63,15 @@ func NewDataAvailabilityHeader(eds *rsmt2d.ExtendedDataSquare) (DataAvailability
 	return dah, nil
 }
 
+// ExtendShares erasure codes the square of shares s using the codec and NMT
+// of the latest app version.
 func ExtendShares(s [][]byte) (*rsmt2d.ExtendedDataSquare, error) {
+	return ExtendSharesForVersion(s, appconsts.LatestVersion)
+}
+
+// ExtendSharesForVersion erasure codes the square of shares s using the codec
+// and NMT of the given app version.
+func ExtendSharesForVersion(s [][]byte, appVersion uint64) (*rsmt2d.ExtendedDataSquare, error) {
 	// Check that the length of the square is a power of 2.
 	if !square.IsPowerOfTwo(len(s)) {
 		return nil, fmt.Errorf("number of shares is not a power of 2: got %d", len(s))
@@ -72,7 +80,7 @@ func ExtendShares(s [][]byte) (*rsmt2d.ExtendedDataSquare, error) {
 
 	// here we construct a tree
 	// Note: uses the nmt wrapper to construct the tree.
-	return rsmt2d.ComputeExtendedDataSquare(s, appconsts.DefaultCodec(), wrapper.NewConstructor(uint64(squareSize)))
+	return rsmt2d.ComputeExtendedDataSquare(s, appconsts.Codec(appVersion), wrapper.NewVersionedConstructor(appVersion, uint64(squareSize)))
 }
 
 // String returns hex representation of merkle hash of the DAHeader.
@@ -2,11 +2,15 @@ package da
 
 import (
 	"bytes"
+	"fmt"
 	"sort"
 	"strings"
 	"testing"
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	v1 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v1"
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
 	sh "github.com/celestiaorg/go-square/v2/share"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -98,6 +102,31 @@ func TestExtendShares(t *testing.T) {
 	}
 }
 
+// TestExtendSharesForVersion ensures that the app versions that use the
+// original codec and NMT hasher produce byte-identical data roots.
+func TestExtendSharesForVersion(t *testing.T) {
+	goldenDataRoots := []struct {
+		name     string
+		shares   [][]byte
+		dataRoot string
+	}{
+		{"min shares", MinShares(), "3D96B7D238E7E0456F6AF8E7CDF0A67BD6CF9C2089ECB559C659DCAA1F880353"},
+		{"4x4 square", generateShares(4 * 4), "CFFCA6ACC29FA1A80F7E6B717DF9230DD9642FAE4AE4706C9E3EED7525D2EA2E"},
+		{"32x32 square", generateShares(32 * 32), "A5D9CC1C148575486748C0CEB2F0A38E4A5D4675324310D6F6B7C6040CE1F2F4"},
+	}
+	for _, appVersion := range []uint64{v1.Version, v2.Version, v3.Version} {
+		for _, tt := range goldenDataRoots {
+			t.Run(fmt.Sprintf("%s v%d", tt.name, appVersion), func(t *testing.T) {
+				eds, err := ExtendSharesForVersion(tt.shares, appVersion)
+				require.NoError(t, err)
+				dah, err := NewDataAvailabilityHeader(eds)
+				require.NoError(t, err)
+				assert.Equal(t, tt.dataRoot, fmt.Sprintf("%X", dah.Hash()))
+			})
+		}
+	}
+}
+
 func TestDataAvailabilityHeaderProtoConversion(t *testing.T) {
 	type test struct {
 		name string
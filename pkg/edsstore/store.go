@@ -0,0 +1,297 @@
+// Package edsstore keeps the extended data squares of recently produced blocks
+// so that they don't have to be recomputed from the block's transactions every
+// time they are needed, e.g. to serve inclusion proofs.
+package edsstore
+
+import (
+	"bytes"
+	"container/list"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/wrapper"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+const (
+	// fileExtension is the extension of the files of the on-disk store.
+	fileExtension = ".eds"
+	// magic identifies the version of the on-disk format. Files of other
+	// versions are treated as invalid and removed when the store is pruned.
+	magic = "EDS\x02"
+	// headerSize is the size of the header of a file: the magic bytes, the
+	// height, the app version, the width of the extended square and the size
+	// of a share.
+	headerSize = len(magic) + 8 + 8 + 4 + 4
+)
+
+// Store keeps the extended data squares of the last heights keyed by their
+// data root. The squares are kept in memory, evicting the least recently used
+// one once the store is full, and optionally on disk so that they survive a
+// restart and outlive their eviction from memory. A nil Store keeps nothing.
+//
+// Store is safe for concurrent use. The squares it returns must not be
+// modified.
+type Store struct {
+	mu sync.Mutex
+	// size is the number of heights for which squares are kept.
+	size int
+	// dir is the directory of the on-disk store. It is empty if the squares
+	// are only kept in memory.
+	dir string
+	// entries contains the squares kept in memory, most recently used first.
+	entries *list.List
+	byRoot  map[string]*list.Element
+}
+
+type entry struct {
+	height     int64
+	appVersion uint64
+	dataRoot   []byte
+	eds        *rsmt2d.ExtendedDataSquare
+}
+
+// NewStore creates a Store that keeps the squares of the last size heights.
+// If dir is not empty, the squares are also written to dir which is created if
+// it doesn't exist.
+func NewStore(size int, dir string) (*Store, error) {
+	if size <= 0 {
+		return nil, fmt.Errorf("size must be positive: %d", size)
+	}
+	if dir != "" {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, err
+		}
+	}
+	return &Store{
+		size:    size,
+		dir:     dir,
+		entries: list.New(),
+		byRoot:  make(map[string]*list.Element, size),
+	}, nil
+}
+
+// Put stores the extended data square of the block at height with the given
+// app version and data root and prunes the squares of heights that are no
+// longer kept. The app version determines the erasure codec and the NMT hasher
+// that the square is read back with. The square's roots are computed before it
+// is stored so that it can be read concurrently afterwards.
+func (s *Store) Put(height int64, appVersion uint64, dataRoot []byte, eds *rsmt2d.ExtendedDataSquare) error {
+	if s == nil {
+		return nil
+	}
+	if err := computeRoots(eds); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := string(dataRoot)
+	if elem, ok := s.byRoot[key]; ok {
+		s.remove(elem)
+	}
+	s.byRoot[key] = s.entries.PushFront(&entry{height: height, appVersion: appVersion, dataRoot: bytes.Clone(dataRoot), eds: eds})
+	s.pruneMemory(height)
+
+	if s.dir == "" {
+		return nil
+	}
+	if err := s.write(height, appVersion, dataRoot, eds); err != nil {
+		return err
+	}
+	return s.pruneDisk(height)
+}
+
+// Get returns the extended data square with the given data root and the app
+// version it was stored with if it is kept.
+func (s *Store) Get(dataRoot []byte) (*rsmt2d.ExtendedDataSquare, uint64, bool) {
+	if s == nil {
+		return nil, 0, false
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if elem, ok := s.byRoot[string(dataRoot)]; ok {
+		s.entries.MoveToFront(elem)
+		e := elem.Value.(*entry)
+		return e.eds, e.appVersion, true
+	}
+	if s.dir == "" {
+		return nil, 0, false
+	}
+
+	height, appVersion, eds, err := s.read(dataRoot)
+	if err != nil {
+		return nil, 0, false
+	}
+	s.byRoot[string(dataRoot)] = s.entries.PushFront(&entry{height: height, appVersion: appVersion, dataRoot: bytes.Clone(dataRoot), eds: eds})
+	s.pruneMemory(height)
+	return eds, appVersion, true
+}
+
+// Len returns the number of squares kept in memory.
+func (s *Store) Len() int {
+	if s == nil {
+		return 0
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.entries.Len()
+}
+
+// pruneMemory evicts the squares of heights that are no longer kept relative
+// to latestHeight and then the least recently used squares beyond the size of
+// the store.
+func (s *Store) pruneMemory(latestHeight int64) {
+	for elem := s.entries.Front(); elem != nil; {
+		next := elem.Next()
+		if elem.Value.(*entry).height <= latestHeight-int64(s.size) {
+			s.remove(elem)
+		}
+		elem = next
+	}
+	for s.entries.Len() > s.size {
+		s.remove(s.entries.Back())
+	}
+}
+
+func (s *Store) remove(elem *list.Element) {
+	s.entries.Remove(elem)
+	delete(s.byRoot, string(elem.Value.(*entry).dataRoot))
+}
+
+// pruneDisk removes the files of heights that are no longer kept relative to
+// latestHeight.
+func (s *Store) pruneDisk(latestHeight int64) error {
+	files, err := os.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if file.IsDir() || !strings.HasSuffix(file.Name(), fileExtension) {
+			continue
+		}
+		path := filepath.Join(s.dir, file.Name())
+		height, err := readHeight(path)
+		if err != nil || height <= latestHeight-int64(s.size) {
+			if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Store) path(dataRoot []byte) string {
+	return filepath.Join(s.dir, strings.ToUpper(hex.EncodeToString(dataRoot))+fileExtension)
+}
+
+// write atomically writes the square to its file.
+func (s *Store) write(height int64, appVersion uint64, dataRoot []byte, eds *rsmt2d.ExtendedDataSquare) error {
+	shares := eds.Flattened()
+	header := make([]byte, 0, headerSize)
+	header = append(header, magic...)
+	header = binary.BigEndian.AppendUint64(header, uint64(height))
+	header = binary.BigEndian.AppendUint64(header, appVersion)
+	header = binary.BigEndian.AppendUint32(header, uint32(eds.Width()))
+	header = binary.BigEndian.AppendUint32(header, uint32(len(shares[0])))
+
+	tmp, err := os.CreateTemp(s.dir, "tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(header); err != nil {
+		tmp.Close()
+		return err
+	}
+	for _, share := range shares {
+		if _, err := tmp.Write(share); err != nil {
+			tmp.Close()
+			return err
+		}
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(dataRoot))
+}
+
+// read reads the square with the given data root and its app version from
+// its file and verifies that it matches the data root.
+func (s *Store) read(dataRoot []byte) (int64, uint64, *rsmt2d.ExtendedDataSquare, error) {
+	bz, err := os.ReadFile(s.path(dataRoot))
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	height, appVersion, width, shareSize, err := parseHeader(bz)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	if width == 0 || width%2 != 0 || len(bz) != headerSize+width*width*shareSize {
+		return 0, 0, nil, fmt.Errorf("invalid extended data square file of width %d and share size %d", width, shareSize)
+	}
+
+	shares := make([][]byte, width*width)
+	for i := range shares {
+		start := headerSize + i*shareSize
+		shares[i] = bz[start : start+shareSize]
+	}
+	eds, err := rsmt2d.ImportExtendedDataSquare(shares, appconsts.Codec(appVersion), wrapper.NewVersionedConstructor(appVersion, uint64(width/2)))
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	if err != nil {
+		return 0, 0, nil, err
+	}
+	if !bytes.Equal(dah.Hash(), dataRoot) {
+		return 0, 0, nil, fmt.Errorf("extended data square file has data root %X, expected %X", dah.Hash(), dataRoot)
+	}
+	return height, appVersion, eds, nil
+}
+
+func readHeight(path string) (int64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, err
+	}
+	defer file.Close()
+	header := make([]byte, headerSize)
+	if _, err := io.ReadFull(file, header); err != nil {
+		return 0, err
+	}
+	height, _, _, _, err := parseHeader(header)
+	return height, err
+}
+
+func parseHeader(bz []byte) (height int64, appVersion uint64, width int, shareSize int, err error) {
+	if len(bz) < headerSize || string(bz[:len(magic)]) != magic {
+		return 0, 0, 0, 0, errors.New("invalid extended data square file header")
+	}
+	bz = bz[len(magic):]
+	height = int64(binary.BigEndian.Uint64(bz))
+	appVersion = binary.BigEndian.Uint64(bz[8:])
+	width = int(binary.BigEndian.Uint32(bz[16:]))
+	shareSize = int(binary.BigEndian.Uint32(bz[20:]))
+	return height, appVersion, width, shareSize, nil
+}
+
+// computeRoots computes and caches the row and column roots of eds.
+func computeRoots(eds *rsmt2d.ExtendedDataSquare) error {
+	if _, err := eds.RowRoots(); err != nil {
+		return err
+	}
+	_, err := eds.ColRoots()
+	return err
+}
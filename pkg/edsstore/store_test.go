@@ -0,0 +1,131 @@
+package edsstore_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/edsstore"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const appVersion = appconsts.LatestVersion
+
+func TestStore(t *testing.T) {
+	store, err := edsstore.NewStore(2, "")
+	require.NoError(t, err)
+
+	eds1, root1 := newEDS(t, 1)
+	eds2, root2 := newEDS(t, 2)
+	eds3, root3 := newEDS(t, 3)
+
+	_, _, ok := store.Get(root1)
+	assert.False(t, ok)
+
+	require.NoError(t, store.Put(1, appVersion, root1, eds1))
+	require.NoError(t, store.Put(2, appVersion, root2, eds2))
+	got, gotVersion, ok := store.Get(root1)
+	require.True(t, ok)
+	assert.True(t, got.Equals(eds1))
+	assert.Equal(t, appVersion, gotVersion)
+
+	// only the squares of the last two heights are kept
+	require.NoError(t, store.Put(3, appVersion, root3, eds3))
+	assert.Equal(t, 2, store.Len())
+	_, _, ok = store.Get(root1)
+	assert.False(t, ok)
+	got, _, ok = store.Get(root2)
+	require.True(t, ok)
+	assert.True(t, got.Equals(eds2))
+	got, _, ok = store.Get(root3)
+	require.True(t, ok)
+	assert.True(t, got.Equals(eds3))
+
+	// the same data root at a new height is kept for that height
+	require.NoError(t, store.Put(4, appVersion, root2, eds2))
+	require.NoError(t, store.Put(5, appVersion, root1, eds1))
+	_, _, ok = store.Get(root3)
+	assert.False(t, ok)
+	_, _, ok = store.Get(root2)
+	assert.True(t, ok)
+}
+
+func TestStoreDisk(t *testing.T) {
+	dir := t.TempDir()
+	store, err := edsstore.NewStore(1, dir)
+	require.NoError(t, err)
+
+	eds1, root1 := newEDS(t, 1)
+	eds2, root2 := newEDS(t, 2)
+	require.NoError(t, store.Put(1, appVersion, root1, eds1))
+
+	// a new store reads the square from disk
+	store, err = edsstore.NewStore(1, dir)
+	require.NoError(t, err)
+	assert.Equal(t, 0, store.Len())
+	got, gotVersion, ok := store.Get(root1)
+	require.True(t, ok)
+	assert.True(t, got.Equals(eds1))
+	assert.Equal(t, appVersion, gotVersion)
+	assert.Equal(t, 1, store.Len())
+
+	// the squares of heights that are no longer kept are pruned from disk
+	require.NoError(t, store.Put(2, appVersion, root2, eds2))
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+
+	store, err = edsstore.NewStore(1, dir)
+	require.NoError(t, err)
+	_, _, ok = store.Get(root1)
+	assert.False(t, ok)
+	got, _, ok = store.Get(root2)
+	require.True(t, ok)
+	assert.True(t, got.Equals(eds2))
+
+	// a corrupted square is not returned
+	path := filepath.Join(dir, files[0].Name())
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	bz[len(bz)-1] ^= 0xFF
+	require.NoError(t, os.WriteFile(path, bz, 0o644))
+	store, err = edsstore.NewStore(1, dir)
+	require.NoError(t, err)
+	_, _, ok = store.Get(root2)
+	assert.False(t, ok)
+}
+
+func TestNilStore(t *testing.T) {
+	var store *edsstore.Store
+	eds, root := newEDS(t, 1)
+	require.NoError(t, store.Put(1, appVersion, root, eds))
+	_, _, ok := store.Get(root)
+	assert.False(t, ok)
+	assert.Equal(t, 0, store.Len())
+}
+
+func TestNewStoreInvalidSize(t *testing.T) {
+	_, err := edsstore.NewStore(0, "")
+	assert.Error(t, err)
+}
+
+// newEDS returns an extended data square of a 2x2 square of shares with the
+// given namespace and its data root.
+func newEDS(t *testing.T, namespace byte) (*rsmt2d.ExtendedDataSquare, []byte) {
+	ns := share.MustNewV0Namespace(bytes.Repeat([]byte{namespace}, share.NamespaceVersionZeroIDSize))
+	shares := make([][]byte, 4)
+	for i := range shares {
+		shares[i] = append(ns.Bytes(), bytes.Repeat([]byte{byte(i)}, share.ShareSize-share.NamespaceSize)...)
+	}
+	eds, err := da.ExtendSharesForVersion(shares, appVersion)
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	return eds, dah.Hash()
+}
@@ -0,0 +1,196 @@
+// Package fraud detects extended data squares that are not correctly erasure
+// coded and creates and verifies compact proofs of this misbehaviour.
+package fraud
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/pkg/wrapper"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// ErrInvalidProof is returned when a bad encoding proof doesn't prove that an
+// axis is incorrectly erasure coded.
+var ErrInvalidProof = errors.New("invalid bad encoding proof")
+
+// DetectBadEncoding returns a bad encoding proof for the first row, or else the
+// first column, of eds that is not correctly erasure coded. It returns nil if
+// every axis of eds is correctly erasure coded. The roots of eds must be the
+// roots of dah and appVersion must be the app version of the square's block.
+func DetectBadEncoding(eds *rsmt2d.ExtendedDataSquare, dah da.DataAvailabilityHeader, appVersion uint64) (*BadEncodingProof, error) {
+	rowRoots, err := eds.RowRoots()
+	if err != nil {
+		return nil, err
+	}
+	colRoots, err := eds.ColRoots()
+	if err != nil {
+		return nil, err
+	}
+	if !equalRoots(rowRoots, dah.RowRoots) || !equalRoots(colRoots, dah.ColumnRoots) {
+		return nil, errors.New("the roots of the extended data square differ from the data availability header")
+	}
+
+	codec := appconsts.Codec(appVersion)
+	for _, axis := range []Axis{Axis_ROW, Axis_COL} {
+		for i := uint(0); i < eds.Width(); i++ {
+			encoded, err := isCorrectlyEncoded(codec, axisShares(eds, axis, i))
+			if err != nil {
+				return nil, err
+			}
+			if !encoded {
+				return NewBadEncodingProof(eds, appVersion, axis, i)
+			}
+		}
+	}
+	return nil, nil
+}
+
+// NewBadEncodingProof returns a bad encoding proof for the axis of eds at
+// index. appVersion is the app version of the square's block. It doesn't check
+// that the axis is incorrectly erasure coded.
+func NewBadEncodingProof(eds *rsmt2d.ExtendedDataSquare, appVersion uint64, axis Axis, index uint) (*BadEncodingProof, error) {
+	width := eds.Width()
+	if index >= width {
+		return nil, fmt.Errorf("index %d is out of the bounds of the extended data square of width %d", index, width)
+	}
+	half := width / 2
+	shares := axisShares(eds, axis, index)
+
+	befp := &BadEncodingProof{Axis: axis, Index: uint32(index), AppVersion: appVersion}
+	for i := half; i < width; i++ {
+		// the trees of the orthogonal axes of the parity half only contain
+		// leaves of the parity shares namespace so the shares can be proven
+		// even if the original data square is not ordered by namespace.
+		tree := wrapper.NewVersionedErasuredNamespacedMerkleTree(appVersion, uint64(half), i)
+		for _, sh := range axisShares(eds, axis.orthogonal(), i) {
+			if err := tree.Push(sh); err != nil {
+				return nil, err
+			}
+		}
+		nmtProof, err := tree.ProveRange(int(index), int(index)+1)
+		if err != nil {
+			return nil, err
+		}
+		befp.Shares = append(befp.Shares, shares[i])
+		befp.Proofs = append(befp.Proofs, &proof.NMTProof{
+			Start:    int32(nmtProof.Start()),
+			End:      int32(nmtProof.End()),
+			Nodes:    nmtProof.Nodes(),
+			LeafHash: nmtProof.LeafHash(),
+		})
+	}
+	return befp, nil
+}
+
+// Verify returns nil if the proof proves that an axis of the extended data
+// square committed to by dah is not correctly erasure coded. Otherwise, it
+// returns an error wrapping ErrInvalidProof.
+//
+// The axis is reconstructed from the proven shares and its root is compared
+// to the committed one. Shares that aren't the erasure coding of the committed
+// original data decode to arbitrary data, so the root is computed without
+// requiring the reconstructed original data to be ordered by namespace.
+func (p *BadEncodingProof) Verify(dah da.DataAvailabilityHeader) error {
+	width := uint(len(dah.RowRoots))
+	if width == 0 || uint(len(dah.ColumnRoots)) != width {
+		return fmt.Errorf("%w: data availability header has %d row roots and %d column roots", ErrInvalidProof, len(dah.RowRoots), len(dah.ColumnRoots))
+	}
+	half := width / 2
+	if uint(p.Index) >= width {
+		return fmt.Errorf("%w: index %d is out of the bounds of the extended data square of width %d", ErrInvalidProof, p.Index, width)
+	}
+	if uint(len(p.Shares)) != half || uint(len(p.Proofs)) != half {
+		return fmt.Errorf("%w: expected %d shares and proofs, got %d shares and %d proofs", ErrInvalidProof, half, len(p.Shares), len(p.Proofs))
+	}
+
+	axisRoots, orthogonalRoots := dah.RowRoots, dah.ColumnRoots
+	if p.Axis == Axis_COL {
+		axisRoots, orthogonalRoots = dah.ColumnRoots, dah.RowRoots
+	}
+
+	shares := make([][]byte, width)
+	for i, sh := range p.Shares {
+		nmtProof := p.Proofs[i]
+		if nmtProof == nil || len(sh) != share.ShareSize {
+			return fmt.Errorf("%w: invalid share or proof %d", ErrInvalidProof, i)
+		}
+		if nmtProof.Start != int32(p.Index) || nmtProof.End != int32(p.Index)+1 {
+			return fmt.Errorf("%w: proof %d is for the range [%d, %d) instead of index %d", ErrInvalidProof, i, nmtProof.Start, nmtProof.End, p.Index)
+		}
+		inclusion := nmt.NewInclusionProof(int(nmtProof.Start), int(nmtProof.End), nmtProof.Nodes, true)
+		if !inclusion.VerifyInclusion(appconsts.NewBaseHashFuncForVersion(p.AppVersion)(), wrapper.ParityNamespace(p.AppVersion), [][]byte{sh}, orthogonalRoots[half+uint(i)]) {
+			return fmt.Errorf("%w: share %d is not included in its orthogonal axis", ErrInvalidProof, i)
+		}
+		shares[half+uint(i)] = bytes.Clone(sh)
+	}
+
+	decoded, err := appconsts.Codec(p.AppVersion).Decode(shares)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidProof, err)
+	}
+	tree := newUnorderedTree(p.AppVersion, uint64(half), uint(p.Index))
+	for _, sh := range decoded {
+		if err := tree.Push(sh); err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidProof, err)
+		}
+	}
+	root, err := tree.Root()
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidProof, err)
+	}
+	if bytes.Equal(root, axisRoots[p.Index]) {
+		return fmt.Errorf("%w: %s %d is correctly erasure coded", ErrInvalidProof, p.Axis, p.Index)
+	}
+	return nil
+}
+
+// orthogonal returns the axis orthogonal to a.
+func (a Axis) orthogonal() Axis {
+	if a == Axis_ROW {
+		return Axis_COL
+	}
+	return Axis_ROW
+}
+
+// axisShares returns the shares of the axis of eds at index.
+func axisShares(eds *rsmt2d.ExtendedDataSquare, axis Axis, index uint) [][]byte {
+	if axis == Axis_ROW {
+		return eds.Row(index)
+	}
+	return eds.Col(index)
+}
+
+// isCorrectlyEncoded returns true if the parity half of shares is the erasure
+// coding of the original half.
+func isCorrectlyEncoded(codec rsmt2d.Codec, shares [][]byte) (bool, error) {
+	half := len(shares) / 2
+	parity, err := codec.Encode(shares[:half])
+	if err != nil {
+		return false, err
+	}
+	for i, sh := range parity {
+		if !bytes.Equal(sh, shares[half+i]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func equalRoots(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,150 @@
+package fraud_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/fraud"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/test/util/malicious"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/rsmt2d"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	coretypes "github.com/tendermint/tendermint/types"
+)
+
+func TestBadEncodingProof(t *testing.T) {
+	eds, dah, appVersion := outOfOrderBlock(t)
+	width := eds.Width()
+
+	// the out of order square is correctly erasure coded
+	befp, err := fraud.DetectBadEncoding(eds, dah, appVersion)
+	require.NoError(t, err)
+	assert.Nil(t, befp)
+
+	// corrupt the last parity share of the square and commit to it
+	shares := eds.Flattened()
+	corrupted := append([]byte{}, shares[len(shares)-1]...)
+	corrupted[len(corrupted)-1] ^= 0xFF
+	shares[len(shares)-1] = corrupted
+	badEDS, err := rsmt2d.ImportExtendedDataSquare(shares, appconsts.Codec(appVersion), malicious.NewConstructor(uint64(width/2)))
+	require.NoError(t, err)
+	badDAH, err := da.NewDataAvailabilityHeader(badEDS)
+	require.NoError(t, err)
+
+	befp, err = fraud.DetectBadEncoding(badEDS, badDAH, appVersion)
+	require.NoError(t, err)
+	require.NotNil(t, befp)
+	assert.Equal(t, fraud.Axis_ROW, befp.Axis)
+	assert.Equal(t, uint32(width-1), befp.Index)
+	assert.Len(t, befp.Shares, int(width/2))
+	assert.Equal(t, appVersion, befp.AppVersion)
+	assert.NoError(t, befp.Verify(badDAH))
+
+	// the proof survives a round trip through its encoding
+	bz, err := befp.Marshal()
+	require.NoError(t, err)
+	var decoded fraud.BadEncodingProof
+	require.NoError(t, decoded.Unmarshal(bz))
+	assert.NoError(t, decoded.Verify(badDAH))
+
+	// the corrupted column can be proven too
+	colProof, err := fraud.NewBadEncodingProof(badEDS, appVersion, fraud.Axis_COL, width-1)
+	require.NoError(t, err)
+	assert.NoError(t, colProof.Verify(badDAH))
+
+	t.Run("proof doesn't verify against the honest header", func(t *testing.T) {
+		assert.ErrorIs(t, befp.Verify(dah), fraud.ErrInvalidProof)
+	})
+
+	t.Run("proof of a correctly erasure coded axis doesn't verify", func(t *testing.T) {
+		proof, err := fraud.NewBadEncodingProof(badEDS, appVersion, fraud.Axis_ROW, width/2)
+		require.NoError(t, err)
+		assert.ErrorIs(t, proof.Verify(badDAH), fraud.ErrInvalidProof)
+	})
+
+	t.Run("proof with a tampered share doesn't verify", func(t *testing.T) {
+		var tampered fraud.BadEncodingProof
+		require.NoError(t, tampered.Unmarshal(bz))
+		tampered.Shares[0][share.ShareSize-1] ^= 0xFF
+		assert.ErrorIs(t, tampered.Verify(badDAH), fraud.ErrInvalidProof)
+	})
+
+	t.Run("proof with missing shares doesn't verify", func(t *testing.T) {
+		var truncated fraud.BadEncodingProof
+		require.NoError(t, truncated.Unmarshal(bz))
+		truncated.Shares = truncated.Shares[1:]
+		truncated.Proofs = truncated.Proofs[1:]
+		assert.ErrorIs(t, truncated.Verify(badDAH), fraud.ErrInvalidProof)
+	})
+
+	t.Run("detection requires the header of the square", func(t *testing.T) {
+		_, err := fraud.DetectBadEncoding(badEDS, dah, appVersion)
+		assert.Error(t, err)
+	})
+}
+
+func TestBadEncodingProofOfOriginalRow(t *testing.T) {
+	eds, dah, appVersion := outOfOrderBlock(t)
+	width := eds.Width()
+
+	// the rows of the original data aren't ordered by namespace but are
+	// correctly erasure coded.
+	honestProof, err := fraud.NewBadEncodingProof(eds, appVersion, fraud.Axis_ROW, 0)
+	require.NoError(t, err)
+	assert.ErrorIs(t, honestProof.Verify(dah), fraud.ErrInvalidProof)
+
+	// corrupt the last parity share of the first row and commit to it
+	shares := eds.Flattened()
+	corrupted := append([]byte{}, shares[width-1]...)
+	corrupted[len(corrupted)-1] ^= 0xFF
+	shares[width-1] = corrupted
+	badEDS, err := rsmt2d.ImportExtendedDataSquare(shares, appconsts.Codec(appVersion), malicious.NewConstructor(uint64(width/2)))
+	require.NoError(t, err)
+	badDAH, err := da.NewDataAvailabilityHeader(badEDS)
+	require.NoError(t, err)
+
+	befp, err := fraud.DetectBadEncoding(badEDS, badDAH, appVersion)
+	require.NoError(t, err)
+	require.NotNil(t, befp)
+	assert.Equal(t, fraud.Axis_ROW, befp.Axis)
+	assert.Equal(t, uint32(0), befp.Index)
+	assert.NoError(t, befp.Verify(badDAH))
+}
+
+// outOfOrderBlock returns the extended data square, data availability header
+// and app version of a block proposed with
+// malicious.OutOfOrderPrepareProposal.
+func outOfOrderBlock(t *testing.T) (*rsmt2d.ExtendedDataSquare, da.DataAvailabilityHeader, uint64) {
+	accounts := testfactory.GenerateAccounts(3)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), accounts...)
+	badApp := &malicious.App{App: testApp}
+	badApp.SetMaliciousBehavior(malicious.BehaviorConfig{HandlerName: malicious.OutOfOrderHandlerKey})
+
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	txs := testutil.RandBlobTxsWithAccounts(t, testApp, encCfg.TxConfig, kr, 1000, 3, false, testutil.ChainID, accounts)
+	res := badApp.OutOfOrderPrepareProposal(abci.RequestPrepareProposal{
+		BlockData: &tmproto.Data{Txs: coretypes.Txs(txs).ToSliceOfBytes()},
+		ChainId:   testutil.ChainID,
+		Height:    testApp.LastBlockHeight() + 1,
+		Time:      time.Now(),
+	})
+	require.Len(t, res.BlockData.Txs, len(txs))
+
+	dataSquare, err := malicious.Construct(res.BlockData.Txs, testApp.AppVersion(), appconsts.DefaultSquareSizeUpperBound, malicious.OutOfOrderExport)
+	require.NoError(t, err)
+	eds, err := malicious.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+	require.Equal(t, res.BlockData.Hash, dah.Hash())
+	return eds, dah, testApp.AppVersion()
+}
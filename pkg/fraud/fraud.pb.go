@@ -0,0 +1,539 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/core/v1/fraud/fraud.proto
+
+package fraud
+
+import (
+	fmt "fmt"
+	proof "github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Axis is a row or a column of an extended data square.
+type Axis int32
+
+const (
+	// ROW is a row of the extended data square.
+	Axis_ROW Axis = 0
+	// COL is a column of the extended data square.
+	Axis_COL Axis = 1
+)
+
+var Axis_name = map[int32]string{
+	0: "ROW",
+	1: "COL",
+}
+
+var Axis_value = map[string]int32{
+	"ROW": 0,
+	"COL": 1,
+}
+
+func (x Axis) String() string {
+	return proto.EnumName(Axis_name, int32(x))
+}
+
+func (Axis) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b85a3b2032bc9e60, []int{0}
+}
+
+// BadEncodingProof proves that a row or a column of an extended data square
+// committed to by a data availability header is not correctly erasure coded.
+// It contains the parity half of the axis, which is enough to reconstruct the
+// whole axis, along with the inclusion proofs of these shares to the roots of
+// the orthogonal axes.
+type BadEncodingProof struct {
+	// axis is the type of the incorrectly erasure coded axis.
+	Axis Axis `protobuf:"varint,1,opt,name=axis,proto3,enum=celestia.core.v1.fraud.Axis" json:"axis,omitempty"`
+	// index is the index of the incorrectly erasure coded axis.
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	// shares are the shares of the parity half of the axis ordered by their
+	// index in the axis.
+	Shares [][]byte `protobuf:"bytes,3,rep,name=shares,proto3" json:"shares,omitempty"`
+	// proofs are the NMT inclusion proofs of the shares to the roots of the
+	// orthogonal axes.
+	Proofs []*proof.NMTProof `protobuf:"bytes,4,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	// app_version is the app version of the block of the extended data square.
+	// It determines the erasure codec and the NMT hasher of the square.
+	AppVersion uint64 `protobuf:"varint,5,opt,name=app_version,json=appVersion,proto3" json:"app_version,omitempty"`
+}
+
+func (m *BadEncodingProof) Reset()         { *m = BadEncodingProof{} }
+func (m *BadEncodingProof) String() string { return proto.CompactTextString(m) }
+func (*BadEncodingProof) ProtoMessage()    {}
+func (*BadEncodingProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b85a3b2032bc9e60, []int{0}
+}
+func (m *BadEncodingProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BadEncodingProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BadEncodingProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BadEncodingProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BadEncodingProof.Merge(m, src)
+}
+func (m *BadEncodingProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *BadEncodingProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_BadEncodingProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BadEncodingProof proto.InternalMessageInfo
+
+func (m *BadEncodingProof) GetAxis() Axis {
+	if m != nil {
+		return m.Axis
+	}
+	return Axis_ROW
+}
+
+func (m *BadEncodingProof) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *BadEncodingProof) GetShares() [][]byte {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+func (m *BadEncodingProof) GetProofs() []*proof.NMTProof {
+	if m != nil {
+		return m.Proofs
+	}
+	return nil
+}
+
+func (m *BadEncodingProof) GetAppVersion() uint64 {
+	if m != nil {
+		return m.AppVersion
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("celestia.core.v1.fraud.Axis", Axis_name, Axis_value)
+	proto.RegisterType((*BadEncodingProof)(nil), "celestia.core.v1.fraud.BadEncodingProof")
+}
+
+func init() {
+	proto.RegisterFile("celestia/core/v1/fraud/fraud.proto", fileDescriptor_b85a3b2032bc9e60)
+}
+
+var fileDescriptor_b85a3b2032bc9e60 = []byte{
+	// 302 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xc1, 0x4a, 0x33, 0x31,
+	0x10, 0x80, 0x37, 0xff, 0x6e, 0xfb, 0x43, 0xaa, 0x52, 0x82, 0x94, 0x20, 0x12, 0x43, 0x4f, 0x8b,
+	0xd0, 0xac, 0xad, 0x17, 0xaf, 0x56, 0xc4, 0x8b, 0x5a, 0x09, 0xa2, 0xe0, 0x45, 0xd2, 0xdd, 0x74,
+	0x1b, 0xd4, 0x4d, 0x48, 0xda, 0xd2, 0xc7, 0xf0, 0xb1, 0xbc, 0x08, 0x3d, 0x7a, 0x94, 0xf6, 0x45,
+	0x64, 0x37, 0xad, 0x97, 0x7a, 0x19, 0x66, 0x32, 0xdf, 0x64, 0x3e, 0x06, 0xb6, 0x53, 0xf9, 0x2a,
+	0xdd, 0x44, 0x89, 0x24, 0xd5, 0x56, 0x26, 0xb3, 0x6e, 0x32, 0xb2, 0x62, 0x9a, 0xf9, 0xc8, 0x8c,
+	0xd5, 0x13, 0x8d, 0x5a, 0x1b, 0x86, 0x95, 0x0c, 0x9b, 0x75, 0x59, 0xd5, 0x3d, 0xd8, 0x9e, 0x35,
+	0x56, 0xeb, 0x91, 0x8f, 0x7e, 0xb6, 0xfd, 0x09, 0x60, 0xb3, 0x2f, 0xb2, 0xcb, 0x22, 0xd5, 0x99,
+	0x2a, 0xf2, 0xbb, 0xb2, 0x85, 0x4e, 0x60, 0x24, 0xe6, 0xca, 0x61, 0x40, 0x41, 0xbc, 0xd7, 0x3b,
+	0x64, 0x7f, 0xff, 0xcf, 0xce, 0xe7, 0xca, 0xf1, 0x8a, 0x44, 0xfb, 0xb0, 0xa6, 0x8a, 0x4c, 0xce,
+	0xf1, 0x3f, 0x0a, 0xe2, 0x5d, 0xee, 0x0b, 0xd4, 0x82, 0x75, 0x37, 0x16, 0x56, 0x3a, 0x1c, 0xd2,
+	0x30, 0xde, 0xe1, 0xeb, 0x0a, 0x9d, 0xc1, 0x7a, 0xe5, 0xe0, 0x70, 0x44, 0xc3, 0xb8, 0xd1, 0xa3,
+	0xdb, 0x1b, 0xbc, 0xe3, 0xed, 0xcd, 0x7d, 0x65, 0xc4, 0xd7, 0x3c, 0x3a, 0x82, 0x0d, 0x61, 0xcc,
+	0xf3, 0x4c, 0x5a, 0xa7, 0x74, 0x81, 0x6b, 0x14, 0xc4, 0x11, 0x87, 0xc2, 0x98, 0x07, 0xff, 0x72,
+	0x8c, 0x61, 0x54, 0x6a, 0xa1, 0xff, 0x30, 0xe4, 0x83, 0xc7, 0x66, 0x50, 0x26, 0x17, 0x83, 0xeb,
+	0x26, 0xe8, 0x5f, 0x7d, 0x2c, 0x09, 0x58, 0x2c, 0x09, 0xf8, 0x5e, 0x12, 0xf0, 0xbe, 0x22, 0xc1,
+	0x62, 0x45, 0x82, 0xaf, 0x15, 0x09, 0x9e, 0x3a, 0xb9, 0x9a, 0x8c, 0xa7, 0x43, 0x96, 0xea, 0xb7,
+	0x64, 0x23, 0xa2, 0x6d, 0xfe, 0x9b, 0x77, 0x84, 0x31, 0x89, 0x79, 0xc9, 0xfd, 0xd1, 0x87, 0xf5,
+	0xea, 0x72, 0xa7, 0x3f, 0x03, 0x00, 0x9e, 0x2b, 0x58, 0xee, 0x9b, 0x01, 0x00, 0x00,
+}
+
+func (m *BadEncodingProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BadEncodingProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BadEncodingProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AppVersion != 0 {
+		i = encodeVarintFraud(dAtA, i, uint64(m.AppVersion))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFraud(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Shares[iNdEx])
+			copy(dAtA[i:], m.Shares[iNdEx])
+			i = encodeVarintFraud(dAtA, i, uint64(len(m.Shares[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Index != 0 {
+		i = encodeVarintFraud(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Axis != 0 {
+		i = encodeVarintFraud(dAtA, i, uint64(m.Axis))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFraud(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFraud(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BadEncodingProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Axis != 0 {
+		n += 1 + sovFraud(uint64(m.Axis))
+	}
+	if m.Index != 0 {
+		n += 1 + sovFraud(uint64(m.Index))
+	}
+	if len(m.Shares) > 0 {
+		for _, b := range m.Shares {
+			l = len(b)
+			n += 1 + l + sovFraud(uint64(l))
+		}
+	}
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovFraud(uint64(l))
+		}
+	}
+	if m.AppVersion != 0 {
+		n += 1 + sovFraud(uint64(m.AppVersion))
+	}
+	return n
+}
+
+func sovFraud(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFraud(x uint64) (n int) {
+	return sovFraud(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BadEncodingProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFraud
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BadEncodingProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BadEncodingProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Axis", wireType)
+			}
+			m.Axis = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Axis |= Axis(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthFraud
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraud
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, make([]byte, postIndex-iNdEx))
+			copy(m.Shares[len(m.Shares)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFraud
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFraud
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proofs = append(m.Proofs, &proof.NMTProof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppVersion", wireType)
+			}
+			m.AppVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AppVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFraud(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFraud
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFraud(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFraud
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFraud
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFraud
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFraud
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFraud
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFraud        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFraud          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFraud = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,68 @@
+package fraud
+
+import (
+	"bytes"
+	"hash"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/wrapper"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/nmt/namespace"
+)
+
+// newUnorderedTree returns an erasured namespaced merkle tree of the given app
+// version for the axis at axisIndex that doesn't require its leaves to be
+// ordered by namespace. The root of leaves that are ordered by namespace is the
+// same as the root of the regular tree.
+func newUnorderedTree(appVersion, squareSize uint64, axisIndex uint) wrapper.ErasuredNamespacedMerkleTree {
+	newHashFunc := appconsts.NewBaseHashFuncForVersion(appVersion)
+	namespaceSize := appconsts.NamespaceSize(appVersion)
+	hasher := unorderedHasher{
+		NmtHasher: nmt.NewNmtHasher(newHashFunc(), namespace.IDSize(namespaceSize), true),
+		base:      newHashFunc(),
+		maxNs:     bytes.Repeat([]byte{0xFF}, namespaceSize),
+	}
+	tree := wrapper.NewVersionedErasuredNamespacedMerkleTree(appVersion, squareSize, axisIndex)
+	tree.SetTree(unorderedTree{nmt.New(newHashFunc(),
+		nmt.CustomHasher(hasher),
+		nmt.NamespaceIDSize(namespaceSize),
+		nmt.IgnoreMaxNamespace(true),
+	)})
+	return tree
+}
+
+// unorderedTree is a namespaced merkle tree that adds leaves without checking
+// that they are ordered by namespace.
+type unorderedTree struct {
+	*nmt.NamespacedMerkleTree
+}
+
+// Push adds data to the tree without checking its namespace.
+func (t unorderedTree) Push(data namespace.PrefixedData) error {
+	return t.ForceAddLeaf(data)
+}
+
+// unorderedHasher is an nmt.NmtHasher that doesn't check that siblings are
+// ordered by namespace when hashing a node.
+type unorderedHasher struct {
+	*nmt.NmtHasher
+	base  hash.Hash
+	maxNs []byte
+}
+
+// HashNode hashes the siblings left and right in the same way as
+// nmt.NmtHasher.HashNode with ignoreMaxNamespace set, but without checking
+// their namespace ranges, which aren't ordered for unordered leaves.
+func (h unorderedHasher) HashNode(left, right []byte) ([]byte, error) {
+	minNs := nmt.MinNamespace(left, h.NamespaceLen)
+	maxNs := nmt.MaxNamespace(right, h.NamespaceLen)
+	if bytes.Equal(nmt.MinNamespace(right, h.NamespaceLen), h.maxNs) {
+		maxNs = nmt.MaxNamespace(left, h.NamespaceLen)
+	}
+
+	h.base.Reset()
+	h.base.Write([]byte{nmt.NodePrefix})
+	h.base.Write(left)
+	h.base.Write(right)
+	return h.base.Sum(append(append([]byte{}, minNs...), maxNs...)), nil
+}
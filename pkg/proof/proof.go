@@ -20,6 +20,19 @@ import (
 // NewTxInclusionProof returns a new share inclusion proof for the given
 // transaction index.
 func NewTxInclusionProof(txs [][]byte, txIndex, appVersion uint64) (ShareProof, error) {
+	return newTxInclusionProof(nil, txs, txIndex, appVersion)
+}
+
+// NewTxInclusionProofFromEDS returns a new share inclusion proof for the given
+// transaction index using the extended data square of the block containing the
+// transactions instead of extending the square again.
+func NewTxInclusionProofFromEDS(eds *rsmt2d.ExtendedDataSquare, txs [][]byte, txIndex, appVersion uint64) (ShareProof, error) {
+	return newTxInclusionProof(eds, txs, txIndex, appVersion)
+}
+
+// newTxInclusionProof returns a new share inclusion proof for the given
+// transaction index. The square is extended if eds is nil.
+func newTxInclusionProof(eds *rsmt2d.ExtendedDataSquare, txs [][]byte, txIndex, appVersion uint64) (ShareProof, error) {
 	if txIndex >= uint64(len(txs)) {
 		return ShareProof{}, fmt.Errorf("txIndex %d out of bounds", txIndex)
 	}
@@ -44,7 +57,13 @@ func NewTxInclusionProof(txs [][]byte, txIndex, appVersion uint64) (ShareProof,
 	}
 
 	namespace := getTxNamespace(txs[txIndex])
-	return NewShareInclusionProof(dataSquare, namespace, shareRange)
+	if eds == nil {
+		return NewShareInclusionProof(dataSquare, namespace, shareRange)
+	}
+	if uint(dataSquare.Size()*2) != eds.Width() {
+		return ShareProof{}, fmt.Errorf("extended data square width %d does not match square size %d", eds.Width(), dataSquare.Size())
+	}
+	return NewShareInclusionProofFromEDS(eds, namespace, shareRange)
 }
 
 func getTxNamespace(tx []byte) (ns share.Namespace) {
@@ -8,6 +8,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmrand "github.com/tendermint/tendermint/libs/rand"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
 
 	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
 	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
@@ -19,6 +21,7 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/rsmt2d"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -283,3 +286,61 @@ func TestQueryTxInclusionProofRejectsNegativeValues(t *testing.T) {
 		t.Fatal("no rawProof expected")
 	}
 }
+
+func TestQueriersUseEDSGetter(t *testing.T) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	txs := testfactory.GenerateRandomTxs(10, 500)
+	txs = append(txs, blobfactory.RandBlobTxs(signer, tmrand.NewRand(), 10, 1, 500)...)
+
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+	require.NoError(t, err)
+	dah, err := da.NewDataAvailabilityHeader(eds)
+	require.NoError(t, err)
+
+	block := tmproto.Block{
+		Header: tmproto.Header{Version: version.Consensus{App: appconsts.LatestVersion}, DataHash: dah.Hash()},
+		Data:   tmproto.Data{Txs: txs.ToSliceOfBytes()},
+	}
+	bz, err := block.Marshal()
+	require.NoError(t, err)
+	req := abci.RequestQuery{Data: bz}
+
+	getter := &mockEDSGetter{dataRoot: dah.Hash(), eds: eds}
+	queries := []struct {
+		path    []string
+		querier sdk.Querier
+		cached  sdk.Querier
+	}{
+		{[]string{"15"}, proof.QueryTxInclusionProof, proof.NewTxInclusionProofQuerier(getter)},
+		{[]string{"0", "2"}, proof.QueryShareInclusionProof, proof.NewShareInclusionProofQuerier(getter)},
+	}
+	for _, q := range queries {
+		want, err := q.querier(sdk.Context{}, q.path, req)
+		require.NoError(t, err)
+		got, err := q.cached(sdk.Context{}, q.path, req)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+
+		var shareProof proof.ShareProof
+		require.NoError(t, shareProof.Unmarshal(got))
+		assert.NoError(t, shareProof.Validate(dah.Hash()))
+	}
+	assert.Equal(t, 2, getter.hits)
+}
+
+type mockEDSGetter struct {
+	dataRoot []byte
+	eds      *rsmt2d.ExtendedDataSquare
+	hits     int
+}
+
+func (m *mockEDSGetter) Get(dataRoot []byte) (*rsmt2d.ExtendedDataSquare, uint64, bool) {
+	if !bytes.Equal(dataRoot, m.dataRoot) {
+		return nil, 0, false
+	}
+	m.hits++
+	return m.eds, appconsts.LatestVersion, true
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	"github.com/celestiaorg/go-square/v2"
 	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/rsmt2d"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -18,6 +19,12 @@ import (
 
 const TxInclusionQueryPath = "txInclusionProof"
 
+// EDSGetter returns the extended data square with the given data root and the
+// app version it was extended with if it is available.
+type EDSGetter interface {
+	Get(dataRoot []byte) (eds *rsmt2d.ExtendedDataSquare, appVersion uint64, ok bool)
+}
+
 // Querier defines the logic performed when the ABCI client using the Query
 // method with the custom prove.QueryPath. The index of the transaction being
 // proved must be appended to the path. The marshalled bytes of the transaction
@@ -25,7 +32,20 @@ const TxInclusionQueryPath = "txInclusionProof"
 //
 // example path for proving the third transaction in that block:
 // custom/txInclusionProof/3
-func QueryTxInclusionProof(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+func QueryTxInclusionProof(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+	return NewTxInclusionProofQuerier(nil)(ctx, path, req)
+}
+
+// NewTxInclusionProofQuerier returns a QueryTxInclusionProof querier that
+// uses the extended data square of the block from edsGetter, if it is
+// available, instead of extending the block's square again.
+func NewTxInclusionProofQuerier(edsGetter EDSGetter) sdk.Querier {
+	return func(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		return queryTxInclusionProof(edsGetter, path, req)
+	}
+}
+
+func queryTxInclusionProof(edsGetter EDSGetter, path []string, req abci.RequestQuery) ([]byte, error) {
 	// parse the index from the path
 	if len(path) != 1 {
 		return nil, fmt.Errorf("expected query path length: 1 actual: %d ", len(path))
@@ -50,7 +70,12 @@ func QueryTxInclusionProof(_ sdk.Context, path []string, req abci.RequestQuery)
 	}
 
 	// create and marshal the tx inclusion proof, which we return in the form of []byte
-	shareProof, err := NewTxInclusionProof(data.Txs.ToSliceOfBytes(), uint64(index), pbb.Header.Version.App)
+	var shareProof ShareProof
+	if eds, ok := getEDS(edsGetter, pbb.Header.DataHash); ok {
+		shareProof, err = NewTxInclusionProofFromEDS(eds, data.Txs.ToSliceOfBytes(), uint64(index), pbb.Header.Version.App)
+	} else {
+		shareProof, err = NewTxInclusionProof(data.Txs.ToSliceOfBytes(), uint64(index), pbb.Header.Version.App)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +94,20 @@ const ShareInclusionQueryPath = "shareInclusionProof"
 // inclusion proofs of a set of shares to the data root. The share range should
 // be appended to the path. Example path for proving the set of shares [3, 5]:
 // custom/shareInclusionProof/3/5
-func QueryShareInclusionProof(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+func QueryShareInclusionProof(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+	return NewShareInclusionProofQuerier(nil)(ctx, path, req)
+}
+
+// NewShareInclusionProofQuerier returns a QueryShareInclusionProof querier
+// that uses the extended data square of the block from edsGetter, if it is
+// available, instead of extending the block's square again.
+func NewShareInclusionProofQuerier(edsGetter EDSGetter) sdk.Querier {
+	return func(_ sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		return queryShareInclusionProof(edsGetter, path, req)
+	}
+}
+
+func queryShareInclusionProof(edsGetter EDSGetter, path []string, req abci.RequestQuery) ([]byte, error) {
 	// parse the share range from the path
 	if len(path) != 2 {
 		return nil, fmt.Errorf("expected query path length: 2 actual: %d ", len(path))
@@ -90,10 +128,16 @@ func QueryShareInclusionProof(_ sdk.Context, path []string, req abci.RequestQuer
 		return nil, fmt.Errorf("error reading block: %w", err)
 	}
 
-	// construct the data square from the block data. As we don't have
-	// access to the application's state machine we use the upper bound
-	// square size instead of the square size dictated from governance
-	dataSquare, err := square.Construct(pbb.Data.Txs, appconsts.SquareSizeUpperBound(pbb.Header.Version.App), appconsts.SubtreeRootThreshold(pbb.Header.Version.App))
+	eds, cached := getEDS(edsGetter, pbb.Header.DataHash)
+	var dataSquare square.Square
+	if cached {
+		dataSquare, err = share.FromBytes(eds.FlattenedODS())
+	} else {
+		// construct the data square from the block data. As we don't have
+		// access to the application's state machine we use the upper bound
+		// square size instead of the square size dictated from governance
+		dataSquare, err = square.Construct(pbb.Data.Txs, appconsts.SquareSizeUpperBound(pbb.Header.Version.App), appconsts.SubtreeRootThreshold(pbb.Header.Version.App))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -114,7 +158,12 @@ func QueryShareInclusionProof(_ sdk.Context, path []string, req abci.RequestQuer
 
 	shareRange := share.NewRange(begin, end)
 	// create and marshal the share inclusion proof, which we return in the form of []byte
-	shareProof, err := NewShareInclusionProof(dataSquare, nID, shareRange)
+	var shareProof ShareProof
+	if cached {
+		shareProof, err = NewShareInclusionProofFromEDS(eds, nID, shareRange)
+	} else {
+		shareProof, err = NewShareInclusionProof(dataSquare, nID, shareRange)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -157,6 +206,16 @@ func ParseNamespace(rawShares []share.Share, startShare int, endShare int) (shar
 	return startShareNs, nil
 }
 
+// getEDS returns the extended data square with the given data root from
+// edsGetter if it is set and has it.
+func getEDS(edsGetter EDSGetter, dataRoot []byte) (*rsmt2d.ExtendedDataSquare, bool) {
+	if edsGetter == nil || len(dataRoot) == 0 {
+		return nil, false
+	}
+	eds, _, ok := edsGetter.Get(dataRoot)
+	return eds, ok
+}
+
 func safeConvertInt64ToInt(x int64) (int, error) {
 	if x < math.MinInt {
 		return 0, fmt.Errorf("value %d is too small to be converted to int", x)
@@ -0,0 +1,76 @@
+package proof
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/wrapper"
+	"github.com/celestiaorg/nmt"
+	"github.com/celestiaorg/rsmt2d"
+)
+
+// NewSampleProof returns the share at the given row and column of the
+// extended data square of a block with the given app version and its NMT
+// inclusion proof to the row root. The share can belong to any quadrant of the
+// square.
+func NewSampleProof(eds *rsmt2d.ExtendedDataSquare, appVersion uint64, row, col uint) ([]byte, *NMTProof, error) {
+	width := eds.Width()
+	if row >= width || col >= width {
+		return nil, nil, fmt.Errorf("coordinates (%d, %d) are out of the bounds of the extended data square of width %d", row, col, width)
+	}
+
+	rowRoots, err := eds.RowRoots()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the eds tree is not accessible so the row's tree is re-created.
+	tree := wrapper.NewVersionedErasuredNamespacedMerkleTree(appVersion, uint64(width/2), row)
+	for _, sh := range eds.Row(row) {
+		if err := tree.Push(sh); err != nil {
+			return nil, nil, err
+		}
+	}
+	root, err := tree.Root()
+	if err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(rowRoots[row], root) {
+		return nil, nil, errors.New("eds row root is different than tree root")
+	}
+
+	proof, err := tree.ProveRange(int(col), int(col)+1)
+	if err != nil {
+		return nil, nil, err
+	}
+	return eds.GetCell(row, col), &NMTProof{
+		Start:    int32(proof.Start()),
+		End:      int32(proof.End()),
+		Nodes:    proof.Nodes(),
+		LeafHash: proof.LeafHash(),
+	}, nil
+}
+
+// VerifySampleProof returns true if proof proves that share is the share at
+// the given row and column of an extended data square of the given width with
+// rowRoot as the root of the row. The app version is the one of the square's
+// block.
+func VerifySampleProof(sh []byte, proof *NMTProof, appVersion uint64, row, col, width uint, rowRoot []byte) bool {
+	namespaceSize := appconsts.NamespaceSize(appVersion)
+	if proof == nil || row >= width || col >= width || len(sh) < namespaceSize {
+		return false
+	}
+	if proof.Start != int32(col) || proof.End != int32(col)+1 {
+		return false
+	}
+	// shares outside of the original data square are pushed to the row tree
+	// with the parity namespace.
+	namespace := wrapper.ParityNamespace(appVersion)
+	if row < width/2 && col < width/2 {
+		namespace = sh[:namespaceSize]
+	}
+	nmtProof := nmt.NewInclusionProof(int(proof.Start), int(proof.End), proof.Nodes, true)
+	return nmtProof.VerifyInclusion(appconsts.NewBaseHashFuncForVersion(appVersion)(), namespace, [][]byte{sh}, rowRoot)
+}
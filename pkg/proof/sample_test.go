@@ -0,0 +1,56 @@
+package proof_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/da"
+	"github.com/celestiaorg/celestia-app/v3/pkg/proof"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	square "github.com/celestiaorg/go-square/v2"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestSampleProof(t *testing.T) {
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	txs := testfactory.GenerateRandomTxs(10, 500)
+	txs = append(txs, blobfactory.RandBlobTxs(signer, tmrand.NewRand(), 10, 1, 500)...)
+
+	dataSquare, err := square.Construct(txs.ToSliceOfBytes(), appconsts.SquareSizeUpperBound(appconsts.LatestVersion), appconsts.SubtreeRootThreshold(appconsts.LatestVersion))
+	require.NoError(t, err)
+	appVersion := appconsts.LatestVersion
+	eds, err := da.ExtendSharesForVersion(share.ToBytes(dataSquare), appVersion)
+	require.NoError(t, err)
+	rowRoots, err := eds.RowRoots()
+	require.NoError(t, err)
+	width := eds.Width()
+
+	// sample the corners of each quadrant
+	for _, row := range []uint{0, width/2 - 1, width / 2, width - 1} {
+		for _, col := range []uint{0, width/2 - 1, width / 2, width - 1} {
+			sh, sampleProof, err := proof.NewSampleProof(eds, appVersion, row, col)
+			require.NoError(t, err)
+			assert.Equal(t, eds.GetCell(row, col), sh)
+			assert.True(t, proof.VerifySampleProof(sh, sampleProof, appVersion, row, col, width, rowRoots[row]), "row %d col %d", row, col)
+
+			// the proof doesn't verify for another position or root
+			assert.False(t, proof.VerifySampleProof(sh, sampleProof, appVersion, row, (col+1)%width, width, rowRoots[row]))
+			assert.False(t, proof.VerifySampleProof(sh, sampleProof, appVersion, row, col, width, rowRoots[(row+1)%width]))
+
+			tampered := append([]byte{}, sh...)
+			tampered[len(tampered)-1] ^= 0xFF
+			assert.False(t, proof.VerifySampleProof(tampered, sampleProof, appVersion, row, col, width, rowRoots[row]))
+		}
+	}
+
+	_, _, err = proof.NewSampleProof(eds, appVersion, width, 0)
+	assert.Error(t, err)
+	_, _, err = proof.NewSampleProof(eds, appVersion, 0, width)
+	assert.Error(t, err)
+}
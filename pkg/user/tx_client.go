@@ -30,6 +30,7 @@ import (
 	apperrors "github.com/celestiaorg/celestia-app/v3/app/errors"
 	"github.com/celestiaorg/celestia-app/v3/app/grpc/tx"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/blobchunk"
 	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
 	"github.com/celestiaorg/celestia-app/v3/x/minfee"
 )
@@ -255,6 +256,48 @@ func (client *TxClient) SubmitPayForBlobWithAccount(ctx context.Context, account
 	return client.ConfirmTx(ctx, resp.TxHash)
 }
 
+// SubmitChunkedPayForBlob splits data that may be too large to fit in a single
+// square into share version zero blobs of at most chunkSize bytes and submits
+// each of them in its own PayForBlobs transaction. Once all the chunks are
+// included, it submits a blob with the manifest that describes how to
+// reassemble the data. It returns the response of the manifest transaction
+// along with the manifest.
+func (client *TxClient) SubmitChunkedPayForBlob(ctx context.Context, namespace share.Namespace, data []byte, chunkSize int, opts ...TxOption) (*TxResponse, *blobchunk.Manifest, error) {
+	return client.SubmitChunkedPayForBlobWithAccount(ctx, client.defaultAccount, namespace, data, chunkSize, opts...)
+}
+
+// SubmitChunkedPayForBlobWithAccount is SubmitChunkedPayForBlob with the
+// transactions signed by the provided account.
+func (client *TxClient) SubmitChunkedPayForBlobWithAccount(ctx context.Context, account string, namespace share.Namespace, data []byte, chunkSize int, opts ...TxOption) (*TxResponse, *blobchunk.Manifest, error) {
+	chunks, err := blobchunk.Split(namespace, data, chunkSize, share.ShareVersionZero, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	heights := make([]int64, len(chunks))
+	for i, chunk := range chunks {
+		resp, err := client.SubmitPayForBlobWithAccount(ctx, account, []*share.Blob{chunk}, opts...)
+		if err != nil {
+			return nil, nil, fmt.Errorf("submitting chunk %d of %d: %w", i+1, len(chunks), err)
+		}
+		heights[i] = resp.Height
+	}
+
+	manifest, err := blobchunk.NewManifest(data, chunks, heights, client.signer.appVersion)
+	if err != nil {
+		return nil, nil, err
+	}
+	manifestBlob, err := manifest.Blob(share.ShareVersionZero, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	resp, err := client.SubmitPayForBlobWithAccount(ctx, account, []*share.Blob{manifestBlob}, opts...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("submitting manifest: %w", err)
+	}
+	return resp, manifest, nil
+}
+
 // BroadcastPayForBlob signs and broadcasts a transaction to pay for blobs.
 // It does not confirm that the transaction has been committed on chain.
 // If no gas or gas price is set, it will estimate the gas and use
@@ -276,7 +319,7 @@ func (client *TxClient) BroadcastPayForBlobWithAccount(ctx context.Context, acco
 	}
 
 	gasLimit := uint64(float64(types.DefaultEstimateGas(blobSizes)) * client.gasMultiplier)
-	fee := uint64(math.Ceil(appconsts.DefaultMinGasPrice * float64(gasLimit)))
+	fee := uint64(math.Ceil(client.defaultGasPrice * float64(gasLimit)))
 	// prepend calculated params, so it can be overwritten in case the user has specified it.
 	opts = append([]TxOption{SetGasLimit(gasLimit), SetFee(fee)}, opts...)
 
@@ -338,7 +381,7 @@ func (client *TxClient) BroadcastTx(ctx context.Context, msgs []sdktypes.Msg, op
 	}
 
 	if !hasUserSetFee {
-		fee := int64(math.Ceil(appconsts.DefaultMinGasPrice * float64(gasLimit)))
+		fee := int64(math.Ceil(client.defaultGasPrice * float64(gasLimit)))
 		txBuilder.SetFeeAmount(sdktypes.NewCoins(sdktypes.NewCoin(appconsts.BondDenom, sdktypes.NewInt(fee))))
 	}
 
@@ -615,6 +658,23 @@ func (client *TxClient) SetDefaultGasPrice(price float64) {
 	client.defaultGasPrice = price
 }
 
+// UpdateDefaultGasPrice sets the default gas price to the network min gas
+// price currently stored by the minfee module. Networks that adjust the
+// network min gas price every block require this to be called periodically so
+// that transactions are not rejected for paying too little.
+func (client *TxClient) UpdateDefaultGasPrice(ctx context.Context) error {
+	res, err := minfee.NewQueryClient(client.grpc).NetworkMinGasPrice(ctx, &minfee.QueryNetworkMinGasPrice{})
+	if err != nil {
+		return fmt.Errorf("querying network min gas price: %w", err)
+	}
+	price, err := res.NetworkMinGasPrice.Float64()
+	if err != nil {
+		return fmt.Errorf("converting network min gas price: %w", err)
+	}
+	client.SetDefaultGasPrice(price)
+	return nil
+}
+
 func (client *TxClient) SetGasMultiplier(multiplier float64) {
 	client.mtx.Lock()
 	defer client.mtx.Unlock()
@@ -13,13 +13,16 @@ import (
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/rand"
+	coretypes "github.com/tendermint/tendermint/types"
 
 	"github.com/celestiaorg/celestia-app/v3/app"
 	"github.com/celestiaorg/celestia-app/v3/app/encoding"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/blobchunk"
 	"github.com/celestiaorg/celestia-app/v3/pkg/user"
 	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
 	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/celestiaorg/go-square/v2/share"
 )
 
 func TestTxClientTestSuite(t *testing.T) {
@@ -94,6 +97,34 @@ func (suite *TxClientTestSuite) TestSubmitPayForBlob() {
 	})
 }
 
+func (suite *TxClientTestSuite) TestSubmitChunkedPayForBlob() {
+	t := suite.T()
+	ns := share.RandomBlobNamespace()
+	data := rand.Bytes(5000)
+
+	subCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	resp, manifest, err := suite.txClient.SubmitChunkedPayForBlob(subCtx, ns, data, 2000)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, resp.Code)
+	require.Len(t, manifest.ShareCommitments, 3)
+	for _, height := range manifest.Heights {
+		require.LessOrEqual(t, height, resp.Height)
+	}
+
+	getBlock := func(ctx context.Context, height int64) (*coretypes.Block, error) {
+		res, err := suite.ctx.Client.Block(ctx, &height)
+		if err != nil {
+			return nil, err
+		}
+		return res.Block, nil
+	}
+	read, err := blobchunk.Read(subCtx, getBlock, manifest)
+	require.NoError(t, err)
+	require.Equal(t, data, read)
+}
+
 func (suite *TxClientTestSuite) TestSubmitTx() {
 	t := suite.T()
 	gasLimit := uint64(1e6)
@@ -150,6 +181,16 @@ func (suite *TxClientTestSuite) TestSubmitTx() {
 		require.Equal(t, abci.CodeTypeOK, resp.Code)
 		suite.txClient.SetDefaultGasPrice(appconsts.DefaultMinGasPrice)
 	})
+
+	t.Run("submit tx with the network min gas price", func(t *testing.T) {
+		// a tx paying less than the network min gas price would be rejected
+		suite.txClient.SetDefaultGasPrice(0)
+		require.NoError(t, suite.txClient.UpdateDefaultGasPrice(suite.ctx.GoContext()))
+		resp, err := suite.txClient.SubmitTx(suite.ctx.GoContext(), []sdk.Msg{msg})
+		require.NoError(t, err)
+		require.Equal(t, abci.CodeTypeOK, resp.Code)
+		suite.txClient.SetDefaultGasPrice(appconsts.DefaultMinGasPrice)
+	})
 }
 
 func (suite *TxClientTestSuite) TestConfirmTx() {
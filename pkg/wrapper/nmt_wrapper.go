@@ -1,7 +1,9 @@
 package wrapper
 
 import (
+	"bytes"
 	"fmt"
+	"hash"
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	"github.com/celestiaorg/go-square/v2/share"
@@ -27,6 +29,8 @@ type ErasuredNamespacedMerkleTree struct {
 	squareSize uint64 // note: this refers to the width of the original square before erasure-coded
 	options    []nmt.Option
 	tree       Tree
+	// namespaceSize is the size of the namespace prefixed to each leaf.
+	namespaceSize int
 	// axisIndex is the index of the axis (row or column) that this tree is on. This is passed
 	// by rsmt2d and used to help determine which quadrant each leaf belongs to.
 	axisIndex uint64
@@ -53,18 +57,31 @@ type Tree interface {
 // `ignoreMaxNamespace=true`. axisIndex is the index of the row or column that
 // this tree is committing to. squareSize must be greater than zero.
 func NewErasuredNamespacedMerkleTree(squareSize uint64, axisIndex uint, options ...nmt.Option) ErasuredNamespacedMerkleTree {
+	return newErasuredNamespacedMerkleTree(appconsts.NewBaseHashFunc, share.NamespaceSize, squareSize, axisIndex, options...)
+}
+
+// NewVersionedErasuredNamespacedMerkleTree creates a new
+// ErasuredNamespacedMerkleTree like NewErasuredNamespacedMerkleTree but with
+// the base hash function and namespace size of the given app version.
+func NewVersionedErasuredNamespacedMerkleTree(appVersion, squareSize uint64, axisIndex uint, options ...nmt.Option) ErasuredNamespacedMerkleTree {
+	return newErasuredNamespacedMerkleTree(appconsts.NewBaseHashFuncForVersion(appVersion), appconsts.NamespaceSize(appVersion), squareSize, axisIndex, options...)
+}
+
+func newErasuredNamespacedMerkleTree(newHashFunc func() hash.Hash, namespaceSize int, squareSize uint64, axisIndex uint, options ...nmt.Option) ErasuredNamespacedMerkleTree {
 	if squareSize == 0 {
 		panic("cannot create a ErasuredNamespacedMerkleTree of squareSize == 0")
 	}
-	options = append(options, nmt.NamespaceIDSize(share.NamespaceSize))
+	options = append(options, nmt.NamespaceIDSize(namespaceSize))
 	options = append(options, nmt.IgnoreMaxNamespace(true))
-	tree := nmt.New(appconsts.NewBaseHashFunc(), options...)
-	return ErasuredNamespacedMerkleTree{squareSize: squareSize, options: options, tree: tree, axisIndex: uint64(axisIndex), shareIndex: 0}
+	tree := nmt.New(newHashFunc(), options...)
+	return ErasuredNamespacedMerkleTree{squareSize: squareSize, options: options, tree: tree, namespaceSize: namespaceSize, axisIndex: uint64(axisIndex), shareIndex: 0}
 }
 
 type constructor struct {
-	squareSize uint64
-	opts       []nmt.Option
+	squareSize    uint64
+	opts          []nmt.Option
+	newHashFunc   func() hash.Hash
+	namespaceSize int
 }
 
 // NewConstructor creates a tree constructor function as required by rsmt2d to
@@ -72,8 +89,22 @@ type constructor struct {
 // wrapper.ErasuredNamespacedMerkleTree.
 func NewConstructor(squareSize uint64, opts ...nmt.Option) rsmt2d.TreeConstructorFn {
 	return constructor{
-		squareSize: squareSize,
-		opts:       opts,
+		squareSize:    squareSize,
+		opts:          opts,
+		newHashFunc:   appconsts.NewBaseHashFunc,
+		namespaceSize: share.NamespaceSize,
+	}.NewTree
+}
+
+// NewVersionedConstructor creates a tree constructor function like
+// NewConstructor but with the base hash function and namespace size of the
+// given app version.
+func NewVersionedConstructor(appVersion, squareSize uint64, opts ...nmt.Option) rsmt2d.TreeConstructorFn {
+	return constructor{
+		squareSize:    squareSize,
+		opts:          opts,
+		newHashFunc:   appconsts.NewBaseHashFuncForVersion(appVersion),
+		namespaceSize: appconsts.NamespaceSize(appVersion),
 	}.NewTree
 }
 
@@ -81,7 +112,7 @@ func NewConstructor(squareSize uint64, opts ...nmt.Option) rsmt2d.TreeConstructo
 // wrapper.ErasuredNamespacedMerkleTree with predefined square size and
 // nmt.Options
 func (c constructor) NewTree(_ rsmt2d.Axis, axisIndex uint) rsmt2d.Tree {
-	newTree := NewErasuredNamespacedMerkleTree(c.squareSize, axisIndex, c.opts...)
+	newTree := newErasuredNamespacedMerkleTree(c.newHashFunc, c.namespaceSize, c.squareSize, axisIndex, c.opts...)
 	return &newTree
 }
 
@@ -94,16 +125,16 @@ func (w *ErasuredNamespacedMerkleTree) Push(data []byte) error {
 	if w.axisIndex+1 > 2*w.squareSize || w.shareIndex+1 > 2*w.squareSize {
 		return fmt.Errorf("pushed past predetermined square size: boundary at %d index at %d %d", 2*w.squareSize, w.axisIndex, w.shareIndex)
 	}
-	if len(data) < share.NamespaceSize {
+	if len(data) < w.namespaceSize {
 		return fmt.Errorf("data is too short to contain namespace ID")
 	}
-	nidAndData := make([]byte, share.NamespaceSize+len(data))
-	copy(nidAndData[share.NamespaceSize:], data)
+	nidAndData := make([]byte, w.namespaceSize+len(data))
+	copy(nidAndData[w.namespaceSize:], data)
 	// use the parity namespace if the cell is not in Q0 of the extended data square
 	if w.isQuadrantZero() {
-		copy(nidAndData[:share.NamespaceSize], data[:share.NamespaceSize])
+		copy(nidAndData[:w.namespaceSize], data[:w.namespaceSize])
 	} else {
-		copy(nidAndData[:share.NamespaceSize], share.ParitySharesNamespace.Bytes())
+		copy(nidAndData[:w.namespaceSize], parityNamespace(w.namespaceSize))
 	}
 	err := w.tree.Push(nidAndData)
 	if err != nil {
@@ -144,3 +175,19 @@ func (w *ErasuredNamespacedMerkleTree) isQuadrantZero() bool {
 func (w *ErasuredNamespacedMerkleTree) SetTree(tree Tree) {
 	w.tree = tree
 }
+
+// ParityNamespace returns the namespace that the shares outside of the
+// original data square are pushed to the tree with at the given app version.
+func ParityNamespace(appVersion uint64) []byte {
+	return parityNamespace(appconsts.NamespaceSize(appVersion))
+}
+
+// parityNamespace returns the namespace of the given size used for the shares
+// outside of the original data square. It is share.ParitySharesNamespace for
+// the namespace size of the shares.
+func parityNamespace(size int) []byte {
+	if size == share.NamespaceSize {
+		return share.ParitySharesNamespace.Bytes()
+	}
+	return bytes.Repeat([]byte{0xFF}, size)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/celestiaorg/celestia-app/v3/app"
 	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
 	bstypes "github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	"github.com/celestiaorg/celestia-app/v3/x/paramfilter"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -56,6 +57,20 @@ func ImmediateProposals(codec codec.Codec) Modifier {
 	}
 }
 
+// ImmediateParamChanges sets the delay of every param policy to zero so that
+// param changes take effect as soon as their proposal is executed.
+func ImmediateParamChanges(codec codec.Codec) Modifier {
+	return func(state map[string]json.RawMessage) map[string]json.RawMessage {
+		var paramFilterGenState paramfilter.GenesisState
+		codec.MustUnmarshalJSON(state[paramfilter.ModuleName], &paramFilterGenState)
+		for i := range paramFilterGenState.Policies {
+			paramFilterGenState.Policies[i].Delay = 0
+		}
+		state[paramfilter.ModuleName] = codec.MustMarshalJSON(&paramFilterGenState)
+		return state
+	}
+}
+
 // SetDataCommitmentWindow will set the provided data commitment window in the
 // blobstream module's genesis state.
 func SetDataCommitmentWindow(codec codec.Codec, window uint64) Modifier {
@@ -40,6 +40,8 @@ func (a *App) OutOfOrderPrepareProposal(req abci.RequestPrepareProposal) abci.Re
 		a.IBCKeeper,
 		a.ParamsKeeper,
 		a.MsgGateKeeper,
+		a.MinFeeKeeper,
+		a.NsregKeeper,
 	)
 
 	txs := app.FilterTxs(a.Logger(), sdkCtx, handler, a.GetTxConfig(), req.BlockData.Txs)
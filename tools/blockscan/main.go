@@ -9,7 +9,7 @@ import (
 	"os/signal"
 	"strconv"
 
-	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/celestiaorg/celestia-app/v3/pkg/blockdecode"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	"github.com/tendermint/tendermint/rpc/client/http"
@@ -129,17 +129,25 @@ func Trail(ctx context.Context, client *http.HTTP) error {
 
 func PrintBlock(block *types.Block) error {
 	fmt.Println("Height:", block.Height)
-	txs, err := testnode.DecodeBlockData(block.Data)
+	decoded, err := blockdecode.Decode(block.Data, block.Version.App)
 	if err != nil {
 		return err
 	}
-	for _, tx := range txs {
-		authTx, ok := tx.(authsigning.Tx)
+	for _, tx := range decoded.Txs {
+		authTx, ok := tx.Tx.(authsigning.Tx)
 		if !ok {
 			return fmt.Errorf("tx is not an auth.Tx")
 		}
 		PrintTx(authTx)
 	}
+	for _, pfb := range decoded.PFBs {
+		authTx, ok := pfb.Tx.Tx.(authsigning.Tx)
+		if !ok {
+			return fmt.Errorf("tx is not an auth.Tx")
+		}
+		PrintTx(authTx)
+		PrintBlobs(pfb.Blobs)
+	}
 	return nil
 }
 
@@ -151,6 +159,13 @@ func PrintTx(tx authsigning.Tx) {
 `, tx.GetSigners(), tx.GetFee(), printMessages(msgs))
 }
 
+func PrintBlobs(blobs []blockdecode.Blob) {
+	for _, blob := range blobs {
+		fmt.Printf("  Blob - Namespace: %X, Size: %d, Commitment: %X, Shares: [%d, %d)\n",
+			blob.Namespace.Bytes(), blob.Size, blob.Commitment, blob.ShareRange.Start, blob.ShareRange.End)
+	}
+}
+
 func printMessages(msgs []sdk.Msg) string {
 	output := ""
 	for _, msg := range msgs {
@@ -490,7 +490,7 @@ func generateSquareRoutine(
 			return err
 		}
 
-		eds, err := da.ExtendShares(share.ToBytes(dataSquare))
+		eds, err := da.ExtendSharesForVersion(share.ToBytes(dataSquare), cfg.AppVersion)
 		if err != nil {
 			return err
 		}
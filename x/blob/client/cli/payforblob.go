@@ -77,6 +77,8 @@ The JSON should look like:
 The namespaceID is the user-specifiable portion of a version 0 namespace.
 The namespaceID must be a hex encoded string of 10 bytes.
 The blob must be a hex encoded string of non-zero length.
+With --share-version 2 the blobs are compressed before they are submitted and
+the fees are paid for the compressed size.
 		`,
 		Aliases: []string{"pay-for-blobs", "PayForBlobs", "PayForBlob"},
 		Args: func(cmd *cobra.Command, args []string) error {
@@ -129,7 +131,7 @@ The blob must be a hex encoded string of non-zero length.
 					return err
 				}
 
-				return broadcastPFB(cmd, blob)
+				return broadcastPFB(cmd, shareVersion, blob)
 			}
 
 			paresdBlobs, err := parseSubmitBlobs(path)
@@ -146,13 +148,13 @@ The blob must be a hex encoded string of non-zero length.
 				blobs = append(blobs, blob)
 			}
 
-			return broadcastPFB(cmd, blobs...)
+			return broadcastPFB(cmd, shareVersion, blobs...)
 		},
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.PersistentFlags().Uint8(FlagNamespaceVersion, 0, "Specify the namespace version (default 0)")
-	cmd.PersistentFlags().Uint8(FlagShareVersion, 0, "Specify the share version (default 0)")
+	cmd.PersistentFlags().Uint8(FlagShareVersion, 0, "Specify the share version (default 0). Share version 2 compresses the blobs")
 	cmd.PersistentFlags().String(FlagFileInput, "", "Specify the file input")
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 	return cmd
@@ -178,6 +180,8 @@ func getBlobFromArguments(namespaceIDArg, blobArg string, namespaceVersion, shar
 		return types.NewV0Blob(namespace, rawblob)
 	case share.ShareVersionOne:
 		return types.NewV1Blob(namespace, rawblob, signer)
+	case types.ShareVersionTwo:
+		return types.NewV2Blob(namespace, rawblob)
 	default:
 		return nil, fmt.Errorf("share version %d is not supported", shareVersion)
 	}
@@ -200,39 +204,49 @@ func getNamespace(namespaceID []byte, namespaceVersion uint8) (share.Namespace,
 
 // broadcastPFB creates the new PFB message type that will later be broadcast to tendermint nodes
 // this private func is used in CmdPayForBlob
-func broadcastPFB(cmd *cobra.Command, b ...*share.Blob) error {
+func broadcastPFB(cmd *cobra.Command, shareVersion uint8, b ...*share.Blob) error {
 	clientCtx, err := client.GetClientTxContext(cmd)
 	if err != nil {
 		return err
 	}
 
-	pfbMsg, err := types.NewMsgPayForBlobs(clientCtx.FromAddress.String(), appconsts.LatestVersion, b...)
+	res, err := broadcastBlobTx(cmd, clientCtx, shareVersion, b...)
 	if err != nil {
 		return err
 	}
 
+	return clientCtx.PrintProto(res)
+}
+
+// broadcastBlobTx signs a PFB message for the blobs of the share version and
+// broadcasts it together with the blobs to tendermint nodes.
+func broadcastBlobTx(cmd *cobra.Command, clientCtx client.Context, shareVersion uint8, b ...*share.Blob) (*sdk.TxResponse, error) {
+	newMsgPayForBlobs := types.NewMsgPayForBlobs
+	if shareVersion == types.ShareVersionTwo {
+		newMsgPayForBlobs = types.NewMsgPayForV2Blobs
+	}
+	pfbMsg, err := newMsgPayForBlobs(clientCtx.FromAddress.String(), appconsts.LatestVersion, b...)
+	if err != nil {
+		return nil, err
+	}
+
 	// run message checks
 	if err = pfbMsg.ValidateBasic(); err != nil {
-		return err
+		return nil, err
 	}
 
 	txBytes, err := writeTx(clientCtx, sdktx.NewFactoryCLI(clientCtx, cmd.Flags()), pfbMsg)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	blobTx, err := tx.MarshalBlobTx(txBytes, b...)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// broadcast to a Tendermint node
-	res, err := clientCtx.BroadcastTx(blobTx)
-	if err != nil {
-		return err
-	}
-
-	return clientCtx.PrintProto(res)
+	return clientCtx.BroadcastTx(blobTx)
 }
 
 // writeTx attempts to generate and sign a transaction using the normal
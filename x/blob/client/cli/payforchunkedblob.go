@@ -0,0 +1,177 @@
+package cli
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/blobchunk"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+const (
+	// FlagChunkSize allows the user to override the maximum size of the
+	// chunks when submitting a chunked blob.
+	FlagChunkSize = "chunk-size"
+
+	// FlagInclusionTimeout allows the user to override how long to wait for
+	// each chunk to be included when submitting a chunked blob.
+	FlagInclusionTimeout = "inclusion-timeout"
+
+	// inclusionPollInterval is how often the inclusion of a chunk is checked.
+	inclusionPollInterval = time.Second
+)
+
+func CmdPayForChunkedBlob() *cobra.Command {
+	cmd := &cobra.Command{
+		Use: "pay-for-chunked-blob [namespaceID path]",
+		Example: "celestia-appd tx blob pay-for-chunked-blob 0x00010203040506070809 path/to/data \\\n" +
+			"\t--chain-id private \\\n" +
+			"\t--from validator \\\n" +
+			"\t--keyring-backend test \\\n" +
+			"\t--fees 210000utia \\\n" +
+			"\t--yes \n",
+		Short: "Pay for data of any size to be published to Celestia in chunks.",
+		Long: `Pay for data of any size to be published to Celestia in chunks.
+The file at path is split into blobs of at most --chunk-size bytes which are
+each paid for in their own transaction. Each transaction waits for the previous
+one to be included. Once all the chunks are included, a manifest blob is paid
+for that lists the share commitments and heights of the chunks along with the
+size and sha256 hash of the data, which is what readers use to reassemble it.
+The fees and gas flags apply to every transaction.
+
+The namespaceID is the user-specifiable portion of a version 0 namespace.
+The namespaceID must be a hex encoded string of 10 bytes.
+		`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			namespaceVersion, err := cmd.Flags().GetUint8(FlagNamespaceVersion)
+			if err != nil {
+				return err
+			}
+
+			shareVersion, err := cmd.Flags().GetUint8(FlagShareVersion)
+			if err != nil {
+				return err
+			}
+
+			chunkSize, err := cmd.Flags().GetInt(FlagChunkSize)
+			if err != nil {
+				return err
+			}
+
+			timeout, err := cmd.Flags().GetDuration(FlagInclusionTimeout)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.GenerateOnly || clientCtx.Simulate {
+				return errors.New("chunked blobs can only be broadcast")
+			}
+
+			namespaceID, err := hex.DecodeString(strings.TrimPrefix(args[0], "0x"))
+			if err != nil {
+				return fmt.Errorf("failed to decode hex namespace ID: %w", err)
+			}
+			namespace, err := getNamespace(namespaceID, namespaceVersion)
+			if err != nil {
+				return err
+			}
+
+			data, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			var signer []byte
+			if shareVersion == share.ShareVersionOne {
+				signer = clientCtx.FromAddress
+			}
+			chunks, err := blobchunk.Split(namespace, data, chunkSize, shareVersion, signer)
+			if err != nil {
+				return err
+			}
+
+			heights := make([]int64, len(chunks))
+			for i, chunk := range chunks {
+				heights[i], err = broadcastAndWaitForInclusion(cmd, clientCtx, timeout, shareVersion, chunk)
+				if err != nil {
+					return fmt.Errorf("chunk %d of %d: %w", i+1, len(chunks), err)
+				}
+				_, _ = fmt.Fprintf(os.Stderr, "included chunk %d of %d at height %d\n", i+1, len(chunks), heights[i])
+			}
+
+			manifest, err := blobchunk.NewManifest(data, chunks, heights, appconsts.LatestVersion)
+			if err != nil {
+				return err
+			}
+			manifestBlob, err := manifest.Blob(shareVersion, signer)
+			if err != nil {
+				return err
+			}
+
+			res, err := broadcastBlobTx(cmd, clientCtx, shareVersion, manifestBlob)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.PersistentFlags().Uint8(FlagNamespaceVersion, 0, "Specify the namespace version (default 0)")
+	cmd.PersistentFlags().Uint8(FlagShareVersion, 0, "Specify the share version (default 0)")
+	cmd.PersistentFlags().Int(FlagChunkSize, blobchunk.DefaultChunkSize, "Specify the maximum size of a chunk in bytes")
+	cmd.PersistentFlags().Duration(FlagInclusionTimeout, time.Minute, "Specify how long to wait for each chunk to be included")
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	return cmd
+}
+
+// broadcastAndWaitForInclusion broadcasts a PFB for the blob and waits until
+// it is included in a block. It returns the height of the block.
+func broadcastAndWaitForInclusion(cmd *cobra.Command, clientCtx client.Context, timeout time.Duration, shareVersion uint8, blob *share.Blob) (int64, error) {
+	res, err := broadcastBlobTx(cmd, clientCtx, shareVersion, blob)
+	if err != nil {
+		return 0, err
+	}
+	if res == nil {
+		return 0, errors.New("transaction was not broadcast")
+	}
+	if res.Code != 0 {
+		return 0, fmt.Errorf("broadcasting tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		included, err := authtx.QueryTx(clientCtx, res.TxHash)
+		if err == nil {
+			return checkIncludedTx(included)
+		}
+		if time.Now().After(deadline) {
+			return 0, fmt.Errorf("tx %s was not included within %s: %w", res.TxHash, timeout, err)
+		}
+		time.Sleep(inclusionPollInterval)
+	}
+}
+
+func checkIncludedTx(res *sdk.TxResponse) (int64, error) {
+	if res.Code != 0 {
+		return 0, fmt.Errorf("tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+	return res.Height, nil
+}
@@ -19,6 +19,7 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdPayForBlob())
+	cmd.AddCommand(CmdPayForChunkedBlob())
 
 	return cmd
 }
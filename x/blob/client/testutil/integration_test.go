@@ -1,6 +1,7 @@
 package testutil
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"os"
@@ -16,6 +17,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/blockdecode"
 	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
 
 	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
@@ -186,6 +188,46 @@ func (s *IntegrationTestSuite) TestSubmitPayForBlob() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestSubmitCompressedPayForBlob() {
+	require := s.Require()
+	require.NoError(s.ctx.WaitForNextBlock())
+
+	rawBlob := bytes.Repeat([]byte("celestia"), 1000)
+	args := []string{
+		hex.EncodeToString(share.RandomBlobNamespaceID()),
+		hex.EncodeToString(rawBlob),
+		fmt.Sprintf("--from=%s", username),
+		fmt.Sprintf("--%s=%d", paycli.FlagShareVersion, types.ShareVersionTwo),
+		fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
+		fmt.Sprintf("--%s=%s", flags.FlagFees, sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, sdk.NewInt(1000))).String()),
+		fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
+	}
+	out, err := clitestutil.ExecTestCLICmd(s.ctx.Context, paycli.CmdPayForBlob(), args)
+	require.NoError(err, out.String())
+	var txResp sdk.TxResponse
+	require.NoError(s.ctx.Codec.UnmarshalJSON(out.Bytes(), &txResp), out.String())
+	require.Equal(abci.CodeTypeOK, txResp.Code, out.String())
+
+	// the blob is paid for by its compressed size
+	for _, e := range txResp.Logs[0].GetEvents() {
+		if e.Type == types.EventTypePayForBlob {
+			blobSize, err := strconv.ParseInt(e.GetAttributes()[1].GetValue(), 10, 64)
+			require.NoError(err)
+			require.Less(int(blobSize), len(rawBlob))
+		}
+	}
+
+	// the blob data is decompressed when the block is decoded
+	block, err := s.ctx.Client.Block(s.ctx.GoContext(), &txResp.Height)
+	require.NoError(err)
+	decoded, err := blockdecode.Decode(block.Block.Data, block.Block.Version.App)
+	require.NoError(err)
+	require.Len(decoded.PFBs, 1)
+	require.Len(decoded.PFBs[0].Blobs, 1)
+	require.Equal(types.ShareVersionTwo, decoded.PFBs[0].Blobs[0].ShareVersion)
+	require.Equal(rawBlob, decoded.PFBs[0].Blobs[0].Data)
+}
+
 func TestIntegrationTestSuite(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping integration test in short mode.")
@@ -94,6 +94,19 @@ func ValidateBlobTx(txcfg client.TxEncodingConfig, bTx *tx.BlobTx, subtreeRootTh
 		return ErrBlobSizeMismatch.Wrapf("actual %v declared %v", sizes, msgPFB.BlobSizes)
 	}
 
+	// check that the blobs paid for as share version two are compressed blobs
+	for i, shareVersion := range msgPFB.ShareVersions {
+		if shareVersion != uint32(ShareVersionTwo) {
+			continue
+		}
+		if appVersion < v3.Version {
+			return ErrUnsupportedShareVersion.Wrapf("share version %d is not supported in %d. Supported from v3 onwards", shareVersion, appVersion)
+		}
+		if err := ValidateV2Blobs(bTx.Blobs[i]); err != nil {
+			return err
+		}
+	}
+
 	for i, ns := range msgPFB.Namespaces {
 		msgPFBNamespace, err := share.NewNamespaceFromBytes(ns)
 		if err != nil {
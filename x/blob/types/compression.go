@@ -0,0 +1,126 @@
+package types
+
+import (
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/klauspost/compress/zstd"
+)
+
+// ShareVersionTwo is the share version of compressed blobs. The data of a
+// share version two blob is a zstd frame of the data that was submitted so the
+// blob size, and therefore the gas paid for it, is that of the compressed data.
+//
+// go-square doesn't support share version two yet so compressed blobs are laid
+// out in the square as share version zero blobs and their share commitment is
+// computed over those shares. The share version two is recorded in the
+// MsgPayForBlobs that pays for them.
+const ShareVersionTwo = uint8(2)
+
+// MaxDecompressedBlobSize is the maximum size in bytes of the data of a
+// compressed blob once it is decompressed. It is the size of the largest blob
+// that fits in a square so compressing data doesn't allow submitting more of
+// it than an uncompressed blob.
+var MaxDecompressedBlobSize = appconsts.DefaultUpperBoundMaxBytes
+
+// NewV2Blob compresses data and returns the blob the compressed data is laid
+// out in. The blob must be paid for with NewMsgPayForV2Blobs.
+func NewV2Blob(ns share.Namespace, data []byte) (*share.Blob, error) {
+	if len(data) == 0 {
+		return nil, ErrZeroBlobSize
+	}
+	if len(data) > MaxDecompressedBlobSize {
+		return nil, ErrInvalidCompressedBlob.Wrapf("data size %d exceeds the max decompressed blob size %d", len(data), MaxDecompressedBlobSize)
+	}
+	compressed, err := CompressBlobData(data)
+	if err != nil {
+		return nil, err
+	}
+	return NewV0Blob(ns, compressed)
+}
+
+// CompressBlobData compresses data into a single zstd frame. The encoder isn't
+// part of the protocol: any encoder that produces a valid zstd frame can be used
+// since the share commitment is computed over the compressed data.
+func CompressBlobData(data []byte) ([]byte, error) {
+	encoder, err := zstd.NewWriter(nil,
+		zstd.WithEncoderConcurrency(1),
+		zstd.WithEncoderLevel(zstd.SpeedBetterCompression),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer encoder.Close()
+	return encoder.EncodeAll(data, make([]byte, 0, len(data))), nil
+}
+
+// DecompressBlobData decompresses the data of a share version two blob. It
+// returns an error if the data isn't made of valid zstd frames or decompresses
+// to an empty blob or a blob larger than MaxDecompressedBlobSize.
+func DecompressBlobData(data []byte) ([]byte, error) {
+	decoder, err := zstd.NewReader(nil,
+		zstd.WithDecoderConcurrency(1),
+		zstd.WithDecoderMaxMemory(uint64(MaxDecompressedBlobSize)),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer decoder.Close()
+
+	decompressed, err := decoder.DecodeAll(data, nil)
+	if err != nil {
+		return nil, ErrInvalidCompressedBlob.Wrap(err.Error())
+	}
+	if len(decompressed) == 0 {
+		return nil, ErrInvalidCompressedBlob.Wrap("data decompresses to an empty blob")
+	}
+	if len(decompressed) > MaxDecompressedBlobSize {
+		return nil, ErrInvalidCompressedBlob.Wrapf("decompressed size %d exceeds the max decompressed blob size %d", len(decompressed), MaxDecompressedBlobSize)
+	}
+	return decompressed, nil
+}
+
+// ValidateV2Blobs performs the checks of ValidateBlobs and checks that each blob
+// is laid out as a share version zero blob whose data decompresses to a valid
+// blob.
+func ValidateV2Blobs(blobs ...*share.Blob) error {
+	if err := ValidateBlobs(blobs...); err != nil {
+		return err
+	}
+	for _, blob := range blobs {
+		if blob.ShareVersion() != share.ShareVersionZero {
+			return ErrUnsupportedShareVersion.Wrapf("share version %d blobs must be laid out as share version %d blobs, got %d", ShareVersionTwo, share.ShareVersionZero, blob.ShareVersion())
+		}
+		if _, err := DecompressBlobData(blob.Data()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NewMsgPayForV2Blobs returns a MsgPayForBlobs that pays for blobs created with
+// NewV2Blob.
+func NewMsgPayForV2Blobs(signer string, version uint64, blobs ...*share.Blob) (*MsgPayForBlobs, error) {
+	if err := ValidateV2Blobs(blobs...); err != nil {
+		return nil, err
+	}
+	msg, err := NewMsgPayForBlobs(signer, version, blobs...)
+	if err != nil {
+		return nil, err
+	}
+	for i := range msg.ShareVersions {
+		msg.ShareVersions[i] = uint32(ShareVersionTwo)
+	}
+	return msg, msg.ValidateBasic()
+}
+
+// BlobData returns the data that was submitted in the blob paid for at index i
+// of msg. The data of share version two blobs is decompressed.
+func BlobData(msg *MsgPayForBlobs, i int, blob *share.Blob) ([]byte, error) {
+	if i < 0 || i >= len(msg.ShareVersions) {
+		return nil, ErrMismatchedNumberOfPFBorBlob.Wrapf("blob index %d out of range of %d share versions", i, len(msg.ShareVersions))
+	}
+	if msg.ShareVersions[i] != uint32(ShareVersionTwo) {
+		return blob.Data(), nil
+	}
+	return DecompressBlobData(blob.Data())
+}
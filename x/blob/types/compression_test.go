@@ -0,0 +1,162 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testnode"
+	"github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/celestiaorg/go-square/v2/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmrand "github.com/tendermint/tendermint/libs/rand"
+)
+
+func TestNewV2Blob(t *testing.T) {
+	data := bytes.Repeat([]byte("celestia"), 1000)
+	blob, err := types.NewV2Blob(share.RandomBlobNamespace(), data)
+	require.NoError(t, err)
+	assert.Equal(t, share.ShareVersionZero, blob.ShareVersion())
+	assert.Less(t, len(blob.Data()), len(data))
+
+	decompressed, err := types.DecompressBlobData(blob.Data())
+	require.NoError(t, err)
+	assert.Equal(t, data, decompressed)
+
+	_, err = types.NewV2Blob(share.TxNamespace, data)
+	assert.ErrorIs(t, err, types.ErrReservedNamespace)
+
+	_, err = types.NewV2Blob(share.RandomBlobNamespace(), []byte{})
+	assert.ErrorIs(t, err, types.ErrZeroBlobSize)
+
+	_, err = types.NewV2Blob(share.RandomBlobNamespace(), make([]byte, types.MaxDecompressedBlobSize+1))
+	assert.ErrorIs(t, err, types.ErrInvalidCompressedBlob)
+}
+
+func TestDecompressBlobData(t *testing.T) {
+	tooLarge, err := types.CompressBlobData(make([]byte, types.MaxDecompressedBlobSize+1))
+	require.NoError(t, err)
+	empty, err := types.CompressBlobData(nil)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		data []byte
+	}{
+		{name: "not a zstd frame", data: []byte("not compressed")},
+		{name: "truncated frame", data: mustCompress(t, tmrand.Bytes(1000))[:20]},
+		{name: "empty frame", data: empty},
+		{name: "larger than the max decompressed blob size", data: tooLarge},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := types.DecompressBlobData(tc.data)
+			assert.ErrorIs(t, err, types.ErrInvalidCompressedBlob)
+		})
+	}
+}
+
+func TestNewMsgPayForV2Blobs(t *testing.T) {
+	signer := testnode.RandomAddress().String()
+	data := tmrand.Bytes(1000)
+	blob, err := types.NewV2Blob(share.RandomBlobNamespace(), data)
+	require.NoError(t, err)
+
+	msg, err := types.NewMsgPayForV2Blobs(signer, appconsts.LatestVersion, blob)
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{uint32(types.ShareVersionTwo)}, msg.ShareVersions)
+	// the blob is paid for by its compressed size
+	assert.Equal(t, []uint32{uint32(len(blob.Data()))}, msg.BlobSizes)
+
+	got, err := types.BlobData(msg, 0, blob)
+	require.NoError(t, err)
+	assert.Equal(t, data, got)
+
+	uncompressed, err := types.NewV0Blob(share.RandomBlobNamespace(), data)
+	require.NoError(t, err)
+	_, err = types.NewMsgPayForV2Blobs(signer, appconsts.LatestVersion, uncompressed)
+	assert.ErrorIs(t, err, types.ErrInvalidCompressedBlob)
+
+	// the data of blobs of other share versions is returned as is
+	msg, err = types.NewMsgPayForBlobs(signer, appconsts.LatestVersion, uncompressed)
+	require.NoError(t, err)
+	got, err = types.BlobData(msg, 0, uncompressed)
+	require.NoError(t, err)
+	assert.Equal(t, data, got)
+}
+
+func TestValidateBlobTxShareVersionTwo(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	signer, err := testnode.NewOfflineSigner()
+	require.NoError(t, err)
+	addr := signer.Account(testfactory.TestAccName).Address()
+
+	newBlobTx := func(blob *share.Blob) *tx.BlobTx {
+		msg, err := types.NewMsgPayForBlobs(addr.String(), appconsts.LatestVersion, blob)
+		require.NoError(t, err)
+		msg.ShareVersions[0] = uint32(types.ShareVersionTwo)
+		rawTx, err := signer.CreateTx([]sdk.Msg{msg})
+		require.NoError(t, err)
+		return &tx.BlobTx{Tx: rawTx, Blobs: []*share.Blob{blob}}
+	}
+	compressed, err := types.NewV2Blob(share.RandomBlobNamespace(), tmrand.Bytes(1000))
+	require.NoError(t, err)
+	uncompressed, err := types.NewV0Blob(share.RandomBlobNamespace(), tmrand.Bytes(1000))
+	require.NoError(t, err)
+	v1Blob, err := types.NewV1Blob(share.RandomBlobNamespace(), compressed.Data(), addr)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name        string
+		blobTx      *tx.BlobTx
+		appVersion  uint64
+		expectedErr error
+	}{
+		{
+			name:       "compressed blob",
+			blobTx:     newBlobTx(compressed),
+			appVersion: appconsts.LatestVersion,
+		},
+		{
+			name:        "compressed blob before v3",
+			blobTx:      newBlobTx(compressed),
+			appVersion:  v2.Version,
+			expectedErr: types.ErrUnsupportedShareVersion,
+		},
+		{
+			name:        "uncompressed blob",
+			blobTx:      newBlobTx(uncompressed),
+			appVersion:  appconsts.LatestVersion,
+			expectedErr: types.ErrInvalidCompressedBlob,
+		},
+		{
+			name:        "compressed blob laid out as a share version one blob",
+			blobTx:      newBlobTx(v1Blob),
+			appVersion:  appconsts.LatestVersion,
+			expectedErr: types.ErrUnsupportedShareVersion,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateBlobTx(encCfg.TxConfig, tc.blobTx, appconsts.DefaultSubtreeRootThreshold, tc.appVersion)
+			if tc.expectedErr == nil {
+				require.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func mustCompress(t *testing.T, data []byte) []byte {
+	compressed, err := types.CompressBlobData(data)
+	require.NoError(t, err)
+	return compressed
+}
@@ -37,4 +37,5 @@ var (
 	ErrTotalBlobSizeTooLarge = errors.Register(ModuleName, 11138, "total blob size too large")
 	ErrBlobsTooLarge         = errors.Register(ModuleName, 11139, "blob(s) too large")
 	ErrInvalidBlobSigner     = errors.Register(ModuleName, 11140, "invalid blob signer")
+	ErrInvalidCompressedBlob = errors.Register(ModuleName, 11141, "invalid compressed blob")
 )
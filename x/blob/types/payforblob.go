@@ -118,7 +118,7 @@ func (msg *MsgPayForBlobs) ValidateBasic() error {
 	}
 
 	for _, v := range msg.ShareVersions {
-		if v != uint32(share.ShareVersionZero) && v != uint32(share.ShareVersionOne) {
+		if v != uint32(share.ShareVersionZero) && v != uint32(share.ShareVersionOne) && v != uint32(ShareVersionTwo) {
 			return ErrUnsupportedShareVersion
 		}
 	}
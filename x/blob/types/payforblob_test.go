@@ -68,6 +68,14 @@ func TestValidateBasic(t *testing.T) {
 	noShareVersions := validMsgPayForBlobs(t)
 	noShareVersions.ShareVersions = []uint32{}
 
+	// MsgPayForBlobs that has an unsupported share version
+	unsupportedShareVersion := validMsgPayForBlobs(t)
+	unsupportedShareVersion.ShareVersions[0] = uint32(types.ShareVersionTwo) + 1
+
+	// MsgPayForBlobs that pays for a compressed blob
+	shareVersionTwo := validMsgPayForBlobs(t)
+	shareVersionTwo.ShareVersions[0] = uint32(types.ShareVersionTwo)
+
 	// MsgPayForBlobs that has no blob sizes
 	noBlobSizes := validMsgPayForBlobs(t)
 	noBlobSizes.BlobSizes = []uint32{}
@@ -127,6 +135,16 @@ func TestValidateBasic(t *testing.T) {
 			msg:     noShareVersions,
 			wantErr: types.ErrNoShareVersions,
 		},
+		{
+			name:    "unsupported share version",
+			msg:     unsupportedShareVersion,
+			wantErr: types.ErrUnsupportedShareVersion,
+		},
+		{
+			name:    "share version two",
+			msg:     shareVersionTwo,
+			wantErr: nil,
+		},
 		{
 			name:    "no blob sizes",
 			msg:     noBlobSizes,
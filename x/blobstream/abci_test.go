@@ -11,8 +11,10 @@ import (
 
 	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
 	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -579,3 +581,81 @@ func TestPruning(t *testing.T) {
 	// inconsistency happens after pruning
 	testutil.ExecuteBlobstreamHeightsWithTime(ctx, bsKeeper, 5000, 6000, blockInterval)
 }
+
+func TestValsetCreationWithPowerDiffThreshold(t *testing.T) {
+	tests := []struct {
+		name       string
+		threshold  sdk.Dec
+		wantValset bool
+	}{
+		{
+			name:       "default threshold",
+			threshold:  types.DefaultValsetPowerDiffThreshold,
+			wantValset: true,
+		},
+		{
+			name:       "threshold above the power diff",
+			threshold:  sdk.NewDecWithPrec(5, 1),
+			wantValset: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input, ctx := testutil.SetupFiveValChain(t)
+			pk := input.BlobstreamKeeper
+			params := types.DefaultParams()
+			params.ValsetPowerDiffThreshold = tt.threshold
+			pk.SetParams(ctx, params)
+
+			ctx = ctx.WithBlockHeight(1)
+			staking.EndBlocker(ctx, input.StakingKeeper)
+			blobstream.EndBlocker(ctx, pk)
+			require.Equal(t, uint64(1), pk.GetLatestAttestationNonce(ctx))
+
+			// doubling the stake of one of the five validators changes the
+			// normalized power by ~0.27
+			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+			msgServer := stakingkeeper.NewMsgServerImpl(input.StakingKeeper)
+			delegateMsg := stakingtypes.NewMsgDelegate(testutil.AccAddrs[0], testutil.ValAddrs[0], sdk.NewCoin("stake", testutil.StakingAmount))
+			_, err := msgServer.Delegate(ctx, delegateMsg)
+			require.NoError(t, err)
+			staking.EndBlocker(ctx, input.StakingKeeper)
+			blobstream.EndBlocker(ctx, pk)
+
+			if tt.wantValset {
+				assert.Equal(t, uint64(2), pk.GetLatestAttestationNonce(ctx))
+			} else {
+				assert.Equal(t, uint64(1), pk.GetLatestAttestationNonce(ctx))
+			}
+		})
+	}
+}
+
+// TestPruningWithAttestationExpiryTime tests that attestations are pruned
+// according to the AttestationExpiryTime param.
+func TestPruningWithAttestationExpiryTime(t *testing.T) {
+	input, ctx := testutil.SetupFiveValChain(t)
+	bsKeeper := input.BlobstreamKeeper
+	expiryTime := 24 * time.Hour
+	bsKeeper.SetParams(ctx, types.Params{DataCommitmentWindow: 101, AttestationExpiryTime: expiryTime})
+	require.Equal(t, expiryTime, bsKeeper.GetAttestationExpiryTimeParam(ctx))
+
+	blockInterval := 10 * time.Minute
+	ctx = testutil.ExecuteBlobstreamHeightsWithTime(ctx, bsKeeper, 1, 1000, blockInterval)
+
+	// 1000 blocks of 10 minutes are ~7 days, so the attestations older than a
+	// day were pruned even though the default expiry time is 3 weeks.
+	earliestAttestationNonce := bsKeeper.GetEarliestAvailableAttestationNonce(ctx)
+	assert.Greater(t, earliestAttestationNonce, uint64(1))
+	for nonce := uint64(1); nonce < earliestAttestationNonce; nonce++ {
+		_, found, err := bsKeeper.GetAttestationByNonce(ctx, nonce)
+		assert.NoError(t, err)
+		assert.False(t, found)
+	}
+	for nonce := earliestAttestationNonce; nonce <= bsKeeper.GetLatestAttestationNonce(ctx); nonce++ {
+		at, found, err := bsKeeper.GetAttestationByNonce(ctx, nonce)
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.True(t, ctx.BlockTime().Before(at.BlockTime().Add(expiryTime)))
+	}
+}
@@ -0,0 +1,195 @@
+package client
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+
+	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tendermint/tendermint/crypto/merkle"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+)
+
+// AttestationVerifier verifies that a data root tuple was committed to by a
+// Blobstream contract. It is implemented by the Blobstream contract wrapper,
+// by Checkpoint and by SimulatedBlobstream.
+type AttestationVerifier interface {
+	VerifyAttestation(opts *bind.CallOpts, tupleRootNonce *big.Int, tuple wrapper.DataRootTuple, proof wrapper.BinaryMerkleProof) (bool, error)
+}
+
+var _ AttestationVerifier = &Checkpoint{}
+
+// Checkpoint is a locally supplied Blobstream state that can be used instead
+// of a Blobstream contract to verify data root tuple inclusion.
+type Checkpoint struct {
+	// Validators is the optional trusted validator set. If set, every data root
+	// tuple root must be signed by validators whose power is at least
+	// PowerThreshold.
+	Validators []types.BridgeValidator `json:"validators,omitempty"`
+	// PowerThreshold is the power needed to commit to a data root tuple root.
+	PowerThreshold uint64 `json:"power_threshold,omitempty"`
+	// DataRootTupleRoots are the committed data root tuple roots.
+	DataRootTupleRoots []CheckpointDataRootTupleRoot `json:"data_root_tuple_roots"`
+}
+
+// CheckpointDataRootTupleRoot is a data root tuple root committed to at a
+// nonce.
+type CheckpointDataRootTupleRoot struct {
+	Nonce             uint64      `json:"nonce"`
+	DataRootTupleRoot ethcmn.Hash `json:"data_root_tuple_root"`
+	// Signatures are the 65 byte [R || S || V] signatures of the validators
+	// ordered like Checkpoint.Validators. A validator that didn't sign has an
+	// empty signature.
+	Signatures []hexutil.Bytes `json:"signatures,omitempty"`
+}
+
+// LoadCheckpoint reads a JSON encoded checkpoint from path and validates it.
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var checkpoint Checkpoint
+	if err := json.Unmarshal(bz, &checkpoint); err != nil {
+		return nil, fmt.Errorf("decoding checkpoint %s: %w", path, err)
+	}
+	if err := checkpoint.Validate(); err != nil {
+		return nil, err
+	}
+	return &checkpoint, nil
+}
+
+// Validate returns an error if the checkpoint contains duplicate nonces or, if
+// a validator set is provided, if a data root tuple root isn't signed by
+// enough validators.
+func (c Checkpoint) Validate() error {
+	nonces := make(map[uint64]bool, len(c.DataRootTupleRoots))
+	for _, root := range c.DataRootTupleRoots {
+		if nonces[root.Nonce] {
+			return fmt.Errorf("duplicate data root tuple root for nonce %d", root.Nonce)
+		}
+		nonces[root.Nonce] = true
+	}
+	if len(c.Validators) == 0 {
+		return nil
+	}
+
+	if c.PowerThreshold == 0 {
+		return fmt.Errorf("power threshold must be set when validators are provided")
+	}
+	for _, root := range c.DataRootTupleRoots {
+		if err := c.verifySignatures(root); err != nil {
+			return fmt.Errorf("nonce %d: %w", root.Nonce, err)
+		}
+	}
+	return nil
+}
+
+// verifySignatures mimics the signature verification performed by the
+// Blobstream contract when a data root tuple root is submitted.
+func (c Checkpoint) verifySignatures(root CheckpointDataRootTupleRoot) error {
+	if len(root.Signatures) != len(c.Validators) {
+		return fmt.Errorf("expected %d signatures, got %d", len(c.Validators), len(root.Signatures))
+	}
+	digest, err := types.DataRootTupleRootSignBytes(root.Nonce, root.DataRootTupleRoot)
+	if err != nil {
+		return err
+	}
+
+	power := uint64(0)
+	for i, validator := range c.Validators {
+		if len(root.Signatures[i]) == 0 {
+			continue
+		}
+		signer, err := recoverSigner(digest, root.Signatures[i])
+		if err != nil {
+			return err
+		}
+		if signer != ethcmn.HexToAddress(validator.EvmAddress) {
+			return fmt.Errorf("signature %d was not signed by validator %s", i, validator.EvmAddress)
+		}
+		power += validator.Power
+		if power >= c.PowerThreshold {
+			return nil
+		}
+	}
+	return fmt.Errorf("signed power %d is below the power threshold %d", power, c.PowerThreshold)
+}
+
+// VerifyAttestation mimics the 'verifyAttestation' function of the Blobstream
+// contract using the data root tuple roots of the checkpoint.
+func (c Checkpoint) VerifyAttestation(_ *bind.CallOpts, tupleRootNonce *big.Int, tuple wrapper.DataRootTuple, proof wrapper.BinaryMerkleProof) (bool, error) {
+	if !tupleRootNonce.IsUint64() || !tuple.Height.IsUint64() || !proof.Key.IsInt64() || !proof.NumLeaves.IsInt64() {
+		return false, nil
+	}
+	for _, root := range c.DataRootTupleRoots {
+		if root.Nonce != tupleRootNonce.Uint64() {
+			continue
+		}
+		aunts := make([][]byte, len(proof.SideNodes))
+		for i, sideNode := range proof.SideNodes {
+			aunts[i] = append([]byte{}, sideNode[:]...)
+		}
+		leaf := types.DataRootTuple{Height: tuple.Height.Uint64(), DataRoot: tuple.DataRoot}.Encode()
+		merkleProof := merkle.Proof{
+			Total:    proof.NumLeaves.Int64(),
+			Index:    proof.Key.Int64(),
+			LeafHash: tmhash.Sum(append([]byte{0}, leaf...)),
+			Aunts:    aunts,
+		}
+		return merkleProof.Verify(root.DataRootTupleRoot.Bytes(), leaf) == nil, nil
+	}
+	return false, nil
+}
+
+// SignDataRootTupleRoot returns the 65 byte [R || S || V] signature of the
+// data root tuple root at nonce in the format expected by the Blobstream
+// contract.
+func SignDataRootTupleRoot(key *ecdsa.PrivateKey, nonce uint64, dataRootTupleRoot ethcmn.Hash) (hexutil.Bytes, error) {
+	digest, err := types.DataRootTupleRootSignBytes(nonce, dataRootTupleRoot)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := crypto.Sign(accounts.TextHash(digest.Bytes()), key)
+	if err != nil {
+		return nil, err
+	}
+	// the contract expects V to be 27 or 28
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
+// recoverSigner returns the address that produced signature over the Ethereum
+// signed message hash of digest.
+func recoverSigner(digest ethcmn.Hash, signature []byte) (ethcmn.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return ethcmn.Address{}, fmt.Errorf("signature must be %d bytes, got %d", crypto.SignatureLength, len(signature))
+	}
+	sig := append([]byte{}, signature...)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pubKey, err := crypto.SigToPub(accounts.TextHash(digest.Bytes()), sig)
+	if err != nil {
+		return ethcmn.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
+
+// toWrapperSignature converts a 65 byte [R || S || V] signature to the
+// Blobstream contract signature type.
+func toWrapperSignature(signature []byte) wrapper.Signature {
+	var sig wrapper.Signature
+	copy(sig.R[:], signature[:32])
+	copy(sig.S[:], signature[32:64])
+	sig.V = signature[crypto.RecoveryIDOffset]
+	return sig
+}
@@ -14,6 +14,8 @@ const (
 	celesGRPCFlag       = "celes-grpc"
 	evmRPCFlag          = "evm-rpc"
 	contractAddressFlag = "contract-address"
+	checkpointFlag      = "checkpoint"
+	simulatedFlag       = "simulated"
 )
 
 func addVerifyFlags(cmd *cobra.Command) *cobra.Command {
@@ -23,6 +25,8 @@ func addVerifyFlags(cmd *cobra.Command) *cobra.Command {
 	cmd.Flags().StringP(evmRPCFlag, "e", "http://localhost:8545", "The EVM RPC address")
 	cmd.Flags().StringP(contractAddressFlag, "a", "", "The contract address at which Blobstream is deployed")
 	cmd.Flags().StringP(celesGRPCFlag, "c", "localhost:9090", "<host>:<port> To Celestia GRPC address")
+	cmd.Flags().String(checkpointFlag, "", "Path to a JSON checkpoint of committed data root tuple roots to verify against instead of a Blobstream contract")
+	cmd.Flags().Bool(simulatedFlag, false, "Verify against a Blobstream contract deployed on a simulated EVM chain that commits to the data root tuple root computed by the Celestia node")
 
 	return cmd
 }
@@ -32,6 +36,12 @@ type VerifyConfig struct {
 	EVMRPC, CelesGRPC, TendermintRPC string
 	EVMChainID                       uint64
 	ContractAddr                     ethcmn.Address
+	// CheckpointPath is the path to a JSON checkpoint to verify against instead
+	// of the Blobstream contract.
+	CheckpointPath string
+	// Simulated is true if the verification is run against a Blobstream
+	// contract deployed on a simulated EVM chain.
+	Simulated bool
 }
 
 func parseVerifyFlags(cmd *cobra.Command) (VerifyConfig, error) {
@@ -55,17 +65,31 @@ func parseVerifyFlags(cmd *cobra.Command) (VerifyConfig, error) {
 	if err != nil {
 		return VerifyConfig{}, err
 	}
+	checkpointPath, err := cmd.Flags().GetString(checkpointFlag)
+	if err != nil {
+		return VerifyConfig{}, err
+	}
+	simulated, err := cmd.Flags().GetBool(simulatedFlag)
+	if err != nil {
+		return VerifyConfig{}, err
+	}
+	if checkpointPath != "" && simulated {
+		return VerifyConfig{}, fmt.Errorf("flags %s and %s are mutually exclusive", checkpointFlag, simulatedFlag)
+	}
 	contractAddr, err := cmd.Flags().GetString(contractAddressFlag)
 	if err != nil {
 		return VerifyConfig{}, err
 	}
-	if contractAddr == "" {
+	var address ethcmn.Address
+	switch {
+	case contractAddr != "":
+		if !ethcmn.IsHexAddress(contractAddr) {
+			return VerifyConfig{}, fmt.Errorf("valid contract address flag is required: %s", contractAddressFlag)
+		}
+		address = ethcmn.HexToAddress(contractAddr)
+	case checkpointPath == "" && !simulated:
 		return VerifyConfig{}, fmt.Errorf("contract address flag is required: %s", contractAddressFlag)
 	}
-	if !ethcmn.IsHexAddress(contractAddr) {
-		return VerifyConfig{}, fmt.Errorf("valid contract address flag is required: %s", contractAddressFlag)
-	}
-	address := ethcmn.HexToAddress(contractAddr)
 
 	return VerifyConfig{
 		CelestiaChainID: chainID,
@@ -74,5 +98,7 @@ func parseVerifyFlags(cmd *cobra.Command) (VerifyConfig, error) {
 		TendermintRPC:   tendermintRPC,
 		EVMRPC:          evmRPC,
 		ContractAddr:    address,
+		CheckpointPath:  checkpointPath,
+		Simulated:       simulated,
 	}, nil
 }
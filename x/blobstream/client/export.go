@@ -0,0 +1,272 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+)
+
+const (
+	fromNonceFlag    = "from-nonce"
+	toNonceFlag      = "to-nonce"
+	followFlag       = "follow"
+	pollIntervalFlag = "poll-interval"
+
+	// ValsetAttestationType is the type of an exported valset attestation.
+	ValsetAttestationType = "valset"
+	// DataCommitmentAttestationType is the type of an exported data commitment
+	// attestation.
+	DataCommitmentAttestationType = "data_commitment"
+)
+
+// ExportedAttestation is an attestation along with the ABI encoded payloads
+// that the Blobstream contract expects.
+type ExportedAttestation struct {
+	Type  string    `json:"type"`
+	Nonce uint64    `json:"nonce"`
+	Time  time.Time `json:"time"`
+
+	// Height is the height at which a valset was created.
+	Height uint64 `json:"height,omitempty"`
+	// Validators are the members of a valset.
+	Validators []types.BridgeValidator `json:"validators,omitempty"`
+	// PowerThreshold is the power needed to sign over a valset update.
+	PowerThreshold uint64 `json:"power_threshold,omitempty"`
+	// ValidatorSetHash is the hash of the ABI encoded validator set.
+	ValidatorSetHash *ethcmn.Hash `json:"validator_set_hash,omitempty"`
+	// EncodedValidatorSet is the ABI encoded validator set.
+	EncodedValidatorSet hexutil.Bytes `json:"encoded_validator_set,omitempty"`
+
+	// BeginBlock is the first block, inclusive, of a data commitment range.
+	BeginBlock uint64 `json:"begin_block,omitempty"`
+	// EndBlock is the last block, exclusive, of a data commitment range.
+	EndBlock uint64 `json:"end_block,omitempty"`
+	// DataRootTupleRoot is the merkle root of the data root tuples in the data
+	// commitment range.
+	DataRootTupleRoot *ethcmn.Hash `json:"data_root_tuple_root,omitempty"`
+
+	// EncodedPayload is the ABI encoded, domain separated payload that the
+	// Blobstream contract hashes to verify signatures.
+	EncodedPayload hexutil.Bytes `json:"encoded_payload"`
+	// Digest is the keccak256 hash of EncodedPayload which validators sign.
+	Digest ethcmn.Hash `json:"digest"`
+}
+
+// NewExportedValset returns the exported attestation for a valset.
+func NewExportedValset(vs *types.Valset) (ExportedAttestation, error) {
+	encodedMembers, err := vs.EncodeMembers()
+	if err != nil {
+		return ExportedAttestation{}, err
+	}
+	vsHash, err := vs.Hash()
+	if err != nil {
+		return ExportedAttestation{}, err
+	}
+	payload, err := vs.EncodeSignBytes()
+	if err != nil {
+		return ExportedAttestation{}, err
+	}
+	digest, err := vs.SignBytes()
+	if err != nil {
+		return ExportedAttestation{}, err
+	}
+	return ExportedAttestation{
+		Type:                ValsetAttestationType,
+		Nonce:               vs.Nonce,
+		Time:                vs.Time,
+		Height:              vs.Height,
+		Validators:          vs.Members,
+		PowerThreshold:      vs.TwoThirdsThreshold(),
+		ValidatorSetHash:    &vsHash,
+		EncodedValidatorSet: encodedMembers,
+		EncodedPayload:      payload,
+		Digest:              digest,
+	}, nil
+}
+
+// NewExportedDataCommitment returns the exported attestation for a data
+// commitment whose data root tuple root is dataRootTupleRoot.
+func NewExportedDataCommitment(dc *types.DataCommitment, dataRootTupleRoot []byte) (ExportedAttestation, error) {
+	if len(dataRootTupleRoot) != ethcmn.HashLength {
+		return ExportedAttestation{}, fmt.Errorf("data root tuple root must be %d bytes, got %d", ethcmn.HashLength, len(dataRootTupleRoot))
+	}
+	root := ethcmn.BytesToHash(dataRootTupleRoot)
+	payload, err := types.EncodeDataRootTupleRoot(dc.Nonce, root)
+	if err != nil {
+		return ExportedAttestation{}, err
+	}
+	digest, err := types.DataRootTupleRootSignBytes(dc.Nonce, root)
+	if err != nil {
+		return ExportedAttestation{}, err
+	}
+	return ExportedAttestation{
+		Type:              DataCommitmentAttestationType,
+		Nonce:             dc.Nonce,
+		Time:              dc.Time,
+		BeginBlock:        dc.BeginBlock,
+		EndBlock:          dc.EndBlock,
+		DataRootTupleRoot: &root,
+		EncodedPayload:    payload,
+		Digest:            digest,
+	}, nil
+}
+
+// DataCommitmentClient computes the data root tuple root for a range of
+// blocks. It is implemented by the tendermint RPC client.
+type DataCommitmentClient interface {
+	DataCommitment(ctx context.Context, start, end uint64) (*coretypes.ResultDataCommitment, error)
+}
+
+// ExportAttestations exports the attestations in [fromNonce, toNonce] in order
+// and passes them to handle. It returns the nonce of the last exported
+// attestation.
+func ExportAttestations(
+	ctx context.Context,
+	queryClient types.QueryClient,
+	dcClient DataCommitmentClient,
+	fromNonce uint64,
+	toNonce uint64,
+	handle func(ExportedAttestation) error,
+) (uint64, error) {
+	lastNonce := fromNonce - 1
+	for nonce := fromNonce; nonce <= toNonce; nonce++ {
+		res, err := queryClient.AttestationRequestByNonce(ctx, &types.QueryAttestationRequestByNonceRequest{Nonce: nonce})
+		if err != nil {
+			return lastNonce, err
+		}
+		if res.Attestation == nil {
+			return lastNonce, types.ErrNilAttestation
+		}
+		att, err := unmarshallAttestation(res.Attestation)
+		if err != nil {
+			return lastNonce, err
+		}
+
+		var exported ExportedAttestation
+		switch att := att.(type) {
+		case *types.Valset:
+			exported, err = NewExportedValset(att)
+		case *types.DataCommitment:
+			var dcRes *coretypes.ResultDataCommitment
+			dcRes, err = dcClient.DataCommitment(ctx, att.BeginBlock, att.EndBlock)
+			if err != nil {
+				return lastNonce, err
+			}
+			exported, err = NewExportedDataCommitment(att, dcRes.DataCommitment)
+		default:
+			return lastNonce, types.ErrUnknownAttestationType
+		}
+		if err != nil {
+			return lastNonce, err
+		}
+		if err := handle(exported); err != nil {
+			return lastNonce, err
+		}
+		lastNonce = nonce
+	}
+	return lastNonce, nil
+}
+
+// CmdExportAttestations returns a command that exports attestations in order,
+// one JSON object per line, starting at a given nonce.
+func CmdExportAttestations() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "export attestations in order along with the ABI encoded payloads expected by the Blobstream contract",
+		Long: `Export attestations in order, one JSON object per line, starting at --from-nonce.
+Each data commitment includes the data root tuple root of its range. If --follow
+is set, the command keeps polling for new attestations until it is interrupted.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.Client == nil {
+				return fmt.Errorf("a tendermint RPC node is required to compute data root tuple roots")
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			fromNonce, err := cmd.Flags().GetUint64(fromNonceFlag)
+			if err != nil {
+				return err
+			}
+			toNonce, err := cmd.Flags().GetUint64(toNonceFlag)
+			if err != nil {
+				return err
+			}
+			follow, err := cmd.Flags().GetBool(followFlag)
+			if err != nil {
+				return err
+			}
+			pollInterval, err := cmd.Flags().GetDuration(pollIntervalFlag)
+			if err != nil {
+				return err
+			}
+			if fromNonce == 0 {
+				return fmt.Errorf("%s must be greater than zero", fromNonceFlag)
+			}
+
+			earliest, err := queryClient.EarliestAttestationNonce(cmd.Context(), &types.QueryEarliestAttestationNonceRequest{})
+			if err != nil {
+				return err
+			}
+			if fromNonce < earliest.Nonce {
+				return fmt.Errorf("attestation nonce %d has been pruned, the earliest available nonce is %d", fromNonce, earliest.Nonce)
+			}
+
+			handle := func(att ExportedAttestation) error {
+				bz, err := json.Marshal(att)
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintString(string(bz) + "\n")
+			}
+
+			next := fromNonce
+			for {
+				latest, err := queryClient.LatestAttestationNonce(cmd.Context(), &types.QueryLatestAttestationNonceRequest{})
+				if err != nil {
+					return err
+				}
+				end := latest.Nonce
+				if toNonce != 0 && toNonce < end {
+					end = toNonce
+				}
+				if next <= end {
+					lastNonce, err := ExportAttestations(cmd.Context(), queryClient, clientCtx.Client, next, end, handle)
+					if err != nil {
+						return err
+					}
+					next = lastNonce + 1
+				}
+				if !follow || (toNonce != 0 && next > toNonce) {
+					return nil
+				}
+
+				select {
+				case <-cmd.Context().Done():
+					return nil
+				case <-time.After(pollInterval):
+				}
+			}
+		},
+	}
+
+	cmd.Flags().Uint64(fromNonceFlag, 1, "The nonce of the first attestation to export")
+	cmd.Flags().Uint64(toNonceFlag, 0, "The nonce of the last attestation to export. Defaults to the latest attestation")
+	cmd.Flags().Bool(followFlag, false, "Keep polling for new attestations after the latest attestation has been exported")
+	cmd.Flags().Duration(pollIntervalFlag, 10*time.Second, "The interval at which new attestations are polled for when --follow is set")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
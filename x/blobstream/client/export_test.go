@@ -0,0 +1,55 @@
+package client_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/client"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func (s *CLITestSuite) TestExportAttestations() {
+	_, err := s.cctx.WaitForHeightWithTimeout(402, 2*time.Minute)
+	s.Require().NoError(err)
+
+	cmd := client.CmdExportAttestations()
+	out, err := clitestutil.ExecTestCLICmd(s.cctx.Context, cmd, []string{
+		fmt.Sprintf("--from-nonce=%d", 1),
+		fmt.Sprintf("--to-nonce=%d", 2),
+	})
+	s.Require().NoError(err)
+
+	var attestations []client.ExportedAttestation
+	scanner := bufio.NewScanner(bytes.NewReader(out.Bytes()))
+	for scanner.Scan() {
+		var att client.ExportedAttestation
+		s.Require().NoError(json.Unmarshal(scanner.Bytes(), &att))
+		attestations = append(attestations, att)
+	}
+	s.Require().Len(attestations, 2)
+
+	valset := attestations[0]
+	s.Assert().Equal(client.ValsetAttestationType, valset.Type)
+	s.Assert().Equal(uint64(1), valset.Nonce)
+	s.Assert().NotEmpty(valset.Validators)
+	s.Assert().Equal(*valset.ValidatorSetHash, crypto.Keccak256Hash(valset.EncodedValidatorSet))
+	s.Assert().Equal(valset.Digest, crypto.Keccak256Hash(valset.EncodedPayload))
+
+	dc := attestations[1]
+	s.Assert().Equal(client.DataCommitmentAttestationType, dc.Type)
+	s.Assert().Equal(uint64(2), dc.Nonce)
+	s.Assert().Equal(uint64(1), dc.BeginBlock)
+	s.Require().NotNil(dc.DataRootTupleRoot)
+
+	res, err := s.cctx.Client.DataCommitment(s.cctx.GoContext(), dc.BeginBlock, dc.EndBlock)
+	s.Require().NoError(err)
+	s.Assert().Equal(res.DataCommitment.Bytes(), dc.DataRootTupleRoot.Bytes())
+	s.Assert().Equal(dc.Digest, crypto.Keccak256Hash(dc.EncodedPayload))
+
+	_, err = clitestutil.ExecTestCLICmd(s.cctx.Context, client.CmdExportAttestations(), []string{"--from-nonce=0"})
+	s.Assert().Error(err)
+}
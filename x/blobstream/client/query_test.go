@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream/client"
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
 	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
 )
 
@@ -55,3 +56,12 @@ func (s *CLITestSuite) TestQueryAttestationByNonce() {
 		})
 	}
 }
+
+func (s *CLITestSuite) TestQueryParams() {
+	out, err := clitestutil.ExecTestCLICmd(s.cctx.Context, client.CmdQueryParams(), []string{"--output=json"})
+	s.Require().NoError(err)
+
+	var params types.Params
+	s.Require().NoError(s.cctx.Codec.UnmarshalJSON(out.Bytes(), &params))
+	s.Assert().Equal(types.DefaultParams(), params)
+}
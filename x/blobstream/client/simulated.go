@@ -0,0 +1,186 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+
+	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
+	proxywrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/ERC1967Proxy.sol"
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// simulatedValidatorPower is the power of the single validator of a
+	// simulated Blobstream contract.
+	simulatedValidatorPower = 1000
+	// simulatedChainID is the chain ID used by go-ethereum's simulated
+	// backend.
+	simulatedChainID = 1337
+	// simulatedGasLimit is the block gas limit of the simulated chain. It is
+	// high enough to deploy the Blobstream implementation.
+	simulatedGasLimit = 30_000_000
+)
+
+var _ AttestationVerifier = &SimulatedBlobstream{}
+
+// SimulatedBlobstream is a Blobstream contract deployed, behind its ERC1967
+// proxy, on go-ethereum's simulated backend. Its validator set contains a
+// single validator whose key is held by the SimulatedBlobstream, so any data
+// root tuple root can be committed to.
+type SimulatedBlobstream struct {
+	backend  *backends.SimulatedBackend
+	auth     *bind.TransactOpts
+	key      *ecdsa.PrivateKey
+	contract *wrapper.Wrappers
+
+	address     ethcmn.Address
+	valsetNonce uint64
+	validators  []wrapper.Validator
+}
+
+// NewSimulatedBlobstream deploys a Blobstream contract on a simulated backend.
+// The contract's latest nonce is initialNonce so the next data root tuple root
+// must be submitted at initialNonce + 1.
+func NewSimulatedBlobstream(initialNonce uint64) (*SimulatedBlobstream, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(simulatedChainID))
+	if err != nil {
+		return nil, err
+	}
+	evmAddress := crypto.PubkeyToAddress(key.PublicKey)
+	backend := backends.NewSimulatedBackend(
+		ethtypes.GenesisAlloc{evmAddress: {Balance: new(big.Int).Lsh(big.NewInt(1), 100)}},
+		simulatedGasLimit,
+	)
+
+	bs, err := deploySimulatedBlobstream(backend, auth, evmAddress, initialNonce)
+	if err != nil {
+		_ = backend.Close()
+		return nil, err
+	}
+	bs.key = key
+	return bs, nil
+}
+
+// deploySimulatedBlobstream deploys the Blobstream implementation and its
+// proxy, initialized with a validator set that only contains evmAddress.
+func deploySimulatedBlobstream(
+	backend *backends.SimulatedBackend,
+	auth *bind.TransactOpts,
+	evmAddress ethcmn.Address,
+	initialNonce uint64,
+) (*SimulatedBlobstream, error) {
+	implAddress, tx, _, err := wrapper.DeployWrappers(auth, backend)
+	if err != nil {
+		return nil, fmt.Errorf("deploying the Blobstream implementation: %w", err)
+	}
+	if err := commit(backend, tx); err != nil {
+		return nil, fmt.Errorf("deploying the Blobstream implementation: %w", err)
+	}
+
+	valset := &types.Valset{
+		Nonce:   initialNonce,
+		Members: []types.BridgeValidator{{Power: simulatedValidatorPower, EvmAddress: evmAddress.Hex()}},
+	}
+	vsHash, err := valset.Hash()
+	if err != nil {
+		return nil, err
+	}
+	blobstreamABI, err := wrapper.WrappersMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	initData, err := blobstreamABI.Pack(
+		"initialize",
+		new(big.Int).SetUint64(initialNonce),
+		new(big.Int).SetUint64(valset.TwoThirdsThreshold()),
+		vsHash,
+	)
+	if err != nil {
+		return nil, err
+	}
+	proxyAddress, tx, _, err := proxywrapper.DeployWrappers(auth, backend, implAddress, initData)
+	if err != nil {
+		return nil, fmt.Errorf("deploying the Blobstream proxy: %w", err)
+	}
+	if err := commit(backend, tx); err != nil {
+		return nil, fmt.Errorf("deploying the Blobstream proxy: %w", err)
+	}
+
+	contract, err := wrapper.NewWrappers(proxyAddress, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &SimulatedBlobstream{
+		backend:     backend,
+		auth:        auth,
+		contract:    contract,
+		address:     proxyAddress,
+		valsetNonce: initialNonce,
+		validators:  []wrapper.Validator{{Addr: evmAddress, Power: big.NewInt(simulatedValidatorPower)}},
+	}, nil
+}
+
+// Address returns the address of the simulated Blobstream contract.
+func (s *SimulatedBlobstream) Address() ethcmn.Address {
+	return s.address
+}
+
+// SubmitDataRootTupleRoot signs the data root tuple root and submits it to the
+// simulated Blobstream contract at nonce. The nonce must be the contract's
+// latest nonce + 1.
+func (s *SimulatedBlobstream) SubmitDataRootTupleRoot(nonce uint64, dataRootTupleRoot ethcmn.Hash) error {
+	signature, err := SignDataRootTupleRoot(s.key, nonce, dataRootTupleRoot)
+	if err != nil {
+		return err
+	}
+	tx, err := s.contract.SubmitDataRootTupleRoot(
+		s.auth,
+		new(big.Int).SetUint64(nonce),
+		new(big.Int).SetUint64(s.valsetNonce),
+		dataRootTupleRoot,
+		s.validators,
+		[]wrapper.Signature{toWrapperSignature(signature)},
+	)
+	if err == nil {
+		err = commit(s.backend, tx)
+	}
+	if err != nil {
+		return fmt.Errorf("submitting data root tuple root at nonce %d: %w", nonce, err)
+	}
+	return nil
+}
+
+// VerifyAttestation calls the 'verifyAttestation' function of the simulated
+// Blobstream contract.
+func (s *SimulatedBlobstream) VerifyAttestation(opts *bind.CallOpts, tupleRootNonce *big.Int, tuple wrapper.DataRootTuple, proof wrapper.BinaryMerkleProof) (bool, error) {
+	return s.contract.VerifyAttestation(opts, tupleRootNonce, tuple, proof)
+}
+
+// Close shuts down the simulated backend.
+func (s *SimulatedBlobstream) Close() error {
+	return s.backend.Close()
+}
+
+// commit mines a block that includes tx and returns an error if tx failed.
+func commit(backend *backends.SimulatedBackend, tx *ethtypes.Transaction) error {
+	backend.Commit()
+	receipt, err := backend.TransactionReceipt(context.Background(), tx.Hash())
+	if err != nil {
+		return err
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return fmt.Errorf("transaction %s failed", tx.Hash().Hex())
+	}
+	return nil
+}
@@ -10,9 +10,11 @@ import (
 	"strconv"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcmn "github.com/ethereum/go-ethereum/common"
 	"github.com/tendermint/tendermint/crypto/merkle"
 
 	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
+	"github.com/celestiaorg/celestia-app/v3/app/grpc/blobstream"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
 	square "github.com/celestiaorg/go-square/v2"
@@ -21,7 +23,9 @@ import (
 	tmlog "github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/rpc/client/http"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 )
 
 func VerifyCmd() *cobra.Command {
@@ -285,7 +289,7 @@ func VerifyShares(ctx context.Context, logger tmlog.Logger, config VerifyConfig,
 	)
 
 	logger.Debug("getting the data root to commitment inclusion proof")
-	dcProof, err := trpc.DataRootInclusionProof(ctx, unsignedHeight, resp.DataCommitment.BeginBlock, resp.DataCommitment.EndBlock)
+	dcProof, err := dataRootInclusionProof(ctx, bsGRPC, trpc, unsignedHeight, resp.DataCommitment)
 	if err != nil {
 		return false, err
 	}
@@ -295,30 +299,20 @@ func VerifyShares(ctx context.Context, logger tmlog.Logger, config VerifyConfig,
 		return false, err
 	}
 
-	ethClient, err := ethclient.Dial(config.EVMRPC)
-	if err != nil {
-		return false, err
-	}
-	defer ethClient.Close()
-
-	bsWrapper, err := wrapper.NewWrappers(config.ContractAddr, ethClient)
+	verifier, closeVerifier, err := newAttestationVerifier(ctx, logger, config, bsGRPC, trpc, resp.DataCommitment)
 	if err != nil {
 		return false, err
 	}
+	defer closeVerifier()
 
 	logger.Info("verifying that the data root was committed to in the Blobstream contract")
 	isCommittedTo, err = VerifyDataRootInclusion(
 		ctx,
-		bsWrapper,
+		verifier,
 		resp.DataCommitment.Nonce,
 		height,
 		block.Block.DataHash,
-		merkle.Proof{
-			Total:    dcProof.Proof.Total,
-			Index:    dcProof.Proof.Index,
-			LeafHash: dcProof.Proof.LeafHash,
-			Aunts:    dcProof.Proof.Aunts,
-		},
+		dcProof,
 	)
 	if err != nil {
 		return false, err
@@ -333,9 +327,130 @@ func VerifyShares(ctx context.Context, logger tmlog.Logger, config VerifyConfig,
 	return isCommittedTo, nil
 }
 
+// dataRootInclusionProof returns the inclusion proof of the data root of
+// height into the data root tuple root of the data commitment. The proof is
+// computed by the app's Blobstream gRPC service and falls back to the
+// celestia-core RPC if the app doesn't serve it.
+func dataRootInclusionProof(
+	ctx context.Context,
+	conn *grpc.ClientConn,
+	trpc *http.HTTP,
+	height uint64,
+	dataCommitment *types.DataCommitment,
+) (merkle.Proof, error) {
+	res, err := blobstream.NewBlobstreamClient(conn).DataRootInclusionProof(ctx, &blobstream.DataRootInclusionProofRequest{
+		Height:     height,
+		BeginBlock: dataCommitment.BeginBlock,
+		EndBlock:   dataCommitment.EndBlock,
+	})
+	if err == nil {
+		return merkle.Proof{
+			Total:    res.Proof.Total,
+			Index:    res.Proof.Index,
+			LeafHash: res.Proof.LeafHash,
+			Aunts:    res.Proof.Aunts,
+		}, nil
+	}
+	if status.Code(err) != codes.Unimplemented {
+		return merkle.Proof{}, err
+	}
+
+	dcProof, err := trpc.DataRootInclusionProof(ctx, height, dataCommitment.BeginBlock, dataCommitment.EndBlock)
+	if err != nil {
+		return merkle.Proof{}, err
+	}
+	return merkle.Proof{
+		Total:    dcProof.Proof.Total,
+		Index:    dcProof.Proof.Index,
+		LeafHash: dcProof.Proof.LeafHash,
+		Aunts:    dcProof.Proof.Aunts,
+	}, nil
+}
+
+// newAttestationVerifier returns the verifier that data root inclusion is
+// checked against along with a function that releases its resources. It is
+// either a local checkpoint, a Blobstream contract deployed on a simulated EVM
+// chain that commits to the data commitment, or the Blobstream contract at
+// the configured address.
+func newAttestationVerifier(
+	ctx context.Context,
+	logger tmlog.Logger,
+	config VerifyConfig,
+	conn *grpc.ClientConn,
+	trpc *http.HTTP,
+	dataCommitment *types.DataCommitment,
+) (AttestationVerifier, func(), error) {
+	switch {
+	case config.CheckpointPath != "":
+		checkpoint, err := LoadCheckpoint(config.CheckpointPath)
+		if err != nil {
+			return nil, nil, err
+		}
+		return checkpoint, func() {}, nil
+	case config.Simulated:
+		root, err := dataRootTupleRoot(ctx, conn, trpc, dataCommitment)
+		if err != nil {
+			return nil, nil, err
+		}
+		logger.Debug("deploying a simulated Blobstream contract", "nonce", dataCommitment.Nonce, "data_root_tuple_root", root.Hex())
+		bs, err := NewSimulatedBlobstream(dataCommitment.Nonce - 1)
+		if err != nil {
+			return nil, nil, err
+		}
+		closeVerifier := func() {
+			if err := bs.Close(); err != nil {
+				logger.Debug("error closing simulated backend", "err", err.Error())
+			}
+		}
+		if err := bs.SubmitDataRootTupleRoot(dataCommitment.Nonce, root); err != nil {
+			closeVerifier()
+			return nil, nil, err
+		}
+		return bs, closeVerifier, nil
+	default:
+		ethClient, err := ethclient.Dial(config.EVMRPC)
+		if err != nil {
+			return nil, nil, err
+		}
+		bsWrapper, err := wrapper.NewWrappers(config.ContractAddr, ethClient)
+		if err != nil {
+			ethClient.Close()
+			return nil, nil, err
+		}
+		return bsWrapper, ethClient.Close, nil
+	}
+}
+
+// dataRootTupleRoot returns the data root tuple root of the data commitment.
+// It is computed by the app's Blobstream gRPC service and falls back to the
+// celestia-core RPC if the app doesn't serve it.
+func dataRootTupleRoot(
+	ctx context.Context,
+	conn *grpc.ClientConn,
+	trpc *http.HTTP,
+	dataCommitment *types.DataCommitment,
+) (ethcmn.Hash, error) {
+	res, err := blobstream.NewBlobstreamClient(conn).DataRootTupleRoot(ctx, &blobstream.DataRootTupleRootRequest{
+		BeginBlock: dataCommitment.BeginBlock,
+		EndBlock:   dataCommitment.EndBlock,
+	})
+	if err == nil {
+		return ethcmn.BytesToHash(res.DataRootTupleRoot), nil
+	}
+	if status.Code(err) != codes.Unimplemented {
+		return ethcmn.Hash{}, err
+	}
+
+	dcRes, err := trpc.DataCommitment(ctx, dataCommitment.BeginBlock, dataCommitment.EndBlock)
+	if err != nil {
+		return ethcmn.Hash{}, err
+	}
+	return ethcmn.BytesToHash(dcRes.DataCommitment), nil
+}
+
 func VerifyDataRootInclusion(
 	_ context.Context,
-	bsWrapper *wrapper.Wrappers,
+	verifier AttestationVerifier,
 	nonce uint64,
 	height int64,
 	dataRoot []byte,
@@ -356,7 +471,7 @@ func VerifyDataRootInclusion(
 		NumLeaves: big.NewInt(proof.Total),
 	}
 
-	valid, err := bsWrapper.VerifyAttestation(
+	valid, err := verifier.VerifyAttestation(
 		&bind.CallOpts{},
 		big.NewInt(int64(nonce)),
 		tuple,
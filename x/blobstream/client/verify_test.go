@@ -0,0 +1,181 @@
+package client_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	wrapper "github.com/celestiaorg/blobstream-contracts/v3/wrappers/Blobstream.sol"
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/client"
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+)
+
+// testDataRootTuples returns data root tuples for the heights [1, 9) along
+// with their data root tuple root.
+func testDataRootTuples() ([]types.DataRootTuple, ethcmn.Hash) {
+	tuples := make([]types.DataRootTuple, 8)
+	for i := range tuples {
+		tuples[i] = types.DataRootTuple{Height: uint64(i + 1), DataRoot: sha256.Sum256([]byte{byte(i)})}
+	}
+	return tuples, ethcmn.BytesToHash(types.DataRootTupleRoot(tuples))
+}
+
+// verifyTuple checks the inclusion of the tuple at height against verifier.
+func verifyTuple(t *testing.T, verifier client.AttestationVerifier, nonce uint64, tuples []types.DataRootTuple, height uint64) bool {
+	proof, err := types.DataRootTupleInclusionProof(tuples, height)
+	require.NoError(t, err)
+	dataRoot := tuples[height-tuples[0].Height].DataRoot
+	valid, err := client.VerifyDataRootInclusion(context.Background(), verifier, nonce, int64(height), dataRoot[:], *proof)
+	require.NoError(t, err)
+	return valid
+}
+
+func TestSimulatedBlobstream(t *testing.T) {
+	tuples, root := testDataRootTuples()
+
+	bs, err := client.NewSimulatedBlobstream(4)
+	require.NoError(t, err)
+	defer bs.Close()
+
+	// the nonce must follow the contract's latest nonce
+	require.Error(t, bs.SubmitDataRootTupleRoot(6, root))
+	require.NoError(t, bs.SubmitDataRootTupleRoot(5, root))
+
+	for _, tuple := range tuples {
+		assert.True(t, verifyTuple(t, bs, 5, tuples, tuple.Height))
+	}
+	// a data root that wasn't committed to is rejected
+	other := append([]types.DataRootTuple{}, tuples...)
+	other[2].DataRoot = sha256.Sum256([]byte("other"))
+	assert.False(t, verifyTuple(t, bs, 5, other, 3))
+
+	valid, err := bs.VerifyAttestation(&bind.CallOpts{}, big.NewInt(5), wrapper.DataRootTuple{Height: big.NewInt(1), DataRoot: tuples[0].DataRoot}, wrapper.BinaryMerkleProof{Key: big.NewInt(0), NumLeaves: big.NewInt(8)})
+	require.NoError(t, err)
+	assert.False(t, valid)
+}
+
+func TestCheckpoint(t *testing.T) {
+	tuples, root := testDataRootTuples()
+
+	keys := make([]*ecdsa.PrivateKey, 3)
+	validators := make([]types.BridgeValidator, len(keys))
+	for i := range keys {
+		key, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		keys[i] = key
+		validators[i] = types.BridgeValidator{Power: 10, EvmAddress: crypto.PubkeyToAddress(key.PublicKey).Hex()}
+	}
+	sign := func(i int, nonce uint64) hexutil.Bytes {
+		sig, err := client.SignDataRootTupleRoot(keys[i], nonce, root)
+		require.NoError(t, err)
+		return sig
+	}
+
+	checkpoint := client.Checkpoint{
+		Validators:     validators,
+		PowerThreshold: 20,
+		DataRootTupleRoots: []client.CheckpointDataRootTupleRoot{{
+			Nonce:             7,
+			DataRootTupleRoot: root,
+			Signatures:        []hexutil.Bytes{sign(0, 7), {}, sign(2, 7)},
+		}},
+	}
+	require.NoError(t, checkpoint.Validate())
+
+	for _, tuple := range tuples {
+		assert.True(t, verifyTuple(t, checkpoint, 7, tuples, tuple.Height))
+	}
+	assert.False(t, verifyTuple(t, checkpoint, 8, tuples, 1))
+	other := append([]types.DataRootTuple{}, tuples...)
+	other[2].DataRoot = sha256.Sum256([]byte("other"))
+	assert.False(t, verifyTuple(t, checkpoint, 7, other, 3))
+
+	// the checkpoint round trips through JSON
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	bz, err := json.Marshal(checkpoint)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, bz, 0o600))
+	loaded, err := client.LoadCheckpoint(path)
+	require.NoError(t, err)
+	assert.Equal(t, checkpoint, *loaded)
+
+	t.Run("insufficient power", func(t *testing.T) {
+		c := checkpoint
+		c.DataRootTupleRoots = []client.CheckpointDataRootTupleRoot{{
+			Nonce: 7, DataRootTupleRoot: root, Signatures: []hexutil.Bytes{sign(0, 7), nil, nil},
+		}}
+		assert.Error(t, c.Validate())
+	})
+	t.Run("wrong nonce signed", func(t *testing.T) {
+		c := checkpoint
+		c.DataRootTupleRoots = []client.CheckpointDataRootTupleRoot{{
+			Nonce: 7, DataRootTupleRoot: root, Signatures: []hexutil.Bytes{sign(0, 8), sign(1, 8), nil},
+		}}
+		assert.Error(t, c.Validate())
+	})
+	t.Run("duplicate nonce", func(t *testing.T) {
+		c := client.Checkpoint{DataRootTupleRoots: []client.CheckpointDataRootTupleRoot{
+			{Nonce: 7, DataRootTupleRoot: root},
+			{Nonce: 7, DataRootTupleRoot: root},
+		}}
+		assert.Error(t, c.Validate())
+	})
+	t.Run("roots only", func(t *testing.T) {
+		c := client.Checkpoint{DataRootTupleRoots: []client.CheckpointDataRootTupleRoot{{Nonce: 7, DataRootTupleRoot: root}}}
+		require.NoError(t, c.Validate())
+		assert.True(t, verifyTuple(t, c, 7, tuples, 4))
+	})
+}
+
+func (s *CLITestSuite) TestVerifySharesOffline() {
+	_, err := s.cctx.WaitForHeightWithTimeout(402, 2*time.Minute)
+	s.Require().NoError(err)
+
+	config := client.VerifyConfig{
+		TendermintRPC: s.cfg.TmConfig.RPC.ListenAddress,
+		CelesGRPC:     s.cfg.AppConfig.GRPC.Address,
+		Simulated:     true,
+	}
+	logger := tmlog.NewNopLogger()
+
+	committed, err := client.VerifyShares(s.cctx.GoContext(), logger, config, 10, 0, 1)
+	s.Require().NoError(err)
+	s.Assert().True(committed)
+
+	res, err := s.cctx.Client.DataCommitment(s.cctx.GoContext(), 1, 401)
+	s.Require().NoError(err)
+	checkpoint := client.Checkpoint{DataRootTupleRoots: []client.CheckpointDataRootTupleRoot{
+		{Nonce: 2, DataRootTupleRoot: ethcmn.BytesToHash(res.DataCommitment)},
+	}}
+	bz, err := json.Marshal(checkpoint)
+	s.Require().NoError(err)
+	path := filepath.Join(s.T().TempDir(), "checkpoint.json")
+	s.Require().NoError(os.WriteFile(path, bz, 0o600))
+
+	config.Simulated = false
+	config.CheckpointPath = path
+	committed, err = client.VerifyShares(s.cctx.GoContext(), logger, config, 10, 0, 1)
+	s.Require().NoError(err)
+	s.Assert().True(committed)
+
+	checkpoint.DataRootTupleRoots[0].DataRootTupleRoot = ethcmn.Hash{}
+	bz, err = json.Marshal(checkpoint)
+	s.Require().NoError(err)
+	s.Require().NoError(os.WriteFile(path, bz, 0o600))
+	committed, err = client.VerifyShares(s.cctx.GoContext(), logger, config, 10, 0, 1)
+	s.Require().NoError(err)
+	s.Assert().False(committed)
+}
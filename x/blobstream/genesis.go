@@ -29,7 +29,6 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 
 // ExportGenesis returns the capability module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
-	genesis := types.DefaultGenesis()
-	genesis.Params.DataCommitmentWindow = k.GetDataCommitmentWindowParam(ctx)
-	return genesis
+	params := k.GetParams(ctx)
+	return &types.GenesisState{Params: &params}
 }
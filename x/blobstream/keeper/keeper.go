@@ -35,14 +35,23 @@ func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramSpace p
 	}
 }
 
-// GetParams returns the parameters from the store
-func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-	k.paramSpace.GetParamSet(ctx, &params)
+// GetParams returns the parameters from the store. Params that are missing
+// from the store are set to their default value.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	params := types.DefaultParams()
+	k.paramSpace.GetParamSetIfExists(ctx, &params)
 	return params
 }
 
-// SetParams sets the parameters in the store
+// SetParams sets the parameters in the store. Params that are unset are set to
+// their default value.
 func (k Keeper) SetParams(ctx sdk.Context, ps types.Params) {
+	if !ps.HasValsetPowerDiffThreshold() {
+		ps.ValsetPowerDiffThreshold = types.DefaultValsetPowerDiffThreshold
+	}
+	if !ps.HasAttestationExpiryTime() {
+		ps.AttestationExpiryTime = types.DefaultAttestationExpiryTime
+	}
 	k.paramSpace.SetParamSet(ctx, &ps)
 }
 
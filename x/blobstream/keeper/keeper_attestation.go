@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -137,3 +138,8 @@ func (k Keeper) DeleteAttestation(ctx sdk.Context, nonce uint64) {
 	}
 	store.Delete(key)
 }
+
+// GetAttestationExpiryTimeParam returns the AttestationExpiryTime param.
+func (k Keeper) GetAttestationExpiryTimeParam(ctx sdk.Context) time.Duration {
+	return k.GetParams(ctx).AttestationExpiryTime
+}
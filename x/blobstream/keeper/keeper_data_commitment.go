@@ -41,6 +41,7 @@ func (k Keeper) NextDataCommitment(ctx sdk.Context) (types.DataCommitment, error
 	return *dataCommitment, nil
 }
 
+// GetDataCommitmentWindowParam returns the DataCommitmentWindow param.
 func (k Keeper) GetDataCommitmentWindowParam(ctx sdk.Context) uint64 {
 	resp, err := k.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
 	if err != nil {
@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParams(t *testing.T) {
+	input := testutil.CreateTestEnv(t)
+	k := input.BlobstreamKeeper
+	ctx := input.Context
+
+	assert.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	// unset params are set to their default value
+	k.SetParams(ctx, types.Params{DataCommitmentWindow: 200})
+	assert.Equal(t, uint64(200), k.GetDataCommitmentWindowParam(ctx))
+	assert.Equal(t, types.DefaultValsetPowerDiffThreshold, k.GetValsetPowerDiffThresholdParam(ctx))
+	assert.Equal(t, types.DefaultAttestationExpiryTime, k.GetAttestationExpiryTimeParam(ctx))
+
+	params := types.Params{
+		DataCommitmentWindow:     400,
+		ValsetPowerDiffThreshold: sdk.NewDecWithPrec(1, 1),
+		AttestationExpiryTime:    time.Hour,
+	}
+	k.SetParams(ctx, params)
+	assert.Equal(t, params, k.GetParams(ctx))
+
+	k.SetParams(ctx, types.DefaultParams())
+	assert.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+
+	require.Panics(t, func() {
+		k.SetParams(ctx, types.Params{DataCommitmentWindow: 400, AttestationExpiryTime: -time.Hour})
+	})
+}
@@ -234,3 +234,8 @@ func (k Keeper) IsEVMAddressUnique(ctx sdk.Context, evmAddress gethcommon.Addres
 	}
 	return true
 }
+
+// GetValsetPowerDiffThresholdParam returns the ValsetPowerDiffThreshold param.
+func (k Keeper) GetValsetPowerDiffThresholdParam(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).ValsetPowerDiffThreshold
+}
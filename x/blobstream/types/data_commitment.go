@@ -1,6 +1,13 @@
 package types
 
-import "time"
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
 
 var _ AttestationRequestI = &DataCommitment{}
 
@@ -22,3 +29,30 @@ func NewDataCommitment(
 func (m *DataCommitment) BlockTime() time.Time {
 	return m.Time
 }
+
+// EncodeDataRootTupleRoot returns the ABI encoded payload that the Blobstream
+// contract hashes when a data root tuple root is submitted. It mimics the
+// 'domainSeparateDataRootTupleRoot' function used by the Blobstream contracts.
+func EncodeDataRootTupleRoot(nonce uint64, dataRootTupleRoot ethcmn.Hash) ([]byte, error) {
+	bytes, err := InternalBlobstreamABI.Pack(
+		"domainSeparateDataRootTupleRoot",
+		DcDomainSeparator,
+		new(big.Int).SetUint64(nonce),
+		dataRootTupleRoot,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("packing data root tuple root: %w", err)
+	}
+	// discard the function selector
+	return bytes[4:], nil
+}
+
+// DataRootTupleRootSignBytes produces the bytes that celestia validators are
+// required to sign over for the data root tuple root of a data commitment.
+func DataRootTupleRootSignBytes(nonce uint64, dataRootTupleRoot ethcmn.Hash) (ethcmn.Hash, error) {
+	bytes, err := EncodeDataRootTupleRoot(nonce, dataRootTupleRoot)
+	if err != nil {
+		return ethcmn.Hash{}, err
+	}
+	return crypto.Keccak256Hash(bytes), nil
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	ethcmn "github.com/ethereum/go-ethereum/common"
+	"github.com/tendermint/tendermint/crypto/merkle"
+)
+
+// DataRootTupleLimit is the maximum number of data root tuples that can be
+// committed to in a single data root tuple root. It matches the limit used by
+// the celestia-core DataCommitment RPC.
+const DataRootTupleLimit = 10_000
+
+// DataRootTuple is the height and data root of a block that the Blobstream
+// contract commits to.
+type DataRootTuple struct {
+	Height   uint64
+	DataRoot [32]byte
+}
+
+// Encode returns the ABI encoding of the data root tuple which is the height,
+// left padded to 32 bytes, concatenated with the data root.
+func (t DataRootTuple) Encode() []byte {
+	height := ethcmn.LeftPadBytes(new(big.Int).SetUint64(t.Height).Bytes(), 32)
+	return append(height, t.DataRoot[:]...)
+}
+
+// DataRootTupleRoot returns the merkle root of the data root tuples. It
+// matches the root computed by the celestia-core DataCommitment RPC.
+func DataRootTupleRoot(tuples []DataRootTuple) []byte {
+	return merkle.HashFromByteSlices(encodeDataRootTuples(tuples))
+}
+
+// DataRootTupleInclusionProof returns the merkle proof that the data root
+// tuple at height is included in the data root tuple root of tuples. The
+// tuples must be ordered by height without gaps.
+func DataRootTupleInclusionProof(tuples []DataRootTuple, height uint64) (*merkle.Proof, error) {
+	if len(tuples) == 0 {
+		return nil, fmt.Errorf("cannot prove inclusion in an empty set of data root tuples")
+	}
+	first, last := tuples[0].Height, tuples[len(tuples)-1].Height
+	if height < first || height > last {
+		return nil, fmt.Errorf("height %d is not in the data root tuple range [%d, %d]", height, first, last)
+	}
+	_, proofs := merkle.ProofsFromByteSlices(encodeDataRootTuples(tuples))
+	return proofs[height-first], nil
+}
+
+// ValidateDataRootTupleRange returns an error if the end exclusive range
+// [beginBlock, endBlock) cannot be committed to.
+func ValidateDataRootTupleRange(beginBlock uint64, endBlock uint64) error {
+	if beginBlock == 0 {
+		return fmt.Errorf("the first block is 0")
+	}
+	if beginBlock >= endBlock {
+		return fmt.Errorf("last block %d is not higher than first block %d", endBlock, beginBlock)
+	}
+	if endBlock-beginBlock > DataRootTupleLimit {
+		return fmt.Errorf("the range exceeds the limit of %d blocks", DataRootTupleLimit)
+	}
+	return nil
+}
+
+func encodeDataRootTuples(tuples []DataRootTuple) [][]byte {
+	encoded := make([][]byte, len(tuples))
+	for i, tuple := range tuples {
+		encoded[i] = tuple.Encode()
+	}
+	return encoded
+}
@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/rpc/core"
+)
+
+func TestDataRootTupleEncode(t *testing.T) {
+	tuple := types.DataRootTuple{Height: 0x1234, DataRoot: sha256.Sum256([]byte("data root"))}
+	want, err := core.EncodeDataRootTuple(tuple.Height, tuple.DataRoot)
+	require.NoError(t, err)
+	assert.Equal(t, want, tuple.Encode())
+}
+
+func TestDataRootTupleInclusionProof(t *testing.T) {
+	tuples := make([]types.DataRootTuple, 10)
+	for i := range tuples {
+		tuples[i] = types.DataRootTuple{Height: uint64(i + 5), DataRoot: sha256.Sum256([]byte{byte(i)})}
+	}
+	root := types.DataRootTupleRoot(tuples)
+
+	for _, tuple := range tuples {
+		proof, err := types.DataRootTupleInclusionProof(tuples, tuple.Height)
+		require.NoError(t, err)
+		assert.NoError(t, proof.Verify(root, tuple.Encode()))
+	}
+
+	_, err := types.DataRootTupleInclusionProof(tuples, 4)
+	assert.Error(t, err)
+	_, err = types.DataRootTupleInclusionProof(tuples, 15)
+	assert.Error(t, err)
+	_, err = types.DataRootTupleInclusionProof(nil, 1)
+	assert.Error(t, err)
+}
+
+func TestValidateDataRootTupleRange(t *testing.T) {
+	assert.NoError(t, types.ValidateDataRootTupleRange(1, 2))
+	assert.NoError(t, types.ValidateDataRootTupleRange(1, 1+types.DataRootTupleLimit))
+	assert.Error(t, types.ValidateDataRootTupleRange(0, 2))
+	assert.Error(t, types.ValidateDataRootTupleRange(2, 2))
+	assert.Error(t, types.ValidateDataRootTupleRange(3, 2))
+	assert.Error(t, types.ValidateDataRootTupleRange(1, 2+types.DataRootTupleLimit))
+}
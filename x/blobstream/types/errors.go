@@ -27,4 +27,6 @@ var (
 	ErrEVMAddressNotHex                          = errors.Register(ModuleName, 36, "the provided evm address is not a valid hex address")
 	ErrEVMAddressAlreadyExists                   = errors.Register(ModuleName, 37, "the provided evm address already exists")
 	ErrEVMAddressNotFound                        = errors.Register(ModuleName, 38, "EVM address not found")
+	ErrInvalidValsetPowerDiffThreshold           = errors.Register(ModuleName, 39, "invalid valset power diff threshold")
+	ErrInvalidAttestationExpiryTime              = errors.Register(ModuleName, 40, "invalid attestation expiry time")
 )
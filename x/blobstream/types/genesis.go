@@ -2,10 +2,12 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 
 	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -16,18 +18,43 @@ const (
 	// MinimumDataCommitmentWindow is a constant that defines the minimum
 	// allowable window for the Blobstream data commitments.
 	MinimumDataCommitmentWindow = 100
+
+	// DefaultAttestationExpiryTime is the default duration after which an
+	// attestation is pruned from state.
+	DefaultAttestationExpiryTime = 3 * 7 * 24 * time.Hour // 3 weeks
+)
+
+var (
+	// ParamsStoreKeyDataCommitmentWindow is the key used for the
+	// DataCommitmentWindow param.
+	ParamsStoreKeyDataCommitmentWindow = []byte("DataCommitmentWindow")
+	// ParamsStoreKeyValsetPowerDiffThreshold is the key used for the
+	// ValsetPowerDiffThreshold param.
+	ParamsStoreKeyValsetPowerDiffThreshold = []byte("ValsetPowerDiffThreshold")
+	// ParamsStoreKeyAttestationExpiryTime is the key used for the
+	// AttestationExpiryTime param.
+	ParamsStoreKeyAttestationExpiryTime = []byte("AttestationExpiryTime")
+
+	// DefaultValsetPowerDiffThreshold is the default threshold of change in
+	// the validator set power that triggers the creation of a new valset
+	// attestation.
+	DefaultValsetPowerDiffThreshold = sdk.NewDecWithPrec(5, 2) // 0.05
 )
 
-// ParamsStoreKeyDataCommitmentWindow is the key used for the
-// DataCommitmentWindow param.
-var ParamsStoreKeyDataCommitmentWindow = []byte("DataCommitmentWindow")
+// DefaultParams returns the default blobstream params.
+func DefaultParams() Params {
+	return Params{
+		DataCommitmentWindow:     400,
+		ValsetPowerDiffThreshold: DefaultValsetPowerDiffThreshold,
+		AttestationExpiryTime:    DefaultAttestationExpiryTime,
+	}
+}
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
+	params := DefaultParams()
 	return &GenesisState{
-		Params: &Params{
-			DataCommitmentWindow: 400,
-		},
+		Params: &params,
 	}
 }
 
@@ -51,6 +78,8 @@ func ParamKeyTable() paramtypes.KeyTable {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamsStoreKeyDataCommitmentWindow, &p.DataCommitmentWindow, validateDataCommitmentWindow),
+		paramtypes.NewParamSetPair(ParamsStoreKeyValsetPowerDiffThreshold, &p.ValsetPowerDiffThreshold, validateValsetPowerDiffThreshold),
+		paramtypes.NewParamSetPair(ParamsStoreKeyAttestationExpiryTime, &p.AttestationExpiryTime, validateAttestationExpiryTime),
 	}
 }
 
@@ -75,10 +104,62 @@ func validateDataCommitmentWindow(i interface{}) error {
 	return nil
 }
 
+func validateValsetPowerDiffThreshold(i interface{}) error {
+	val, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val.IsNil() || !val.IsPositive() || val.GT(sdk.OneDec()) {
+		return errors.Wrap(ErrInvalidValsetPowerDiffThreshold, fmt.Sprintf(
+			"valset power diff threshold %v must be in the range (0, 1]",
+			val,
+		))
+	}
+	return nil
+}
+
+func validateAttestationExpiryTime(i interface{}) error {
+	val, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if val <= 0 {
+		return errors.Wrap(ErrInvalidAttestationExpiryTime, fmt.Sprintf(
+			"attestation expiry time %v must be positive",
+			val,
+		))
+	}
+	return nil
+}
+
 // ValidateBasic checks that the parameters have valid values.
 func (p Params) ValidateBasic() error {
 	if err := validateDataCommitmentWindow(p.DataCommitmentWindow); err != nil {
 		return errors.Wrap(err, "data commitment window")
 	}
+	if p.HasValsetPowerDiffThreshold() {
+		if err := validateValsetPowerDiffThreshold(p.ValsetPowerDiffThreshold); err != nil {
+			return errors.Wrap(err, "valset power diff threshold")
+		}
+	}
+	if p.HasAttestationExpiryTime() {
+		if err := validateAttestationExpiryTime(p.AttestationExpiryTime); err != nil {
+			return errors.Wrap(err, "attestation expiry time")
+		}
+	}
 	return nil
 }
+
+// HasValsetPowerDiffThreshold returns true if the valset power diff threshold
+// is set. An unset threshold falls back to DefaultValsetPowerDiffThreshold so
+// that genesis files written before the param was added remain valid.
+func (p Params) HasValsetPowerDiffThreshold() bool {
+	return !p.ValsetPowerDiffThreshold.IsNil()
+}
+
+// HasAttestationExpiryTime returns true if the attestation expiry time is set.
+// An unset expiry time falls back to DefaultAttestationExpiryTime so that
+// genesis files written before the param was added remain valid.
+func (p Params) HasAttestationExpiryTime() bool {
+	return p.AttestationExpiryTime != 0
+}
@@ -2,11 +2,13 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
 
 	"github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -57,6 +59,43 @@ func TestGenesisStateValidate(t *testing.T) {
 			},
 			expErr: false,
 		},
+		"valid params: custom valset power diff threshold and attestation expiry time": {
+			src: &types.GenesisState{
+				Params: &types.Params{
+					DataCommitmentWindow:     types.MinimumDataCommitmentWindow,
+					ValsetPowerDiffThreshold: sdk.OneDec(),
+					AttestationExpiryTime:    time.Hour,
+				},
+			},
+			expErr: false,
+		},
+		"invalid params: zero valset power diff threshold": {
+			src: &types.GenesisState{
+				Params: &types.Params{
+					DataCommitmentWindow:     types.MinimumDataCommitmentWindow,
+					ValsetPowerDiffThreshold: sdk.ZeroDec(),
+				},
+			},
+			expErr: true,
+		},
+		"invalid params: valset power diff threshold above one": {
+			src: &types.GenesisState{
+				Params: &types.Params{
+					DataCommitmentWindow:     types.MinimumDataCommitmentWindow,
+					ValsetPowerDiffThreshold: sdk.NewDecWithPrec(11, 1),
+				},
+			},
+			expErr: true,
+		},
+		"invalid params: negative attestation expiry time": {
+			src: &types.GenesisState{
+				Params: &types.Params{
+					DataCommitmentWindow:  types.MinimumDataCommitmentWindow,
+					AttestationExpiryTime: -time.Hour,
+				},
+			},
+			expErr: true,
+		},
 	}
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
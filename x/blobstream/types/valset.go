@@ -30,10 +30,21 @@ func NewValset(nonce, height uint64, members InternalBridgeValidators, blockTime
 // SignBytes produces the bytes that celestia validators are required to sign
 // over when the validator set changes.
 func (v *Valset) SignBytes() (ethcmn.Hash, error) {
-	vsHash, err := v.Hash()
+	bytes, err := v.EncodeSignBytes()
 	if err != nil {
 		return ethcmn.Hash{}, err
 	}
+	return crypto.Keccak256Hash(bytes), nil
+}
+
+// EncodeSignBytes returns the ABI encoded payload that is hashed to produce
+// the SignBytes. It mimics the 'domainSeparateValidatorSetHash' function used
+// by the Blobstream contracts.
+func (v *Valset) EncodeSignBytes() ([]byte, error) {
+	vsHash, err := v.Hash()
+	if err != nil {
+		return nil, err
+	}
 
 	// the word 'checkpoint' needs to be the same as the 'name' above in the
 	// checkpointAbiJson but other than that it's a constant that has no impact
@@ -52,13 +63,22 @@ func (v *Valset) SignBytes() (ethcmn.Hash, error) {
 		panic(fmt.Sprintf("Error packing checkpoint! %s/n", err))
 	}
 
-	hash := crypto.Keccak256Hash(bytes[4:])
-	return hash, nil
+	return bytes[4:], nil
 }
 
 // Hash mimics the 'computeValsetHash' function used by the Blobstream contracts by
 // using a Valset to compute the hash of the abi encoded validator set.
 func (v *Valset) Hash() (ethcmn.Hash, error) {
+	encodedVals, err := v.EncodeMembers()
+	if err != nil {
+		return ethcmn.Hash{}, err
+	}
+	return crypto.Keccak256Hash(encodedVals), nil
+}
+
+// EncodeMembers returns the ABI encoded validator set as expected by the
+// Blobstream contracts.
+func (v *Valset) EncodeMembers() ([]byte, error) {
 	ethVals := make([]wrapper.Validator, len(v.Members))
 	for i, val := range v.Members {
 		ethVals[i] = wrapper.Validator{
@@ -69,10 +89,11 @@ func (v *Valset) Hash() (ethcmn.Hash, error) {
 
 	encodedVals, err := InternalBlobstreamABI.Pack("computeValidatorSetHash", ethVals)
 	if err != nil {
-		return ethcmn.Hash{}, err
+		return nil, err
 	}
 
-	return crypto.Keccak256Hash(encodedVals[4:]), nil
+	// discard the function selector
+	return encodedVals[4:], nil
 }
 
 func (v *Valset) TwoThirdsThreshold() uint64 {
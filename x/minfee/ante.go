@@ -0,0 +1,35 @@
+package minfee
+
+import (
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SquareUsageDecorator records the number of shares that a transaction and
+// its blobs occupy in the data square so that the network min gas price can
+// be adjusted at the end of the block. It only applies to app versions > 2.
+type SquareUsageDecorator struct {
+	k Keeper
+}
+
+func NewSquareUsageDecorator(k Keeper) SquareUsageDecorator {
+	return SquareUsageDecorator{k}
+}
+
+// AnteHandle implements the Cosmos SDK AnteHandler function signature.
+func (d SquareUsageDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.IsCheckTx() || simulate || ctx.BlockHeader().Version.App <= v2.Version {
+		return next(ctx, tx, simulate)
+	}
+
+	var blobSizes []uint32
+	for _, m := range tx.GetMsgs() {
+		if pfb, ok := m.(*blobtypes.MsgPayForBlobs); ok {
+			blobSizes = append(blobSizes, pfb.BlobSizes...)
+		}
+	}
+	d.k.RecordSquareUsage(ctx, len(ctx.TxBytes()), blobSizes)
+
+	return next(ctx, tx, simulate)
+}
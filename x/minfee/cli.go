@@ -0,0 +1,109 @@
+package minfee
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the CLI query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s module", ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdQueryNetworkMinGasPrice())
+	cmd.AddCommand(CmdQueryNetworkMinGasPriceHistory())
+	cmd.AddCommand(CmdQueryTotalFeesBurned())
+	return cmd
+}
+
+func CmdQueryNetworkMinGasPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "network-min-gas-price",
+		Short:   "Query for the current network min gas price",
+		Args:    cobra.NoArgs,
+		Example: "network-min-gas-price",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := NewQueryClient(clientCtx)
+			resp, err := queryClient.NetworkMinGasPrice(cmd.Context(), &QueryNetworkMinGasPrice{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryNetworkMinGasPriceHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "network-min-gas-price-history",
+		Short:   "Query for the changes to the network min gas price, oldest first",
+		Args:    cobra.NoArgs,
+		Example: "network-min-gas-price-history --limit 10 --reverse",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := NewQueryClient(clientCtx)
+			resp, err := queryClient.NetworkMinGasPriceHistory(cmd.Context(), &QueryNetworkMinGasPriceHistory{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "network min gas price history")
+	return cmd
+}
+
+func CmdQueryTotalFeesBurned() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "total-fees-burned",
+		Short:   "Query for the total amount of fees that have been burned",
+		Args:    cobra.NoArgs,
+		Example: "total-fees-burned",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := NewQueryClient(clientCtx)
+			resp, err := queryClient.TotalFeesBurned(cmd.Context(), &QueryTotalFeesBurned{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,11 @@
+package minfee
+
+const (
+	EventTypeNetworkMinGasPriceAdjusted = "network_min_gas_price_adjusted"
+	EventTypeFeeBurned                  = "fee_burned"
+
+	AttributeKeyOldNetworkMinGasPrice = "old_network_min_gas_price"
+	AttributeKeyNewNetworkMinGasPrice = "new_network_min_gas_price"
+	AttributeKeySquareUtilization     = "square_utilization"
+	AttributeKeyTotalFeesBurned       = "total_fees_burned"
+)
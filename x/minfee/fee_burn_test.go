@@ -0,0 +1,162 @@
+package minfee_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	"github.com/celestiaorg/celestia-app/v3/x/minfee"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramkeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+	tmdb "github.com/tendermint/tm-db"
+)
+
+func TestBurnFees(t *testing.T) {
+	const (
+		gasPerBlobByte = 8
+		blobShares     = 10
+	)
+	// blobSize is the size of a blob that occupies exactly blobShares shares.
+	blobSize := uint32(share.FirstSparseShareContentSize + (blobShares-1)*share.ContinuationSparseShareContentSize)
+	blobGas := uint64(blobShares * share.ShareSize * gasPerBlobByte)
+
+	testCases := []struct {
+		name       string
+		feeBurn    minfee.FeeBurn
+		fee        sdk.Coins
+		gas        uint64
+		wantBurned int64
+	}{
+		{
+			name:       "disabled by default",
+			feeBurn:    minfee.DefaultFeeBurn(),
+			fee:        sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1000)),
+			gas:        blobGas * 2,
+			wantBurned: 0,
+		},
+		{
+			name:       "burns a fraction of the whole fee",
+			feeBurn:    minfee.FeeBurn{BurnFraction: sdk.MustNewDecFromStr("0.25")},
+			fee:        sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1000)),
+			gas:        blobGas * 2,
+			wantBurned: 250,
+		},
+		{
+			name:       "burns a fraction of the blob fee",
+			feeBurn:    minfee.FeeBurn{BurnFraction: sdk.MustNewDecFromStr("0.25"), BlobFeeOnly: true},
+			fee:        sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1000)),
+			gas:        blobGas * 2,
+			wantBurned: 125,
+		},
+		{
+			name:       "only burns utia",
+			feeBurn:    minfee.FeeBurn{BurnFraction: sdk.OneDec()},
+			fee:        sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1000), sdk.NewInt64Coin("ibc/A", 1000)),
+			gas:        blobGas * 2,
+			wantBurned: 1000,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			bankKeeper := &mockBankKeeper{}
+			k, ctx := setupKeeperWithBank(t, bankKeeper, mockBlobKeeper{gasPerBlobByte: gasPerBlobByte})
+			k.SetFeeBurn(ctx, tc.feeBurn)
+
+			err := k.BurnFees(ctx, mockFeeTx{fee: tc.fee, gas: tc.gas}, []uint32{blobSize})
+			require.NoError(t, err)
+
+			require.Equal(t, tc.wantBurned, bankKeeper.burned.AmountOf(appconsts.BondDenom).Int64())
+			require.Equal(t, tc.wantBurned, k.GetTotalFeesBurned(ctx).Int64())
+		})
+	}
+}
+
+func TestBurnFeesAccumulatesTotal(t *testing.T) {
+	k, ctx := setupKeeperWithBank(t, &mockBankKeeper{}, mockBlobKeeper{gasPerBlobByte: 8})
+	k.SetFeeBurn(ctx, minfee.FeeBurn{BurnFraction: sdk.MustNewDecFromStr("0.5")})
+	feeTx := mockFeeTx{fee: sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 101)), gas: 1000}
+
+	require.NoError(t, k.BurnFees(ctx, feeTx, []uint32{1}))
+	require.NoError(t, k.BurnFees(ctx, feeTx, []uint32{1}))
+
+	// 50.5 is rounded down to 50 for each tx.
+	require.Equal(t, int64(100), k.GetTotalFeesBurned(ctx).Int64())
+}
+
+func setupKeeperWithBank(t *testing.T, bankKeeper minfee.BankKeeper, blobKeeper minfee.BlobKeeper) (minfee.Keeper, sdk.Context) {
+	storeKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tStoreKey := storetypes.NewTransientStoreKey(paramtypes.TStoreKey)
+	minfeeStoreKey := sdk.NewKVStoreKey(minfee.StoreKey)
+	minfeeTStoreKey := storetypes.NewTransientStoreKey(minfee.TStoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(tStoreKey, storetypes.StoreTypeTransient, nil)
+	stateStore.MountStoreWithDB(minfeeStoreKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(minfeeTStoreKey, storetypes.StoreTypeTransient, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	paramsKeeper := paramkeeper.NewKeeper(cdc, codec.NewLegacyAmino(), storeKey, tStoreKey)
+	paramsKeeper.Subspace(minfee.ModuleName)
+
+	k := minfee.NewKeeper(cdc, minfeeStoreKey, minfeeTStoreKey, paramsKeeper, bankKeeper, blobKeeper)
+	header := tmproto.Header{Height: 1, Version: version.Consensus{App: v3.Version}}
+	ctx := sdk.NewContext(stateStore, header, false, log.NewNopLogger())
+	return k, ctx
+}
+
+type mockBankKeeper struct {
+	burned sdk.Coins
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToModule(_ sdk.Context, senderModule, recipientModule string, _ sdk.Coins) error {
+	if senderModule != authtypes.FeeCollectorName || recipientModule != minfee.ModuleName {
+		panic("unexpected transfer")
+	}
+	return nil
+}
+
+func (m *mockBankKeeper) BurnCoins(_ sdk.Context, _ string, amt sdk.Coins) error {
+	m.burned = m.burned.Add(amt...)
+	return nil
+}
+
+type mockBlobKeeper struct {
+	gasPerBlobByte uint32
+}
+
+func (m mockBlobKeeper) GovMaxSquareSize(sdk.Context) uint64 {
+	return appconsts.DefaultGovMaxSquareSize
+}
+
+func (m mockBlobKeeper) GasPerBlobByte(sdk.Context) uint32 {
+	return m.gasPerBlobByte
+}
+
+type mockFeeTx struct {
+	sdk.FeeTx
+	fee sdk.Coins
+	gas uint64
+}
+
+func (m mockFeeTx) GetFee() sdk.Coins {
+	return m.fee
+}
+
+func (m mockFeeTx) GetGas() uint64 {
+	return m.gas
+}
@@ -0,0 +1,45 @@
+package minfee
+
+import (
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// GetFeeDenoms returns the allowlist of fee denoms stored in the minfee
+// subspace. It returns an empty list if none has been set.
+func GetFeeDenoms(ctx sdk.Context, subspace paramtypes.Subspace) []FeeDenom {
+	feeDenoms := []FeeDenom{}
+	RegisterMinFeeParamTable(subspace).GetIfExists(ctx, KeyFeeDenoms, &feeDenoms)
+	return feeDenoms
+}
+
+// ConvertFee returns the value of fee in the bond denom. Coins of the bond
+// denom are counted as is and coins of an allowlisted fee denom are converted
+// using its exchange rate, rounding down. Coins of any other denom are worth
+// nothing.
+func ConvertFee(fee sdk.Coins, feeDenoms []FeeDenom) math.Int {
+	total := math.ZeroInt()
+	for _, coin := range fee {
+		if coin.Denom == appconsts.BondDenom {
+			total = total.Add(coin.Amount)
+			continue
+		}
+		exchangeRate, found := exchangeRate(feeDenoms, coin.Denom)
+		if !found {
+			continue
+		}
+		total = total.Add(exchangeRate.MulInt(coin.Amount).TruncateInt())
+	}
+	return total
+}
+
+func exchangeRate(feeDenoms []FeeDenom, denom string) (sdk.Dec, bool) {
+	for _, feeDenom := range feeDenoms {
+		if feeDenom.Denom == denom {
+			return feeDenom.ExchangeRate, true
+		}
+	}
+	return sdk.Dec{}, false
+}
@@ -0,0 +1,114 @@
+package minfee_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/x/minfee"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertFee(t *testing.T) {
+	feeDenoms := []minfee.FeeDenom{
+		{Denom: "ibc/A", ExchangeRate: sdk.MustNewDecFromStr("0.5")},
+		{Denom: "ibc/B", ExchangeRate: sdk.NewDec(3)},
+	}
+
+	testCases := []struct {
+		name string
+		fee  sdk.Coins
+		want int64
+	}{
+		{
+			name: "empty fee",
+			fee:  sdk.NewCoins(),
+			want: 0,
+		},
+		{
+			name: "bond denom only",
+			fee:  sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 100)),
+			want: 100,
+		},
+		{
+			name: "allowlisted denoms are converted and rounded down",
+			fee:  sdk.NewCoins(sdk.NewInt64Coin("ibc/A", 5), sdk.NewInt64Coin("ibc/B", 2)),
+			want: 2 + 6,
+		},
+		{
+			name: "bond denom and allowlisted denom",
+			fee:  sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 100), sdk.NewInt64Coin("ibc/B", 2)),
+			want: 106,
+		},
+		{
+			name: "zero coin of a denom that is not allowlisted",
+			fee:  sdk.Coins{sdk.NewInt64Coin(appconsts.BondDenom, 100), sdk.NewInt64Coin("stake", 0)},
+			want: 100,
+		},
+		{
+			name: "denom that is not allowlisted is skipped",
+			fee:  sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 100), sdk.NewInt64Coin("ibc/C", 2)),
+			want: 100,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := minfee.ConvertFee(tc.fee, feeDenoms)
+			require.Equal(t, tc.want, got.Int64())
+		})
+	}
+}
+
+func TestValidateFeeDenoms(t *testing.T) {
+	testCases := []struct {
+		name      string
+		feeDenoms []minfee.FeeDenom
+		wantErr   bool
+	}{
+		{
+			name:      "empty",
+			feeDenoms: []minfee.FeeDenom{},
+		},
+		{
+			name:      "valid",
+			feeDenoms: []minfee.FeeDenom{{Denom: "ibc/A", ExchangeRate: sdk.NewDec(1)}, {Denom: "ibc/B", ExchangeRate: sdk.NewDec(2)}},
+		},
+		{
+			name:      "bond denom",
+			feeDenoms: []minfee.FeeDenom{{Denom: appconsts.BondDenom, ExchangeRate: sdk.NewDec(1)}},
+			wantErr:   true,
+		},
+		{
+			name:      "duplicate denom",
+			feeDenoms: []minfee.FeeDenom{{Denom: "ibc/A", ExchangeRate: sdk.NewDec(1)}, {Denom: "ibc/A", ExchangeRate: sdk.NewDec(2)}},
+			wantErr:   true,
+		},
+		{
+			name:      "invalid denom",
+			feeDenoms: []minfee.FeeDenom{{Denom: "!", ExchangeRate: sdk.NewDec(1)}},
+			wantErr:   true,
+		},
+		{
+			name:      "zero exchange rate",
+			feeDenoms: []minfee.FeeDenom{{Denom: "ibc/A", ExchangeRate: sdk.ZeroDec()}},
+			wantErr:   true,
+		},
+		{
+			name:      "nil exchange rate",
+			feeDenoms: []minfee.FeeDenom{{Denom: "ibc/A"}},
+			wantErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := minfee.ValidateFeeDenoms(tc.feeDenoms)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -4,13 +4,13 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	params "github.com/cosmos/cosmos-sdk/x/params/keeper"
 )
 
 // DefaultGenesis returns the default genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
 		NetworkMinGasPrice: DefaultNetworkMinGasPrice,
+		TotalFeesBurned:    sdk.ZeroInt(),
 	}
 }
 
@@ -19,13 +19,41 @@ func ValidateGenesis(genesis *GenesisState) error {
 	if genesis.NetworkMinGasPrice.IsNegative() || genesis.NetworkMinGasPrice.IsZero() {
 		return fmt.Errorf("network min gas price cannot be negative or zero: %g", genesis.NetworkMinGasPrice)
 	}
+	if genesis.GasPriceAdjustment != nil {
+		if err := genesis.GasPriceAdjustment.Validate(); err != nil {
+			return fmt.Errorf("invalid gas price adjustment: %w", err)
+		}
+	}
+	if genesis.FeeBurn != nil {
+		if err := genesis.FeeBurn.Validate(); err != nil {
+			return fmt.Errorf("invalid fee burn: %w", err)
+		}
+	}
+	if !genesis.TotalFeesBurned.IsNil() && genesis.TotalFeesBurned.IsNegative() {
+		return fmt.Errorf("total fees burned cannot be negative: %s", genesis.TotalFeesBurned)
+	}
+	if err := ValidateFeeDenoms(genesis.FeeDenoms); err != nil {
+		return fmt.Errorf("invalid fee denoms: %w", err)
+	}
+	for i, change := range genesis.NetworkMinGasPriceHistory {
+		if change.OldNetworkMinGasPrice.IsNil() || change.NewNetworkMinGasPrice.IsNil() {
+			return fmt.Errorf("network min gas price change %d is missing a price", i)
+		}
+		if !change.NewNetworkMinGasPrice.IsPositive() {
+			return fmt.Errorf("network min gas price change %d has a non positive new price: %s", i, change.NewNetworkMinGasPrice)
+		}
+		if i > 0 && change.Height < genesis.NetworkMinGasPriceHistory[i-1].Height {
+			return fmt.Errorf("network min gas price changes must be ordered by height: change %d at height %d is before change %d at height %d",
+				i, change.Height, i-1, genesis.NetworkMinGasPriceHistory[i-1].Height)
+		}
+	}
 
 	return nil
 }
 
 // ExportGenesis returns the minfee module's exported genesis.
-func ExportGenesis(ctx sdk.Context, k params.Keeper) *GenesisState {
-	subspace, exists := k.GetSubspace(ModuleName)
+func ExportGenesis(ctx sdk.Context, k Keeper) *GenesisState {
+	subspace, exists := k.paramsKeeper.GetSubspace(ModuleName)
 	if !exists {
 		panic("minfee subspace not set")
 	}
@@ -34,5 +62,26 @@ func ExportGenesis(ctx sdk.Context, k params.Keeper) *GenesisState {
 	var networkMinGasPrice sdk.Dec
 	subspace.Get(ctx, KeyNetworkMinGasPrice, &networkMinGasPrice)
 
-	return &GenesisState{NetworkMinGasPrice: networkMinGasPrice}
+	genesis := &GenesisState{NetworkMinGasPrice: networkMinGasPrice}
+	// Only export the gas price adjustment params if they have been set.
+	if subspace.Has(ctx, KeyGasPriceAdjustmentEnabled) {
+		gasPriceAdjustment := DefaultGasPriceAdjustment()
+		subspace.GetParamSetIfExists(ctx, &gasPriceAdjustment)
+		genesis.GasPriceAdjustment = &gasPriceAdjustment
+	}
+	// Only export the fee denoms if they have been set.
+	if subspace.Has(ctx, KeyFeeDenoms) {
+		genesis.FeeDenoms = GetFeeDenoms(ctx, subspace)
+	}
+	// Only export the fee burn params if they have been set.
+	if subspace.Has(ctx, KeyFeeBurnFraction) {
+		feeBurn := DefaultFeeBurn()
+		subspace.GetParamSetIfExists(ctx, &feeBurn)
+		genesis.FeeBurn = &feeBurn
+	}
+	if storeMounted(ctx) {
+		genesis.NetworkMinGasPriceHistory = k.GetNetworkMinGasPriceHistory(ctx)
+		genesis.TotalFeesBurned = k.GetTotalFeesBurned(ctx)
+	}
+	return genesis
 }
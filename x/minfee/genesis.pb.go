@@ -28,6 +28,19 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the minfee module's genesis state.
 type GenesisState struct {
 	NetworkMinGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=network_min_gas_price,json=networkMinGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"network_min_gas_price"`
+	// GasPriceAdjustment is optional. If it is not set, the network min gas
+	// price is not adjusted automatically.
+	GasPriceAdjustment *GasPriceAdjustment `protobuf:"bytes,2,opt,name=gas_price_adjustment,json=gasPriceAdjustment,proto3" json:"gas_price_adjustment,omitempty"`
+	// NetworkMinGasPriceHistory contains every change to the network min gas
+	// price in the order in which they occurred.
+	NetworkMinGasPriceHistory []NetworkMinGasPriceChange `protobuf:"bytes,3,rep,name=network_min_gas_price_history,json=networkMinGasPriceHistory,proto3" json:"network_min_gas_price_history"`
+	// FeeDenoms is the allowlist of denoms other than utia that can be used to
+	// pay fees.
+	FeeDenoms []FeeDenom `protobuf:"bytes,4,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms"`
+	// FeeBurn is optional. If it is not set, no fees are burned.
+	FeeBurn *FeeBurn `protobuf:"bytes,5,opt,name=fee_burn,json=feeBurn,proto3" json:"fee_burn,omitempty"`
+	// TotalFeesBurned is the total amount of utia fees that have been burned.
+	TotalFeesBurned github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,6,opt,name=total_fees_burned,json=totalFeesBurned,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_fees_burned"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -63,30 +76,309 @@ func (m *GenesisState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GenesisState proto.InternalMessageInfo
 
+func (m *GenesisState) GetGasPriceAdjustment() *GasPriceAdjustment {
+	if m != nil {
+		return m.GasPriceAdjustment
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNetworkMinGasPriceHistory() []NetworkMinGasPriceChange {
+	if m != nil {
+		return m.NetworkMinGasPriceHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFeeBurn() *FeeBurn {
+	if m != nil {
+		return m.FeeBurn
+	}
+	return nil
+}
+
+// FeeBurn defines the governance parameters for burning a fraction of the
+// fees paid by PayForBlobs transactions.
+type FeeBurn struct {
+	// BurnFraction is the fraction of the utia fee of a PayForBlobs transaction
+	// that is burned. The rest is kept by the fee collector.
+	BurnFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=burn_fraction,json=burnFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_fraction"`
+	// BlobFeeOnly indicates whether the burn fraction only applies to the
+	// portion of the fee that pays for the gas consumed by blob bytes.
+	BlobFeeOnly bool `protobuf:"varint,2,opt,name=blob_fee_only,json=blobFeeOnly,proto3" json:"blob_fee_only,omitempty"`
+}
+
+func (m *FeeBurn) Reset()         { *m = FeeBurn{} }
+func (m *FeeBurn) String() string { return proto.CompactTextString(m) }
+func (*FeeBurn) ProtoMessage()    {}
+func (*FeeBurn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40506204178306cf, []int{1}
+}
+func (m *FeeBurn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeBurn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeBurn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeBurn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeBurn.Merge(m, src)
+}
+func (m *FeeBurn) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeBurn) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeBurn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeBurn proto.InternalMessageInfo
+
+func (m *FeeBurn) GetBlobFeeOnly() bool {
+	if m != nil {
+		return m.BlobFeeOnly
+	}
+	return false
+}
+
+// FeeDenom is a denom other than utia that can be used to pay fees.
+type FeeDenom struct {
+	// Denom is the denom of the fee coin e.g. an IBC denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// ExchangeRate is the amount of utia that one unit of denom is worth. It is
+	// used to convert fees paid in denom to utia when checking them against the
+	// min gas price and when computing the tx priority.
+	ExchangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=exchange_rate,json=exchangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exchange_rate"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40506204178306cf, []int{2}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// NetworkMinGasPriceChange records a change to the network min gas price.
+type NetworkMinGasPriceChange struct {
+	// Height is the height of the block in which the change occurred.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// OldNetworkMinGasPrice is the network min gas price before the change.
+	OldNetworkMinGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=old_network_min_gas_price,json=oldNetworkMinGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"old_network_min_gas_price"`
+	// NewNetworkMinGasPrice is the network min gas price after the change.
+	NewNetworkMinGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=new_network_min_gas_price,json=newNetworkMinGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"new_network_min_gas_price"`
+	// ProposalId is the id of the governance proposal that made the change. It
+	// is 0 if the change was made by the automatic gas price adjustment.
+	ProposalId uint64 `protobuf:"varint,4,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *NetworkMinGasPriceChange) Reset()         { *m = NetworkMinGasPriceChange{} }
+func (m *NetworkMinGasPriceChange) String() string { return proto.CompactTextString(m) }
+func (*NetworkMinGasPriceChange) ProtoMessage()    {}
+func (*NetworkMinGasPriceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40506204178306cf, []int{3}
+}
+func (m *NetworkMinGasPriceChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NetworkMinGasPriceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NetworkMinGasPriceChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NetworkMinGasPriceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NetworkMinGasPriceChange.Merge(m, src)
+}
+func (m *NetworkMinGasPriceChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *NetworkMinGasPriceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_NetworkMinGasPriceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NetworkMinGasPriceChange proto.InternalMessageInfo
+
+func (m *NetworkMinGasPriceChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *NetworkMinGasPriceChange) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// GasPriceAdjustment defines the governance parameters for automatically
+// adjusting the network min gas price at the end of every block based on how
+// full the data square was relative to a target.
+type GasPriceAdjustment struct {
+	// Enabled indicates whether the network min gas price is adjusted.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// TargetSquareUtilization is the fraction of the max data square that
+	// blocks should fill on average. The network min gas price increases if a
+	// block fills more than the target and decreases if it fills less.
+	TargetSquareUtilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=target_square_utilization,json=targetSquareUtilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_square_utilization"`
+	// MaxChangeRate is the max fraction by which the network min gas price can
+	// change from one block to the next.
+	MaxChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=max_change_rate,json=maxChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_change_rate"`
+	// MinNetworkMinGasPrice is the lower bound of the network min gas price.
+	MinNetworkMinGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_network_min_gas_price,json=minNetworkMinGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_network_min_gas_price"`
+	// MaxNetworkMinGasPrice is the upper bound of the network min gas price.
+	MaxNetworkMinGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_network_min_gas_price,json=maxNetworkMinGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_network_min_gas_price"`
+}
+
+func (m *GasPriceAdjustment) Reset()         { *m = GasPriceAdjustment{} }
+func (m *GasPriceAdjustment) String() string { return proto.CompactTextString(m) }
+func (*GasPriceAdjustment) ProtoMessage()    {}
+func (*GasPriceAdjustment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_40506204178306cf, []int{4}
+}
+func (m *GasPriceAdjustment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasPriceAdjustment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasPriceAdjustment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasPriceAdjustment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasPriceAdjustment.Merge(m, src)
+}
+func (m *GasPriceAdjustment) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasPriceAdjustment) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasPriceAdjustment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasPriceAdjustment proto.InternalMessageInfo
+
+func (m *GasPriceAdjustment) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "celestia.minfee.v1.GenesisState")
+	proto.RegisterType((*FeeBurn)(nil), "celestia.minfee.v1.FeeBurn")
+	proto.RegisterType((*FeeDenom)(nil), "celestia.minfee.v1.FeeDenom")
+	proto.RegisterType((*NetworkMinGasPriceChange)(nil), "celestia.minfee.v1.NetworkMinGasPriceChange")
+	proto.RegisterType((*GasPriceAdjustment)(nil), "celestia.minfee.v1.GasPriceAdjustment")
 }
 
 func init() { proto.RegisterFile("celestia/minfee/v1/genesis.proto", fileDescriptor_40506204178306cf) }
 
 var fileDescriptor_40506204178306cf = []byte{
-	// 250 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x48, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0xcf, 0xcd, 0xcc, 0x4b, 0x4b, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x4f,
-	0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xa9,
-	0xd0, 0x83, 0xa8, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x4b, 0xeb, 0x83,
-	0x58, 0x10, 0x95, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5, 0xf1, 0x10, 0x09, 0x08, 0x07,
-	0x22, 0xa5, 0x54, 0xcf, 0xc5, 0xe3, 0x0e, 0x31, 0x35, 0xb8, 0x24, 0xb1, 0x24, 0x55, 0x28, 0x9f,
-	0x4b, 0x34, 0x2f, 0xb5, 0xa4, 0x3c, 0xbf, 0x28, 0x3b, 0x3e, 0x37, 0x33, 0x2f, 0x3e, 0x3d, 0x11,
-	0xa4, 0x2b, 0x33, 0x39, 0x55, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3, 0xc9, 0xe6, 0xc4, 0x3d, 0x79,
-	0x86, 0x5b, 0xf7, 0xe4, 0xd5, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xa1,
-	0xe6, 0x41, 0x29, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x92, 0xca, 0x82, 0xd4, 0x62, 0x3d, 0x97, 0xd4,
-	0xe4, 0x4b, 0x5b, 0x74, 0xb9, 0xa0, 0xd6, 0xb9, 0xa4, 0x26, 0x07, 0x09, 0x41, 0x8d, 0xf6, 0xcd,
-	0xcc, 0x73, 0x4f, 0x2c, 0x0e, 0x00, 0x99, 0xeb, 0xe4, 0x76, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47,
-	0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d,
-	0xc7, 0x72, 0x0c, 0x51, 0x3a, 0xc8, 0x76, 0x40, 0xbd, 0x9a, 0x5f, 0x94, 0x0e, 0x67, 0xeb, 0x26,
-	0x16, 0x14, 0xe8, 0x57, 0x40, 0x83, 0x27, 0x89, 0x0d, 0xec, 0x1f, 0x63, 0x40, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xc1, 0xc2, 0x03, 0x17, 0x38, 0x01, 0x00, 0x00,
+	// 682 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x4d, 0x4f, 0xdb, 0x30,
+	0x18, 0xc7, 0x1b, 0x5a, 0xa0, 0xb8, 0x20, 0x34, 0x0b, 0xb6, 0x94, 0x6d, 0xa5, 0xea, 0x01, 0xf5,
+	0x00, 0xad, 0x60, 0xd2, 0x4e, 0xbb, 0x50, 0x50, 0x19, 0x87, 0xbd, 0x28, 0x68, 0xd2, 0xb4, 0x8b,
+	0xe5, 0x26, 0x4f, 0x53, 0x8f, 0xc4, 0xee, 0x62, 0x17, 0xda, 0x5d, 0xf7, 0x05, 0xf8, 0x30, 0x7c,
+	0x83, 0x5d, 0x38, 0xa2, 0x9d, 0xa6, 0x1d, 0xd0, 0x04, 0xdf, 0x63, 0x9a, 0xec, 0xa4, 0x1d, 0x52,
+	0xc2, 0x61, 0x52, 0x4e, 0xf5, 0x63, 0x3f, 0xfe, 0xfd, 0x9f, 0xb7, 0xd4, 0xa8, 0xee, 0x42, 0x00,
+	0x52, 0x31, 0xda, 0x0e, 0x19, 0xef, 0x03, 0xb4, 0xcf, 0x76, 0xdb, 0x3e, 0x70, 0x90, 0x4c, 0xb6,
+	0x86, 0x91, 0x50, 0x02, 0xe3, 0xa9, 0x47, 0x2b, 0xf6, 0x68, 0x9d, 0xed, 0x6e, 0xac, 0xf9, 0xc2,
+	0x17, 0xe6, 0xb8, 0xad, 0x57, 0xb1, 0xe7, 0x46, 0xd5, 0x15, 0x32, 0x14, 0x92, 0xc4, 0x07, 0xb1,
+	0x11, 0x1f, 0x35, 0x2e, 0x4b, 0x68, 0xf9, 0x28, 0xc6, 0x9e, 0x28, 0xaa, 0x00, 0x0b, 0xb4, 0xce,
+	0x41, 0x9d, 0x8b, 0xe8, 0x94, 0x84, 0x8c, 0x13, 0x9f, 0xea, 0x6b, 0xcc, 0x05, 0xdb, 0xaa, 0x5b,
+	0xcd, 0xa5, 0xce, 0xab, 0xab, 0x9b, 0xcd, 0xc2, 0xaf, 0x9b, 0xcd, 0x2d, 0x9f, 0xa9, 0xc1, 0xa8,
+	0xd7, 0x72, 0x45, 0x98, 0x00, 0x93, 0x9f, 0x1d, 0xe9, 0x9d, 0xb6, 0xd5, 0x64, 0x08, 0xb2, 0x75,
+	0x08, 0xee, 0x8f, 0xcb, 0x1d, 0x94, 0xe8, 0x1d, 0x82, 0xeb, 0xe0, 0x04, 0xfd, 0x86, 0xf1, 0x23,
+	0x2a, 0xdf, 0x6b, 0x2e, 0xfe, 0x88, 0xd6, 0x66, 0x22, 0x84, 0x7a, 0x9f, 0x47, 0x52, 0x85, 0xc0,
+	0x95, 0x3d, 0x57, 0xb7, 0x9a, 0x95, 0xbd, 0xad, 0x56, 0x3a, 0xcb, 0xd6, 0xf4, 0xee, 0xfe, 0xcc,
+	0xdb, 0xc1, 0x7e, 0x6a, 0x0f, 0x2b, 0xf4, 0x3c, 0x33, 0x15, 0x32, 0x60, 0x52, 0x89, 0x68, 0x62,
+	0x17, 0xeb, 0xc5, 0x66, 0x65, 0x6f, 0x3b, 0x4b, 0xe2, 0x6d, 0x2a, 0xd0, 0x83, 0x01, 0xe5, 0x3e,
+	0x74, 0x4a, 0xba, 0x00, 0x4e, 0x35, 0x9d, 0xc8, 0xeb, 0x18, 0x8a, 0xf7, 0x11, 0xea, 0x03, 0x10,
+	0x0f, 0xb8, 0x08, 0xa5, 0x5d, 0x32, 0x12, 0xcf, 0xb2, 0x24, 0xba, 0x00, 0x87, 0xda, 0x29, 0x41,
+	0x2e, 0xf5, 0x13, 0x5b, 0xe2, 0x97, 0xa8, 0xac, 0x11, 0xbd, 0x51, 0xc4, 0xed, 0x79, 0x53, 0x86,
+	0xa7, 0x0f, 0x00, 0x3a, 0xa3, 0x88, 0x3b, 0x8b, 0xfd, 0x78, 0x81, 0x07, 0xe8, 0x91, 0x12, 0x8a,
+	0x06, 0xa4, 0x0f, 0x20, 0xcd, 0x75, 0xf0, 0xec, 0x85, 0xff, 0xee, 0xdb, 0x31, 0x57, 0xf7, 0xfa,
+	0x76, 0xcc, 0x95, 0xb3, 0x6a, 0xb0, 0x5d, 0x00, 0xd9, 0x31, 0xd0, 0xc6, 0x85, 0x85, 0x16, 0x13,
+	0x79, 0x4c, 0xd1, 0x8a, 0x96, 0x22, 0xfd, 0x88, 0xba, 0x8a, 0x09, 0x9e, 0xcb, 0xa4, 0x2c, 0x6b,
+	0x64, 0x37, 0x21, 0xe2, 0x06, 0x5a, 0xe9, 0x05, 0xa2, 0xa7, 0xf3, 0x22, 0x82, 0x07, 0x13, 0x33,
+	0x1c, 0x65, 0xa7, 0xa2, 0x37, 0xbb, 0x00, 0xef, 0x78, 0x30, 0x69, 0x7c, 0xb3, 0x50, 0x79, 0x5a,
+	0x52, 0xbc, 0x86, 0xe6, 0x4d, 0x03, 0xe2, 0x58, 0x9c, 0xd8, 0xd0, 0x91, 0xc2, 0xd8, 0x35, 0x7d,
+	0x24, 0x11, 0x55, 0x60, 0xcf, 0xe5, 0x11, 0xe9, 0x14, 0xe9, 0x50, 0x05, 0x8d, 0xef, 0x73, 0xc8,
+	0x7e, 0x68, 0x76, 0xf0, 0x63, 0xb4, 0x30, 0x00, 0xe6, 0x0f, 0x94, 0x09, 0xab, 0xe8, 0x24, 0x16,
+	0x3e, 0x43, 0x55, 0x11, 0x78, 0x24, 0xfb, 0xbb, 0xcb, 0x23, 0xc6, 0x75, 0x11, 0x78, 0xe9, 0xa8,
+	0xb4, 0x2e, 0x87, 0xf3, 0x07, 0x74, 0x8b, 0x79, 0xe8, 0x72, 0x38, 0xcf, 0xd0, 0xdd, 0x44, 0x95,
+	0x61, 0x24, 0x86, 0x42, 0xd2, 0x80, 0x30, 0xcf, 0x2e, 0xd5, 0xad, 0x66, 0xc9, 0x41, 0xd3, 0xad,
+	0x63, 0xaf, 0xf1, 0xa7, 0x88, 0x70, 0xfa, 0x23, 0xc7, 0x36, 0x5a, 0x04, 0x4e, 0x7b, 0x01, 0x78,
+	0xa6, 0x80, 0x65, 0x67, 0x6a, 0xe2, 0x31, 0xaa, 0x2a, 0x1a, 0xf9, 0xa0, 0x88, 0xfc, 0x32, 0xa2,
+	0x11, 0x90, 0x91, 0x62, 0x01, 0xfb, 0x4a, 0xcd, 0x3c, 0xe6, 0x51, 0xc1, 0x27, 0x31, 0xfe, 0xc4,
+	0xd0, 0x3f, 0xfc, 0x83, 0x63, 0x0f, 0xad, 0x86, 0x74, 0x4c, 0xee, 0x4f, 0x55, 0x1e, 0x95, 0x5b,
+	0x09, 0xe9, 0xf8, 0x60, 0x36, 0x56, 0xba, 0x53, 0xba, 0x3b, 0xd9, 0x9d, 0x2a, 0xe5, 0xd1, 0xa9,
+	0x90, 0xf1, 0xec, 0x09, 0xd1, 0xd9, 0x65, 0xeb, 0xce, 0xe7, 0xa2, 0x4b, 0xc7, 0x69, 0xdd, 0x4e,
+	0xf7, 0xea, 0xb6, 0x66, 0x5d, 0xdf, 0xd6, 0xac, 0xdf, 0xb7, 0x35, 0xeb, 0xe2, 0xae, 0x56, 0xb8,
+	0xbe, 0xab, 0x15, 0x7e, 0xde, 0xd5, 0x0a, 0x9f, 0xb6, 0xef, 0xcb, 0x24, 0xff, 0x89, 0x22, 0xf2,
+	0x67, 0xeb, 0x1d, 0x3a, 0x1c, 0xb6, 0xc7, 0xc9, 0xa3, 0xd9, 0x5b, 0x30, 0xaf, 0xdc, 0x8b, 0xbf,
+	0x03, 0x00, 0x39, 0x4e, 0x85, 0xd9, 0x4e, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -109,48 +401,1043 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.TotalFeesBurned.Size()
+		i -= size
+		if _, err := m.TotalFeesBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.FeeBurn != nil {
+		{
+			size, err := m.FeeBurn.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.NetworkMinGasPriceHistory) > 0 {
+		for iNdEx := len(m.NetworkMinGasPriceHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NetworkMinGasPriceHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.GasPriceAdjustment != nil {
+		{
+			size, err := m.GasPriceAdjustment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size := m.NetworkMinGasPrice.Size()
 		i -= size
 		if _, err := m.NetworkMinGasPrice.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeBurn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeBurn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeBurn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlobFeeOnly {
+		i--
+		if m.BlobFeeOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.BurnFraction.Size()
+		i -= size
+		if _, err := m.BurnFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ExchangeRate.Size()
+		i -= size
+		if _, err := m.ExchangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NetworkMinGasPriceChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NetworkMinGasPriceChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NetworkMinGasPriceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.NewNetworkMinGasPrice.Size()
+		i -= size
+		if _, err := m.NewNetworkMinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.OldNetworkMinGasPrice.Size()
+		i -= size
+		if _, err := m.OldNetworkMinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPriceAdjustment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceAdjustment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceAdjustment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxNetworkMinGasPrice.Size()
+		i -= size
+		if _, err := m.MaxNetworkMinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinNetworkMinGasPrice.Size()
+		i -= size
+		if _, err := m.MinNetworkMinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxChangeRate.Size()
+		i -= size
+		if _, err := m.MaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TargetSquareUtilization.Size()
+		i -= size
+		if _, err := m.TargetSquareUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetworkMinGasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.GasPriceAdjustment != nil {
+		l = m.GasPriceAdjustment.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.NetworkMinGasPriceHistory) > 0 {
+		for _, e := range m.NetworkMinGasPriceHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FeeDenoms) > 0 {
+		for _, e := range m.FeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.FeeBurn != nil {
+		l = m.FeeBurn.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.TotalFeesBurned.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *FeeBurn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BurnFraction.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.BlobFeeOnly {
+		n += 2
+	}
+	return n
+}
+
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.ExchangeRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *NetworkMinGasPriceChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	l = m.OldNetworkMinGasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.NewNetworkMinGasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.ProposalId != 0 {
+		n += 1 + sovGenesis(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *GasPriceAdjustment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = m.TargetSquareUtilization.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxChangeRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MinNetworkMinGasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MaxNetworkMinGasPrice.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkMinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetworkMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceAdjustment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.GasPriceAdjustment == nil {
+				m.GasPriceAdjustment = &GasPriceAdjustment{}
+			}
+			if err := m.GasPriceAdjustment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkMinGasPriceHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkMinGasPriceHistory = append(m.NetworkMinGasPriceHistory, NetworkMinGasPriceChange{})
+			if err := m.NetworkMinGasPriceHistory[len(m.NetworkMinGasPriceHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, FeeDenom{})
+			if err := m.FeeDenoms[len(m.FeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBurn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeBurn == nil {
+				m.FeeBurn = &FeeBurn{}
+			}
+			if err := m.FeeBurn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFeesBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalFeesBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeBurn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeBurn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlobFeeOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BlobFeeOnly = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExchangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExchangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NetworkMinGasPriceChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NetworkMinGasPriceChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NetworkMinGasPriceChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldNetworkMinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OldNetworkMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewNetworkMinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewNetworkMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.NetworkMinGasPrice.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
+func (m *GasPriceAdjustment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -173,15 +1460,35 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			return fmt.Errorf("proto: GasPriceAdjustment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GasPriceAdjustment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkMinGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetSquareUtilization", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -209,7 +1516,109 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetworkMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetSquareUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinNetworkMinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinNetworkMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNetworkMinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxNetworkMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
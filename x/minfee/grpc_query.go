@@ -3,32 +3,94 @@ package minfee
 import (
 	"context"
 
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/params/keeper"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
 var _ QueryServer = &QueryServerImpl{}
 
-// QueryServerImpl wraps the params keeper and implements the minfee gRPC query server.
+// QueryServerImpl wraps the minfee keeper and implements the minfee gRPC query server.
 type QueryServerImpl struct {
-	paramsKeeper keeper.Keeper
+	keeper Keeper
 }
 
 // NewQueryServerImpl creates a new QueryServerImpl.
-func NewQueryServerImpl(paramsKeeper keeper.Keeper) *QueryServerImpl {
-	return &QueryServerImpl{paramsKeeper: paramsKeeper}
+func NewQueryServerImpl(k Keeper) *QueryServerImpl {
+	return &QueryServerImpl{keeper: k}
 }
 
 // NetworkMinGasPrice returns the network minimum gas price.
 func (q *QueryServerImpl) NetworkMinGasPrice(ctx context.Context, _ *QueryNetworkMinGasPrice) (*QueryNetworkMinGasPriceResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	var params Params
-	subspace, found := q.paramsKeeper.GetSubspace(ModuleName)
+	subspace, found := q.keeper.paramsKeeper.GetSubspace(ModuleName)
 	if !found {
 		return nil, status.Errorf(codes.NotFound, "subspace not found for minfee. Minfee is only active in app version 2 and onwards")
 	}
 	subspace.GetParamSet(sdkCtx, &params)
 	return &QueryNetworkMinGasPriceResponse{NetworkMinGasPrice: params.NetworkMinGasPrice}, nil
 }
+
+// GasPriceAdjustment returns the parameters used to automatically adjust the
+// network min gas price.
+func (q *QueryServerImpl) GasPriceAdjustment(ctx context.Context, _ *QueryGasPriceAdjustment) (*QueryGasPriceAdjustmentResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	subspace, found := q.keeper.paramsKeeper.GetSubspace(ModuleName)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "subspace not found for minfee. Minfee is only active in app version 2 and onwards")
+	}
+	gasPriceAdjustment := DefaultGasPriceAdjustment()
+	RegisterMinFeeParamTable(subspace).GetParamSetIfExists(sdkCtx, &gasPriceAdjustment)
+	return &QueryGasPriceAdjustmentResponse{GasPriceAdjustment: gasPriceAdjustment}, nil
+}
+
+// FeeDenoms returns the denoms other than utia that can be used to pay fees.
+func (q *QueryServerImpl) FeeDenoms(ctx context.Context, _ *QueryFeeDenoms) (*QueryFeeDenomsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	subspace, found := q.keeper.paramsKeeper.GetSubspace(ModuleName)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "subspace not found for minfee. Minfee is only active in app version 2 and onwards")
+	}
+	return &QueryFeeDenomsResponse{FeeDenoms: GetFeeDenoms(sdkCtx, subspace)}, nil
+}
+
+// TotalFeesBurned returns the total amount of utia fees that have been burned.
+func (q *QueryServerImpl) TotalFeesBurned(ctx context.Context, _ *QueryTotalFeesBurned) (*QueryTotalFeesBurnedResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !storeMounted(sdkCtx) {
+		return nil, status.Errorf(codes.NotFound, "fee burning is only available in app version 3 and onwards")
+	}
+	total := sdk.NewCoin(appconsts.BondDenom, q.keeper.GetTotalFeesBurned(sdkCtx))
+	return &QueryTotalFeesBurnedResponse{TotalFeesBurned: total}, nil
+}
+
+// NetworkMinGasPriceHistory returns the recorded changes to the network min gas
+// price, oldest first.
+func (q *QueryServerImpl) NetworkMinGasPriceHistory(ctx context.Context, req *QueryNetworkMinGasPriceHistory) (*QueryNetworkMinGasPriceHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !storeMounted(sdkCtx) {
+		return nil, status.Errorf(codes.NotFound, "network min gas price history is only available in app version 3 and onwards")
+	}
+
+	var changes []NetworkMinGasPriceChange
+	historyStore := prefix.NewStore(sdkCtx.KVStore(q.keeper.storeKey), historyKeyPrefix)
+	pageRes, err := query.Paginate(historyStore, req.Pagination, func(_, value []byte) error {
+		var change NetworkMinGasPriceChange
+		if err := q.keeper.cdc.Unmarshal(value, &change); err != nil {
+			return err
+		}
+		changes = append(changes, change)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &QueryNetworkMinGasPriceHistoryResponse{Changes: changes, Pagination: pageRes}, nil
+}
@@ -5,16 +5,20 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v3/app"
 	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
 	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
 	"github.com/celestiaorg/celestia-app/v3/x/minfee"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
 )
 
 func TestQueryNetworkMinGasPrice(t *testing.T) {
 	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
-	queryServer := minfee.NewQueryServerImpl(testApp.ParamsKeeper)
+	queryServer := minfee.NewQueryServerImpl(testApp.MinFeeKeeper)
 
 	sdkCtx := testApp.NewContext(false, tmproto.Header{Height: 1})
 	ctx := sdk.WrapSDKContext(sdkCtx)
@@ -26,3 +30,55 @@ func TestQueryNetworkMinGasPrice(t *testing.T) {
 	// Check the response
 	require.Equal(t, appconsts.DefaultNetworkMinGasPrice, resp.NetworkMinGasPrice.MustFloat64())
 }
+
+func TestQueryGasPriceAdjustment(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	queryServer := minfee.NewQueryServerImpl(testApp.MinFeeKeeper)
+
+	sdkCtx := testApp.NewContext(false, tmproto.Header{Height: 1})
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	resp, err := queryServer.GasPriceAdjustment(ctx, &minfee.QueryGasPriceAdjustment{})
+	require.NoError(t, err)
+	require.Equal(t, minfee.DefaultGasPriceAdjustment(), resp.GasPriceAdjustment)
+
+	gasPriceAdjustment := minfee.DefaultGasPriceAdjustment()
+	gasPriceAdjustment.Enabled = true
+	testApp.MinFeeKeeper.SetGasPriceAdjustment(sdkCtx, gasPriceAdjustment)
+
+	resp, err = queryServer.GasPriceAdjustment(ctx, &minfee.QueryGasPriceAdjustment{})
+	require.NoError(t, err)
+	require.Equal(t, gasPriceAdjustment, resp.GasPriceAdjustment)
+}
+
+func TestQueryNetworkMinGasPriceHistory(t *testing.T) {
+	testApp, _, _ := testutil.NewTestAppWithGenesisSet(app.DefaultConsensusParams())
+	queryServer := minfee.NewQueryServerImpl(testApp.MinFeeKeeper)
+
+	sdkCtx := testApp.NewContext(false, tmproto.Header{Height: 1, Version: version.Consensus{App: v3.Version}})
+	ctx := sdk.WrapSDKContext(sdkCtx)
+
+	changes := []minfee.NetworkMinGasPriceChange{
+		{Height: 2, OldNetworkMinGasPrice: sdk.MustNewDecFromStr("0.1"), NewNetworkMinGasPrice: sdk.MustNewDecFromStr("0.2"), ProposalId: 1},
+		{Height: 5, OldNetworkMinGasPrice: sdk.MustNewDecFromStr("0.2"), NewNetworkMinGasPrice: sdk.MustNewDecFromStr("0.175")},
+		{Height: 6, OldNetworkMinGasPrice: sdk.MustNewDecFromStr("0.175"), NewNetworkMinGasPrice: sdk.MustNewDecFromStr("0.153125")},
+	}
+	for _, change := range changes {
+		testApp.MinFeeKeeper.AppendNetworkMinGasPriceChange(sdkCtx, change)
+	}
+
+	resp, err := queryServer.NetworkMinGasPriceHistory(ctx, &minfee.QueryNetworkMinGasPriceHistory{})
+	require.NoError(t, err)
+	require.Equal(t, changes, resp.Changes)
+
+	resp, err = queryServer.NetworkMinGasPriceHistory(ctx, &minfee.QueryNetworkMinGasPriceHistory{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, changes[:2], resp.Changes)
+
+	resp, err = queryServer.NetworkMinGasPriceHistory(ctx, &minfee.QueryNetworkMinGasPriceHistory{Pagination: &query.PageRequest{Key: resp.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Equal(t, changes[2:], resp.Changes)
+
+	_, err = queryServer.NetworkMinGasPriceHistory(sdk.WrapSDKContext(sdkCtx.WithBlockHeader(tmproto.Header{Height: 1, Version: version.Consensus{App: v2.Version}})), &minfee.QueryNetworkMinGasPriceHistory{})
+	require.Error(t, err)
+}
@@ -0,0 +1,39 @@
+package minfee
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ govtypes.GovHooks = Hooks{}
+
+// Hooks records changes to the network min gas price that were made by
+// governance proposals, either when the proposal is executed or when a change
+// that was delayed by its param policy takes effect.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the governance hooks of the minfee module.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterProposalVotingPeriodEnded is called after the messages of a passed
+// proposal have been executed.
+func (h Hooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {
+	h.k.RecordNetworkMinGasPriceChange(ctx, proposalID)
+}
+
+func (h Hooks) AfterProposalSubmission(sdk.Context, uint64)              {}
+func (h Hooks) AfterProposalDeposit(sdk.Context, uint64, sdk.AccAddress) {}
+func (h Hooks) AfterProposalVote(sdk.Context, uint64, sdk.AccAddress)    {}
+func (h Hooks) AfterProposalFailedMinDeposit(sdk.Context, uint64)        {}
+
+// AfterPendingChangeApplied is called after a parameter change that was
+// delayed by its param policy has taken effect.
+func (h Hooks) AfterPendingChangeApplied(ctx sdk.Context, subspace, key string, proposalID uint64) {
+	if subspace == ModuleName && key == string(KeyNetworkMinGasPrice) {
+		h.k.RecordNetworkMinGasPriceChange(ctx, proposalID)
+	}
+}
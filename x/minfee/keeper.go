@@ -0,0 +1,402 @@
+package minfee
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	params "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var (
+	// txBytesKey is the transient store key for the total number of tx bytes
+	// included in the current block.
+	txBytesKey = []byte{0x01}
+	// blobSharesKey is the transient store key for the total number of
+	// shares occupied by blobs in the current block.
+	blobSharesKey = []byte{0x02}
+
+	// historySequenceKey is the store key for the number of recorded network
+	// min gas price changes.
+	historySequenceKey = []byte{0x00}
+	// historyKeyPrefix is the store key prefix for the network min gas price
+	// changes. Changes are keyed by their big endian encoded sequence number
+	// so that they are iterated in the order in which they occurred.
+	historyKeyPrefix = []byte{0x01}
+	// totalFeesBurnedKey is the store key for the total amount of utia fees
+	// that have been burned.
+	totalFeesBurnedKey = []byte{0x02}
+	// recordedNetworkMinGasPriceKey is the store key for the network min gas
+	// price that the next change is recorded against.
+	recordedNetworkMinGasPriceKey = []byte{0x03}
+)
+
+// BlobKeeper defines the expected blob keeper.
+type BlobKeeper interface {
+	GovMaxSquareSize(ctx sdk.Context) uint64
+	GasPerBlobByte(ctx sdk.Context) uint32
+}
+
+// BankKeeper defines the expected bank keeper.
+type BankKeeper interface {
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// Keeper tracks how full the data square of the current block is and, if
+// enabled, adjusts the network min gas price at the end of every block. From
+// app version 3 onwards it also records every change to the network min gas
+// price and burns the governance set fraction of PayForBlobs fees.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeKey     storetypes.StoreKey
+	tStoreKey    storetypes.StoreKey
+	paramsKeeper params.Keeper
+	bankKeeper   BankKeeper
+	blobKeeper   BlobKeeper
+}
+
+// NewKeeper creates a new minfee Keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	tStoreKey storetypes.StoreKey,
+	paramsKeeper params.Keeper,
+	bankKeeper BankKeeper,
+	blobKeeper BlobKeeper,
+) Keeper {
+	return Keeper{
+		cdc:          cdc,
+		storeKey:     storeKey,
+		tStoreKey:    tStoreKey,
+		paramsKeeper: paramsKeeper,
+		bankKeeper:   bankKeeper,
+		blobKeeper:   blobKeeper,
+	}
+}
+
+func (k Keeper) subspace() paramtypes.Subspace {
+	subspace, exists := k.paramsKeeper.GetSubspace(ModuleName)
+	if !exists {
+		panic("minfee subspace not set")
+	}
+	return RegisterMinFeeParamTable(subspace)
+}
+
+// GetNetworkMinGasPrice returns the current network min gas price.
+func (k Keeper) GetNetworkMinGasPrice(ctx sdk.Context) sdk.Dec {
+	var networkMinGasPrice sdk.Dec
+	k.subspace().Get(ctx, KeyNetworkMinGasPrice, &networkMinGasPrice)
+	return networkMinGasPrice
+}
+
+// SetNetworkMinGasPrice sets the network min gas price.
+func (k Keeper) SetNetworkMinGasPrice(ctx sdk.Context, networkMinGasPrice sdk.Dec) {
+	k.subspace().Set(ctx, KeyNetworkMinGasPrice, networkMinGasPrice)
+}
+
+// GetGasPriceAdjustment returns the gas price adjustment parameters. Any
+// parameter that has not been set takes its default value.
+func (k Keeper) GetGasPriceAdjustment(ctx sdk.Context) GasPriceAdjustment {
+	gasPriceAdjustment := DefaultGasPriceAdjustment()
+	k.subspace().GetParamSetIfExists(ctx, &gasPriceAdjustment)
+	return gasPriceAdjustment
+}
+
+// SetGasPriceAdjustment sets the gas price adjustment parameters.
+func (k Keeper) SetGasPriceAdjustment(ctx sdk.Context, gasPriceAdjustment GasPriceAdjustment) {
+	k.subspace().SetParamSet(ctx, &gasPriceAdjustment)
+}
+
+// GetFeeDenoms returns the allowlist of denoms other than the bond denom that
+// can be used to pay fees.
+func (k Keeper) GetFeeDenoms(ctx sdk.Context) []FeeDenom {
+	return GetFeeDenoms(ctx, k.subspace())
+}
+
+// SetFeeDenoms sets the allowlist of denoms other than the bond denom that can
+// be used to pay fees.
+func (k Keeper) SetFeeDenoms(ctx sdk.Context, feeDenoms []FeeDenom) {
+	k.subspace().Set(ctx, KeyFeeDenoms, feeDenoms)
+}
+
+// GetFeeBurn returns the fee burn parameters. Any parameter that has not been
+// set takes its default value.
+func (k Keeper) GetFeeBurn(ctx sdk.Context) FeeBurn {
+	feeBurn := DefaultFeeBurn()
+	k.subspace().GetParamSetIfExists(ctx, &feeBurn)
+	return feeBurn
+}
+
+// SetFeeBurn sets the fee burn parameters.
+func (k Keeper) SetFeeBurn(ctx sdk.Context, feeBurn FeeBurn) {
+	k.subspace().SetParamSet(ctx, &feeBurn)
+}
+
+// GetTotalFeesBurned returns the total amount of utia fees that have been
+// burned.
+func (k Keeper) GetTotalFeesBurned(ctx sdk.Context) math.Int {
+	bz := ctx.KVStore(k.storeKey).Get(totalFeesBurnedKey)
+	if bz == nil {
+		return math.ZeroInt()
+	}
+	var total math.Int
+	if err := total.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return total
+}
+
+// SetTotalFeesBurned sets the total amount of utia fees that have been burned.
+func (k Keeper) SetTotalFeesBurned(ctx sdk.Context, total math.Int) {
+	bz, err := total.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(totalFeesBurnedKey, bz)
+}
+
+// BurnFees burns the governance set fraction of the utia fee of a
+// PayForBlobs transaction that pays for blobs of blobSizes. The fee must
+// already have been deducted to the fee collector, which keeps the rest. If
+// BlobFeeOnly is set, the fraction only applies to the portion of the fee that
+// pays for the gas consumed by the blob bytes. Fees paid in other denoms are
+// never burned.
+func (k Keeper) BurnFees(ctx sdk.Context, feeTx sdk.FeeTx, blobSizes []uint32) error {
+	feeBurn := k.GetFeeBurn(ctx)
+	if feeBurn.BurnFraction.IsNil() || feeBurn.BurnFraction.IsZero() {
+		return nil
+	}
+
+	fee := feeTx.GetFee().AmountOf(appconsts.BondDenom)
+	gas := feeTx.GetGas()
+	if feeBurn.BlobFeeOnly {
+		if gas == 0 {
+			return nil
+		}
+		blobGas := blobtypes.GasToConsume(blobSizes, k.gasPerBlobByte(ctx))
+		if blobGas > gas {
+			blobGas = gas
+		}
+		fee = fee.Mul(math.NewIntFromUint64(blobGas)).Quo(math.NewIntFromUint64(gas))
+	}
+	amount := feeBurn.BurnFraction.MulInt(fee).TruncateInt()
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(appconsts.BondDenom, amount))
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, ModuleName, coins); err != nil {
+		return err
+	}
+	total := k.GetTotalFeesBurned(ctx).Add(amount)
+	k.SetTotalFeesBurned(ctx, total)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeFeeBurned,
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coins.String()),
+			sdk.NewAttribute(AttributeKeyTotalFeesBurned, total.String()),
+		),
+	)
+	return nil
+}
+
+// RecordSquareUsage adds the shares occupied by a transaction of txSize bytes
+// paying for blobs of blobSizes to the usage of the current block.
+func (k Keeper) RecordSquareUsage(ctx sdk.Context, txSize int, blobSizes []uint32) {
+	store := ctx.TransientStore(k.tStoreKey)
+	blobShares := uint64(0)
+	for _, blobSize := range blobSizes {
+		blobShares += uint64(share.SparseSharesNeeded(blobSize))
+	}
+	incrementCounter(store, txBytesKey, uint64(txSize))
+	incrementCounter(store, blobSharesKey, blobShares)
+}
+
+// SquareUsage returns the number of shares occupied by the transactions and
+// blobs of the current block so far.
+func (k Keeper) SquareUsage(ctx sdk.Context) uint64 {
+	store := ctx.TransientStore(k.tStoreKey)
+	txShares := uint64(share.CompactSharesNeeded(uint32(getCounter(store, txBytesKey))))
+	return txShares + getCounter(store, blobSharesKey)
+}
+
+// AdjustNetworkMinGasPrice adjusts the network min gas price based on how full
+// the data square of the current block was relative to the target square
+// utilization. It is a no-op if gas price adjustment is disabled.
+//
+// The adjustment follows EIP-1559:
+//
+//	newPrice = price * (1 + maxChangeRate * (utilization - target) / target)
+//
+// clamped to the governance set min and max network min gas price.
+func (k Keeper) AdjustNetworkMinGasPrice(ctx sdk.Context) {
+	gasPriceAdjustment := k.GetGasPriceAdjustment(ctx)
+	if !gasPriceAdjustment.Enabled {
+		return
+	}
+	if err := gasPriceAdjustment.Validate(); err != nil {
+		ctx.Logger().Error("skipping network min gas price adjustment", "err", err)
+		return
+	}
+
+	squareSize := uint64(k.maxSquareSize(ctx))
+	utilization := sdk.NewDec(int64(k.SquareUsage(ctx))).QuoInt64(int64(squareSize * squareSize))
+	if utilization.GT(sdk.OneDec()) {
+		utilization = sdk.OneDec()
+	}
+	target := gasPriceAdjustment.TargetSquareUtilization
+
+	oldPrice := k.GetNetworkMinGasPrice(ctx)
+	change := oldPrice.Mul(gasPriceAdjustment.MaxChangeRate).Mul(utilization.Sub(target)).Quo(target)
+	newPrice := oldPrice.Add(change)
+	if newPrice.LT(gasPriceAdjustment.MinNetworkMinGasPrice) {
+		newPrice = gasPriceAdjustment.MinNetworkMinGasPrice
+	}
+	if newPrice.GT(gasPriceAdjustment.MaxNetworkMinGasPrice) {
+		newPrice = gasPriceAdjustment.MaxNetworkMinGasPrice
+	}
+	if newPrice.Equal(oldPrice) {
+		return
+	}
+
+	k.SetNetworkMinGasPrice(ctx, newPrice)
+	k.RecordNetworkMinGasPriceChange(ctx, 0)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeNetworkMinGasPriceAdjusted,
+			sdk.NewAttribute(AttributeKeyOldNetworkMinGasPrice, oldPrice.String()),
+			sdk.NewAttribute(AttributeKeyNewNetworkMinGasPrice, newPrice.String()),
+			sdk.NewAttribute(AttributeKeySquareUtilization, utilization.String()),
+		),
+	)
+}
+
+// maxSquareSize returns the max effective square size of the current block.
+func (k Keeper) maxSquareSize(ctx sdk.Context) int {
+	// The gov param is not initialized before the first block so the default
+	// is used. See App.MaxEffectiveSquareSize.
+	if ctx.BlockHeader().Height <= 1 || k.blobKeeper == nil {
+		return int(appconsts.DefaultGovMaxSquareSize)
+	}
+	upperBound := appconsts.SquareSizeUpperBound(ctx.BlockHeader().Version.App)
+	return min(upperBound, int(k.blobKeeper.GovMaxSquareSize(ctx)))
+}
+
+// storeMounted returns true if the minfee store is mounted, which is the case
+// from app version 3 onwards.
+func storeMounted(ctx sdk.Context) bool {
+	return ctx.BlockHeader().Version.App > v2.Version
+}
+
+// InitRecordedNetworkMinGasPrice stores the current network min gas price as
+// the price that the next change is recorded against unless one is already
+// stored. It is called at genesis and at the start of every block so that the
+// price is stored before any change can be made from app version 3 onwards.
+func (k Keeper) InitRecordedNetworkMinGasPrice(ctx sdk.Context) {
+	if ctx.KVStore(k.storeKey).Has(recordedNetworkMinGasPriceKey) {
+		return
+	}
+	k.setRecordedNetworkMinGasPrice(ctx, k.GetNetworkMinGasPrice(ctx))
+}
+
+func (k Keeper) setRecordedNetworkMinGasPrice(ctx sdk.Context, price sdk.Dec) {
+	bz, err := price.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(recordedNetworkMinGasPriceKey, bz)
+}
+
+// RecordNetworkMinGasPriceChange appends an entry to the network min gas price
+// history if the network min gas price differs from the price the last change
+// was recorded against. The proposalID is the id of the governance proposal
+// that made the change or 0 if the change was made by the automatic gas price
+// adjustment. It is a no-op before app version 3.
+func (k Keeper) RecordNetworkMinGasPriceChange(ctx sdk.Context, proposalID uint64) {
+	if !storeMounted(ctx) {
+		return
+	}
+
+	bz := ctx.KVStore(k.storeKey).Get(recordedNetworkMinGasPriceKey)
+	if bz == nil {
+		// InitRecordedNetworkMinGasPrice runs at genesis and at the start of
+		// every block, before any change can be made, so this is unreachable.
+		panic(fmt.Sprintf("no network min gas price to record the change of proposal %d against", proposalID))
+	}
+	var oldPrice sdk.Dec
+	if err := oldPrice.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	newPrice := k.GetNetworkMinGasPrice(ctx)
+	if newPrice.Equal(oldPrice) {
+		return
+	}
+
+	k.AppendNetworkMinGasPriceChange(ctx, NetworkMinGasPriceChange{
+		Height:                ctx.BlockHeight(),
+		OldNetworkMinGasPrice: oldPrice,
+		NewNetworkMinGasPrice: newPrice,
+		ProposalId:            proposalID,
+	})
+	k.setRecordedNetworkMinGasPrice(ctx, newPrice)
+}
+
+// AppendNetworkMinGasPriceChange appends change to the network min gas price
+// history.
+func (k Keeper) AppendNetworkMinGasPriceChange(ctx sdk.Context, change NetworkMinGasPriceChange) {
+	store := ctx.KVStore(k.storeKey)
+	sequence := getCounter(store, historySequenceKey)
+	historyStore := prefix.NewStore(store, historyKeyPrefix)
+	historyStore.Set(binary.BigEndian.AppendUint64(nil, sequence), k.cdc.MustMarshal(&change))
+	incrementCounter(store, historySequenceKey, 1)
+}
+
+// GetNetworkMinGasPriceHistory returns every recorded change to the network
+// min gas price, oldest first.
+func (k Keeper) GetNetworkMinGasPriceHistory(ctx sdk.Context) []NetworkMinGasPriceChange {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), historyKeyPrefix)
+	defer iterator.Close()
+
+	history := []NetworkMinGasPriceChange{}
+	for ; iterator.Valid(); iterator.Next() {
+		var change NetworkMinGasPriceChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		history = append(history, change)
+	}
+	return history
+}
+
+// gasPerBlobByte returns the gas consumed per blob byte.
+func (k Keeper) gasPerBlobByte(ctx sdk.Context) uint32 {
+	if k.blobKeeper == nil {
+		return appconsts.DefaultGasPerBlobByte
+	}
+	return k.blobKeeper.GasPerBlobByte(ctx)
+}
+
+func getCounter(store sdk.KVStore, key []byte) uint64 {
+	bz := store.Get(key)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+func incrementCounter(store sdk.KVStore, key []byte, amount uint64) {
+	store.Set(key, binary.BigEndian.AppendUint64(nil, getCounter(store, key)+amount))
+}
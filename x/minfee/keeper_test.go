@@ -0,0 +1,183 @@
+package minfee_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	"github.com/celestiaorg/celestia-app/v3/x/minfee"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+func TestAdjustNetworkMinGasPrice(t *testing.T) {
+	squareSize := int(appconsts.DefaultGovMaxSquareSize)
+	// blobSize returns the size of a blob that occupies exactly n shares.
+	blobSize := func(n int) uint32 {
+		return uint32(share.FirstSparseShareContentSize + (n-1)*share.ContinuationSparseShareContentSize)
+	}
+	fullSquareBlob := blobSize(squareSize * squareSize)
+	halfSquareBlob := blobSize(squareSize * squareSize / 2)
+	initialPrice := sdk.MustNewDecFromStr("0.1")
+
+	enabled := minfee.DefaultGasPriceAdjustment()
+	enabled.Enabled = true
+	enabled.MinNetworkMinGasPrice = sdk.MustNewDecFromStr("0.01")
+	enabled.MaxNetworkMinGasPrice = sdk.MustNewDecFromStr("0.105")
+
+	testCases := []struct {
+		name               string
+		gasPriceAdjustment minfee.GasPriceAdjustment
+		blobSizes          []uint32
+		wantPrice          sdk.Dec
+	}{
+		{
+			name:               "no-op if disabled",
+			gasPriceAdjustment: minfee.DefaultGasPriceAdjustment(),
+			blobSizes:          []uint32{fullSquareBlob},
+			wantPrice:          initialPrice,
+		},
+		{
+			name:               "decreases if the square is empty",
+			gasPriceAdjustment: enabled,
+			blobSizes:          nil,
+			wantPrice:          sdk.MustNewDecFromStr("0.0875"),
+		},
+		{
+			name:               "unchanged if the square is at the target",
+			gasPriceAdjustment: enabled,
+			blobSizes:          []uint32{halfSquareBlob},
+			wantPrice:          initialPrice,
+		},
+		{
+			name:               "increases up to the max if the square is full",
+			gasPriceAdjustment: enabled,
+			blobSizes:          []uint32{fullSquareBlob},
+			wantPrice:          sdk.MustNewDecFromStr("0.105"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			k, ctx := setupKeeper(t)
+			k.SetNetworkMinGasPrice(ctx, initialPrice)
+			k.InitRecordedNetworkMinGasPrice(ctx)
+			k.SetGasPriceAdjustment(ctx, tc.gasPriceAdjustment)
+			k.RecordSquareUsage(ctx, 0, tc.blobSizes)
+
+			k.AdjustNetworkMinGasPrice(ctx)
+
+			require.Equal(t, tc.wantPrice, k.GetNetworkMinGasPrice(ctx))
+		})
+	}
+}
+
+func TestAdjustNetworkMinGasPriceRespectsMin(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	gasPriceAdjustment := minfee.DefaultGasPriceAdjustment()
+	gasPriceAdjustment.Enabled = true
+	gasPriceAdjustment.MinNetworkMinGasPrice = sdk.MustNewDecFromStr("0.09")
+	gasPriceAdjustment.MaxNetworkMinGasPrice = sdk.OneDec()
+	k.SetGasPriceAdjustment(ctx, gasPriceAdjustment)
+	k.SetNetworkMinGasPrice(ctx, sdk.MustNewDecFromStr("0.1"))
+	k.InitRecordedNetworkMinGasPrice(ctx)
+
+	for i := 0; i < 10; i++ {
+		k.AdjustNetworkMinGasPrice(ctx)
+	}
+
+	require.Equal(t, sdk.MustNewDecFromStr("0.09"), k.GetNetworkMinGasPrice(ctx))
+}
+
+func TestRecordSquareUsage(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	require.Zero(t, k.SquareUsage(ctx))
+
+	k.RecordSquareUsage(ctx, 100, []uint32{1, share.ContinuationSparseShareContentSize * 2})
+	k.RecordSquareUsage(ctx, 100, nil)
+
+	// 200 tx bytes fit in one compact share and the blobs occupy 1 + 3 shares.
+	require.Equal(t, uint64(5), k.SquareUsage(ctx))
+}
+
+func TestNetworkMinGasPriceHistory(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	gasPriceAdjustment := minfee.DefaultGasPriceAdjustment()
+	gasPriceAdjustment.Enabled = true
+	gasPriceAdjustment.MaxNetworkMinGasPrice = sdk.OneDec()
+	k.SetGasPriceAdjustment(ctx, gasPriceAdjustment)
+	k.SetNetworkMinGasPrice(ctx, sdk.MustNewDecFromStr("0.1"))
+	k.InitRecordedNetworkMinGasPrice(ctx)
+
+	// A governance proposal changes the price.
+	k.SetNetworkMinGasPrice(ctx, sdk.MustNewDecFromStr("0.2"))
+	k.Hooks().AfterProposalVotingPeriodEnded(ctx, 7)
+	// A proposal that doesn't change the price is not recorded.
+	k.Hooks().AfterProposalVotingPeriodEnded(ctx, 8)
+	// The empty square lowers the price.
+	k.AdjustNetworkMinGasPrice(ctx)
+
+	want := []minfee.NetworkMinGasPriceChange{
+		{
+			Height:                ctx.BlockHeight(),
+			OldNetworkMinGasPrice: sdk.MustNewDecFromStr("0.1"),
+			NewNetworkMinGasPrice: sdk.MustNewDecFromStr("0.2"),
+			ProposalId:            7,
+		},
+		{
+			Height:                ctx.BlockHeight(),
+			OldNetworkMinGasPrice: sdk.MustNewDecFromStr("0.2"),
+			NewNetworkMinGasPrice: sdk.MustNewDecFromStr("0.175"),
+			ProposalId:            0,
+		},
+	}
+	require.Equal(t, want, k.GetNetworkMinGasPriceHistory(ctx))
+}
+
+func TestNetworkMinGasPriceHistoryAfterGenesis(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	minfee.NewAppModule(k).InitGenesis(ctx, cdc, cdc.MustMarshalJSON(minfee.DefaultGenesis()))
+
+	// A governance proposal changes the price before any begin blocker ran.
+	k.SetNetworkMinGasPrice(ctx, sdk.MustNewDecFromStr("0.2"))
+	k.Hooks().AfterProposalVotingPeriodEnded(ctx, 1)
+
+	want := []minfee.NetworkMinGasPriceChange{
+		{
+			Height:                ctx.BlockHeight(),
+			OldNetworkMinGasPrice: minfee.DefaultNetworkMinGasPrice,
+			NewNetworkMinGasPrice: sdk.MustNewDecFromStr("0.2"),
+			ProposalId:            1,
+		},
+	}
+	require.Equal(t, want, k.GetNetworkMinGasPriceHistory(ctx))
+}
+
+func TestNetworkMinGasPriceHistoryNotRecordedBeforeV3(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	ctx = ctx.WithBlockHeader(tmproto.Header{Height: 1, Version: version.Consensus{App: v2.Version}})
+	k.SetNetworkMinGasPrice(ctx, sdk.MustNewDecFromStr("0.1"))
+	k.InitRecordedNetworkMinGasPrice(ctx)
+
+	k.SetNetworkMinGasPrice(ctx, sdk.MustNewDecFromStr("0.2"))
+	k.Hooks().AfterProposalVotingPeriodEnded(ctx, 1)
+
+	require.Empty(t, k.GetNetworkMinGasPriceHistory(ctx))
+}
+
+func TestRecordNetworkMinGasPriceChangePanicsWithoutRecordedPrice(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	k.SetNetworkMinGasPrice(ctx, sdk.MustNewDecFromStr("0.2"))
+
+	require.Panics(t, func() { k.RecordNetworkMinGasPriceChange(ctx, 1) })
+}
+
+func setupKeeper(t *testing.T) (minfee.Keeper, sdk.Context) {
+	return setupKeeperWithBank(t, nil, nil)
+}
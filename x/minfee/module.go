@@ -1,6 +1,7 @@
 package minfee
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -15,7 +16,8 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkmodule "github.com/cosmos/cosmos-sdk/types/module"
-	params "github.com/cosmos/cosmos-sdk/x/params/keeper"
+
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
 )
 
 var (
@@ -55,7 +57,11 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingCo
 func (AppModuleBasic) RegisterRESTRoutes(_ client.Context, _ *mux.Router) {}
 
 // RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the module.
-func (AppModuleBasic) RegisterGRPCGatewayRoutes(_ client.Context, _ *runtime.ServeMux) {}
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
 
 // GetTxCmd returns the minfee module's root tx command.
 func (a AppModuleBasic) GetTxCmd() *cobra.Command {
@@ -63,22 +69,21 @@ func (a AppModuleBasic) GetTxCmd() *cobra.Command {
 	return &cobra.Command{}
 }
 
-// GetQueryCmd returns the a dummy command.
+// GetQueryCmd returns the minfee module's root query command.
 func (AppModuleBasic) GetQueryCmd() *cobra.Command {
-	// Return a dummy command
-	return &cobra.Command{}
+	return GetQueryCmd()
 }
 
 // AppModule implements an application module for the minfee module.
 type AppModule struct {
 	AppModuleBasic
-	paramsKeeper params.Keeper
+	keeper Keeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(k params.Keeper) AppModule {
+func NewAppModule(k Keeper) AppModule {
 	// Register the parameter key table in its associated subspace.
-	subspace, exists := k.GetSubspace(ModuleName)
+	subspace, exists := k.paramsKeeper.GetSubspace(ModuleName)
 	if !exists {
 		panic("minfee subspace not set")
 	}
@@ -86,7 +91,7 @@ func NewAppModule(k params.Keeper) AppModule {
 
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
-		paramsKeeper:   k,
+		keeper:         k,
 	}
 }
 
@@ -110,7 +115,7 @@ func (am AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier {
 
 // RegisterServices registers module services.
 func (am AppModule) RegisterServices(cfg sdkmodule.Configurator) {
-	RegisterQueryServer(cfg.QueryServer(), NewQueryServerImpl(am.paramsKeeper))
+	RegisterQueryServer(cfg.QueryServer(), NewQueryServerImpl(am.keeper))
 }
 
 // InitGenesis performs genesis initialization for the minfee module. It returns no validator updates.
@@ -118,7 +123,7 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.Ra
 	var genesisState GenesisState
 	cdc.MustUnmarshalJSON(gs, &genesisState)
 
-	subspace, exists := am.paramsKeeper.GetSubspace(ModuleName)
+	subspace, exists := am.keeper.paramsKeeper.GetSubspace(ModuleName)
 	if !exists {
 		panic("minfee subspace not set")
 	}
@@ -133,20 +138,62 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.Ra
 
 	subspace.SetParamSet(ctx, &Params{NetworkMinGasPrice: networkMinGasPriceDec})
 
+	// The gas price adjustment params, fee denoms and fee burn params are only
+	// written if they are provided so that the state of existing networks is
+	// unchanged.
+	if genesisState.GasPriceAdjustment != nil {
+		am.keeper.SetGasPriceAdjustment(ctx, *genesisState.GasPriceAdjustment)
+	}
+	if len(genesisState.FeeDenoms) > 0 {
+		am.keeper.SetFeeDenoms(ctx, genesisState.FeeDenoms)
+	}
+	if genesisState.FeeBurn != nil {
+		am.keeper.SetFeeBurn(ctx, *genesisState.FeeBurn)
+	}
+
+	if len(genesisState.NetworkMinGasPriceHistory) > 0 {
+		if !storeMounted(ctx) {
+			panic("network min gas price history is only supported from app version 3 onwards")
+		}
+		for _, change := range genesisState.NetworkMinGasPriceHistory {
+			am.keeper.AppendNetworkMinGasPriceChange(ctx, change)
+		}
+	}
+	if !genesisState.TotalFeesBurned.IsNil() && genesisState.TotalFeesBurned.IsPositive() {
+		if !storeMounted(ctx) {
+			panic("total fees burned is only supported from app version 3 onwards")
+		}
+		am.keeper.SetTotalFeesBurned(ctx, genesisState.TotalFeesBurned)
+	}
+	if storeMounted(ctx) {
+		am.keeper.InitRecordedNetworkMinGasPrice(ctx)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns the exported genesis state as raw bytes for the minfee module.
 func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	gs := ExportGenesis(ctx, am.paramsKeeper)
+	gs := ExportGenesis(ctx, am.keeper)
 	return cdc.MustMarshalJSON(gs)
 }
 
-// BeginBlock returns the begin blocker for the minfee module.
-func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+// BeginBlock returns the begin blocker for the minfee module. For app versions
+// > 2 it stores the network min gas price that changes are recorded against
+// if it isn't stored yet, i.e. in the first block after the upgrade to v3.
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	if ctx.BlockHeader().Version.App > v2.Version {
+		am.keeper.InitRecordedNetworkMinGasPrice(ctx)
+	}
+}
 
-// EndBlock returns the end blocker for the minfee module. It returns no validator updates.
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+// EndBlock returns the end blocker for the minfee module. It adjusts the
+// network min gas price for app versions > 2 if gas price adjustment is
+// enabled. It returns no validator updates.
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	if ctx.BlockHeader().Version.App > v2.Version {
+		am.keeper.AdjustNetworkMinGasPrice(ctx)
+	}
 	return []abci.ValidatorUpdate{}
 }
 
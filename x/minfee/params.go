@@ -8,13 +8,43 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
-const ModuleName = "minfee"
+const (
+	ModuleName = "minfee"
 
-var _ paramtypes.ParamSet = (*Params)(nil)
+	// StoreKey is the store key used to persist the network min gas price
+	// history. It is only mounted from app version 3 onwards.
+	StoreKey = ModuleName
+
+	// TStoreKey is the transient store key used to track the data square
+	// usage of the current block.
+	TStoreKey = "transient_" + ModuleName
+)
+
+var (
+	_ paramtypes.ParamSet = (*Params)(nil)
+	_ paramtypes.ParamSet = (*GasPriceAdjustment)(nil)
+	_ paramtypes.ParamSet = (*FeeBurn)(nil)
+)
 
 var (
 	KeyNetworkMinGasPrice     = []byte("NetworkMinGasPrice")
 	DefaultNetworkMinGasPrice sdk.Dec
+
+	KeyGasPriceAdjustmentEnabled = []byte("GasPriceAdjustmentEnabled")
+	KeyTargetSquareUtilization   = []byte("TargetSquareUtilization")
+	KeyMaxChangeRate             = []byte("MaxChangeRate")
+	KeyMinNetworkMinGasPrice     = []byte("MinNetworkMinGasPrice")
+	KeyMaxNetworkMinGasPrice     = []byte("MaxNetworkMinGasPrice")
+
+	DefaultTargetSquareUtilization = sdk.NewDecWithPrec(5, 1)   // 0.5
+	DefaultMaxChangeRate           = sdk.NewDecWithPrec(125, 3) // 0.125
+	// DefaultMaxNetworkMinGasPrice is 1000 times the default network min gas price.
+	DefaultMaxNetworkMinGasPrice sdk.Dec
+
+	KeyFeeDenoms = []byte("FeeDenoms")
+
+	KeyFeeBurnFraction = []byte("FeeBurnFraction")
+	KeyBurnBlobFeeOnly = []byte("BurnBlobFeeOnly")
 )
 
 func init() {
@@ -23,6 +53,7 @@ func init() {
 		panic(err)
 	}
 	DefaultNetworkMinGasPrice = DefaultNetworkMinGasPriceDec
+	DefaultMaxNetworkMinGasPrice = DefaultNetworkMinGasPrice.MulInt64(1000)
 }
 
 type Params struct {
@@ -39,7 +70,11 @@ func RegisterMinFeeParamTable(subspace paramtypes.Subspace) paramtypes.Subspace
 
 // ParamKeyTable returns the param key table for the minfee module.
 func ParamKeyTable() paramtypes.KeyTable {
-	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+	return paramtypes.NewKeyTable().
+		RegisterParamSet(&Params{}).
+		RegisterParamSet(&GasPriceAdjustment{}).
+		RegisterParamSet(&FeeBurn{}).
+		RegisterType(paramtypes.NewParamSetPair(KeyFeeDenoms, []FeeDenom{}, ValidateFeeDenoms))
 }
 
 // ParamSetPairs gets the param key-value pair
@@ -58,3 +93,146 @@ func ValidateMinGasPrice(i interface{}) error {
 
 	return nil
 }
+
+// DefaultGasPriceAdjustment returns the gas price adjustment parameters that
+// apply if none have been set. Adjustment is disabled by default.
+func DefaultGasPriceAdjustment() GasPriceAdjustment {
+	return GasPriceAdjustment{
+		Enabled:                 false,
+		TargetSquareUtilization: DefaultTargetSquareUtilization,
+		MaxChangeRate:           DefaultMaxChangeRate,
+		MinNetworkMinGasPrice:   DefaultNetworkMinGasPrice,
+		MaxNetworkMinGasPrice:   DefaultMaxNetworkMinGasPrice,
+	}
+}
+
+// ParamSetPairs gets the param key-value pairs of the gas price adjustment.
+func (p *GasPriceAdjustment) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyGasPriceAdjustmentEnabled, &p.Enabled, validateBool),
+		paramtypes.NewParamSetPair(KeyTargetSquareUtilization, &p.TargetSquareUtilization, validateTargetSquareUtilization),
+		paramtypes.NewParamSetPair(KeyMaxChangeRate, &p.MaxChangeRate, validateMaxChangeRate),
+		paramtypes.NewParamSetPair(KeyMinNetworkMinGasPrice, &p.MinNetworkMinGasPrice, validatePositiveDec),
+		paramtypes.NewParamSetPair(KeyMaxNetworkMinGasPrice, &p.MaxNetworkMinGasPrice, validatePositiveDec),
+	}
+}
+
+// Validate performs basic validation of the gas price adjustment parameters.
+func (p GasPriceAdjustment) Validate() error {
+	if err := validateTargetSquareUtilization(p.TargetSquareUtilization); err != nil {
+		return err
+	}
+	if err := validateMaxChangeRate(p.MaxChangeRate); err != nil {
+		return err
+	}
+	if err := validatePositiveDec(p.MinNetworkMinGasPrice); err != nil {
+		return err
+	}
+	if err := validatePositiveDec(p.MaxNetworkMinGasPrice); err != nil {
+		return err
+	}
+	if p.MinNetworkMinGasPrice.GT(p.MaxNetworkMinGasPrice) {
+		return fmt.Errorf("min network min gas price %s cannot be greater than max network min gas price %s", p.MinNetworkMinGasPrice, p.MaxNetworkMinGasPrice)
+	}
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateTargetSquareUtilization(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || !v.IsPositive() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("target square utilization must be in (0, 1]: %s", v)
+	}
+	return nil
+}
+
+func validateMaxChangeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max change rate must be in [0, 1]: %s", v)
+	}
+	return nil
+}
+
+func validatePositiveDec(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || !v.IsPositive() {
+		return fmt.Errorf("value must be positive: %s", v)
+	}
+	return nil
+}
+
+// DefaultFeeBurn returns the fee burn parameters that apply if none have been
+// set. No fees are burned by default.
+func DefaultFeeBurn() FeeBurn {
+	return FeeBurn{
+		BurnFraction: sdk.ZeroDec(),
+		BlobFeeOnly:  false,
+	}
+}
+
+// ParamSetPairs gets the param key-value pairs of the fee burn.
+func (p *FeeBurn) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFeeBurnFraction, &p.BurnFraction, validateFraction),
+		paramtypes.NewParamSetPair(KeyBurnBlobFeeOnly, &p.BlobFeeOnly, validateBool),
+	}
+}
+
+// Validate performs basic validation of the fee burn parameters.
+func (p FeeBurn) Validate() error {
+	return validateFraction(p.BurnFraction)
+}
+
+func validateFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("fraction must be in [0, 1]: %s", v)
+	}
+	return nil
+}
+
+// ValidateFeeDenoms validates the allowlist of fee denoms. Every denom must be
+// valid, unique and not the bond denom and every exchange rate must be
+// positive.
+func ValidateFeeDenoms(i interface{}) error {
+	feeDenoms, ok := i.([]FeeDenom)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[string]struct{}, len(feeDenoms))
+	for _, feeDenom := range feeDenoms {
+		if err := sdk.ValidateDenom(feeDenom.Denom); err != nil {
+			return err
+		}
+		if feeDenom.Denom == appconsts.BondDenom {
+			return fmt.Errorf("fee denom cannot be the bond denom %s", appconsts.BondDenom)
+		}
+		if _, exists := seen[feeDenom.Denom]; exists {
+			return fmt.Errorf("duplicate fee denom %s", feeDenom.Denom)
+		}
+		seen[feeDenom.Denom] = struct{}{}
+		if feeDenom.ExchangeRate.IsNil() || !feeDenom.ExchangeRate.IsPositive() {
+			return fmt.Errorf("exchange rate of fee denom %s must be positive: %s", feeDenom.Denom, feeDenom.ExchangeRate)
+		}
+	}
+	return nil
+}
@@ -8,6 +8,8 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -106,36 +108,415 @@ func (m *QueryNetworkMinGasPriceResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryNetworkMinGasPriceResponse proto.InternalMessageInfo
 
+// QueryGasPriceAdjustment is the request type for the Query/GasPriceAdjustment RPC method.
+type QueryGasPriceAdjustment struct {
+}
+
+func (m *QueryGasPriceAdjustment) Reset()         { *m = QueryGasPriceAdjustment{} }
+func (m *QueryGasPriceAdjustment) String() string { return proto.CompactTextString(m) }
+func (*QueryGasPriceAdjustment) ProtoMessage()    {}
+func (*QueryGasPriceAdjustment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c41d9a8b7bf8984, []int{2}
+}
+func (m *QueryGasPriceAdjustment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasPriceAdjustment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasPriceAdjustment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasPriceAdjustment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasPriceAdjustment.Merge(m, src)
+}
+func (m *QueryGasPriceAdjustment) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasPriceAdjustment) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasPriceAdjustment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasPriceAdjustment proto.InternalMessageInfo
+
+// QueryGasPriceAdjustmentResponse is the response type for Query/GasPriceAdjustment RPC method.
+type QueryGasPriceAdjustmentResponse struct {
+	GasPriceAdjustment GasPriceAdjustment `protobuf:"bytes,1,opt,name=gas_price_adjustment,json=gasPriceAdjustment,proto3" json:"gas_price_adjustment"`
+}
+
+func (m *QueryGasPriceAdjustmentResponse) Reset()         { *m = QueryGasPriceAdjustmentResponse{} }
+func (m *QueryGasPriceAdjustmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasPriceAdjustmentResponse) ProtoMessage()    {}
+func (*QueryGasPriceAdjustmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c41d9a8b7bf8984, []int{3}
+}
+func (m *QueryGasPriceAdjustmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasPriceAdjustmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasPriceAdjustmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasPriceAdjustmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasPriceAdjustmentResponse.Merge(m, src)
+}
+func (m *QueryGasPriceAdjustmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasPriceAdjustmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasPriceAdjustmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasPriceAdjustmentResponse proto.InternalMessageInfo
+
+func (m *QueryGasPriceAdjustmentResponse) GetGasPriceAdjustment() GasPriceAdjustment {
+	if m != nil {
+		return m.GasPriceAdjustment
+	}
+	return GasPriceAdjustment{}
+}
+
+// QueryNetworkMinGasPriceHistory is the request type for the Query/NetworkMinGasPriceHistory RPC method.
+type QueryNetworkMinGasPriceHistory struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNetworkMinGasPriceHistory) Reset()         { *m = QueryNetworkMinGasPriceHistory{} }
+func (m *QueryNetworkMinGasPriceHistory) String() string { return proto.CompactTextString(m) }
+func (*QueryNetworkMinGasPriceHistory) ProtoMessage()    {}
+func (*QueryNetworkMinGasPriceHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c41d9a8b7bf8984, []int{4}
+}
+func (m *QueryNetworkMinGasPriceHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetworkMinGasPriceHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetworkMinGasPriceHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNetworkMinGasPriceHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetworkMinGasPriceHistory.Merge(m, src)
+}
+func (m *QueryNetworkMinGasPriceHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetworkMinGasPriceHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetworkMinGasPriceHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNetworkMinGasPriceHistory proto.InternalMessageInfo
+
+func (m *QueryNetworkMinGasPriceHistory) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryNetworkMinGasPriceHistoryResponse is the response type for Query/NetworkMinGasPriceHistory RPC method.
+type QueryNetworkMinGasPriceHistoryResponse struct {
+	Changes    []NetworkMinGasPriceChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+	Pagination *query.PageResponse        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNetworkMinGasPriceHistoryResponse) Reset() {
+	*m = QueryNetworkMinGasPriceHistoryResponse{}
+}
+func (m *QueryNetworkMinGasPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNetworkMinGasPriceHistoryResponse) ProtoMessage()    {}
+func (*QueryNetworkMinGasPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c41d9a8b7bf8984, []int{5}
+}
+func (m *QueryNetworkMinGasPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNetworkMinGasPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNetworkMinGasPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNetworkMinGasPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNetworkMinGasPriceHistoryResponse.Merge(m, src)
+}
+func (m *QueryNetworkMinGasPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNetworkMinGasPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNetworkMinGasPriceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNetworkMinGasPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryNetworkMinGasPriceHistoryResponse) GetChanges() []NetworkMinGasPriceChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+func (m *QueryNetworkMinGasPriceHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryFeeDenoms is the request type for the Query/FeeDenoms RPC method.
+type QueryFeeDenoms struct {
+}
+
+func (m *QueryFeeDenoms) Reset()         { *m = QueryFeeDenoms{} }
+func (m *QueryFeeDenoms) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeDenoms) ProtoMessage()    {}
+func (*QueryFeeDenoms) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c41d9a8b7bf8984, []int{6}
+}
+func (m *QueryFeeDenoms) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeDenoms) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeDenoms.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeDenoms) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeDenoms.Merge(m, src)
+}
+func (m *QueryFeeDenoms) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeDenoms) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeDenoms.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeDenoms proto.InternalMessageInfo
+
+// QueryFeeDenomsResponse is the response type for Query/FeeDenoms RPC method.
+type QueryFeeDenomsResponse struct {
+	FeeDenoms []FeeDenom `protobuf:"bytes,1,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms"`
+}
+
+func (m *QueryFeeDenomsResponse) Reset()         { *m = QueryFeeDenomsResponse{} }
+func (m *QueryFeeDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeDenomsResponse) ProtoMessage()    {}
+func (*QueryFeeDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c41d9a8b7bf8984, []int{7}
+}
+func (m *QueryFeeDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeDenomsResponse.Merge(m, src)
+}
+func (m *QueryFeeDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryFeeDenomsResponse) GetFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+// QueryTotalFeesBurned is the request type for the Query/TotalFeesBurned RPC method.
+type QueryTotalFeesBurned struct {
+}
+
+func (m *QueryTotalFeesBurned) Reset()         { *m = QueryTotalFeesBurned{} }
+func (m *QueryTotalFeesBurned) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalFeesBurned) ProtoMessage()    {}
+func (*QueryTotalFeesBurned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c41d9a8b7bf8984, []int{8}
+}
+func (m *QueryTotalFeesBurned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalFeesBurned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalFeesBurned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalFeesBurned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalFeesBurned.Merge(m, src)
+}
+func (m *QueryTotalFeesBurned) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalFeesBurned) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalFeesBurned.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalFeesBurned proto.InternalMessageInfo
+
+// QueryTotalFeesBurnedResponse is the response type for Query/TotalFeesBurned RPC method.
+type QueryTotalFeesBurnedResponse struct {
+	TotalFeesBurned types.Coin `protobuf:"bytes,1,opt,name=total_fees_burned,json=totalFeesBurned,proto3" json:"total_fees_burned"`
+}
+
+func (m *QueryTotalFeesBurnedResponse) Reset()         { *m = QueryTotalFeesBurnedResponse{} }
+func (m *QueryTotalFeesBurnedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalFeesBurnedResponse) ProtoMessage()    {}
+func (*QueryTotalFeesBurnedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4c41d9a8b7bf8984, []int{9}
+}
+func (m *QueryTotalFeesBurnedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalFeesBurnedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalFeesBurnedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalFeesBurnedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalFeesBurnedResponse.Merge(m, src)
+}
+func (m *QueryTotalFeesBurnedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalFeesBurnedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalFeesBurnedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalFeesBurnedResponse proto.InternalMessageInfo
+
+func (m *QueryTotalFeesBurnedResponse) GetTotalFeesBurned() types.Coin {
+	if m != nil {
+		return m.TotalFeesBurned
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryNetworkMinGasPrice)(nil), "celestia.minfee.v1.QueryNetworkMinGasPrice")
 	proto.RegisterType((*QueryNetworkMinGasPriceResponse)(nil), "celestia.minfee.v1.QueryNetworkMinGasPriceResponse")
+	proto.RegisterType((*QueryGasPriceAdjustment)(nil), "celestia.minfee.v1.QueryGasPriceAdjustment")
+	proto.RegisterType((*QueryGasPriceAdjustmentResponse)(nil), "celestia.minfee.v1.QueryGasPriceAdjustmentResponse")
+	proto.RegisterType((*QueryNetworkMinGasPriceHistory)(nil), "celestia.minfee.v1.QueryNetworkMinGasPriceHistory")
+	proto.RegisterType((*QueryNetworkMinGasPriceHistoryResponse)(nil), "celestia.minfee.v1.QueryNetworkMinGasPriceHistoryResponse")
+	proto.RegisterType((*QueryFeeDenoms)(nil), "celestia.minfee.v1.QueryFeeDenoms")
+	proto.RegisterType((*QueryFeeDenomsResponse)(nil), "celestia.minfee.v1.QueryFeeDenomsResponse")
+	proto.RegisterType((*QueryTotalFeesBurned)(nil), "celestia.minfee.v1.QueryTotalFeesBurned")
+	proto.RegisterType((*QueryTotalFeesBurnedResponse)(nil), "celestia.minfee.v1.QueryTotalFeesBurnedResponse")
 }
 
 func init() { proto.RegisterFile("celestia/minfee/v1/query.proto", fileDescriptor_4c41d9a8b7bf8984) }
 
 var fileDescriptor_4c41d9a8b7bf8984 = []byte{
-	// 336 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0xcf, 0xcd, 0xcc, 0x4b, 0x4b, 0x4d, 0xd5, 0x2f, 0x33, 0xd4, 0x2f,
-	0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x82, 0xc9, 0xeb, 0x41,
-	0xe4, 0xf5, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0xd2, 0xfa, 0x20, 0x16, 0x44,
-	0xa5, 0x94, 0x4c, 0x7a, 0x7e, 0x7e, 0x7a, 0x4e, 0xaa, 0x7e, 0x62, 0x41, 0xa6, 0x7e, 0x62, 0x5e,
-	0x5e, 0x7e, 0x49, 0x62, 0x49, 0x66, 0x7e, 0x5e, 0x31, 0x54, 0x56, 0x32, 0x39, 0xbf, 0x38, 0x37,
-	0xbf, 0x38, 0x1e, 0xa2, 0x0d, 0xc2, 0x81, 0x48, 0x29, 0x49, 0x72, 0x89, 0x07, 0x82, 0x6c, 0xf4,
-	0x4b, 0x2d, 0x29, 0xcf, 0x2f, 0xca, 0xf6, 0xcd, 0xcc, 0x73, 0x4f, 0x2c, 0x0e, 0x28, 0xca, 0x4c,
-	0x4e, 0x55, 0x9a, 0xc4, 0xc8, 0x25, 0x8f, 0x43, 0x2e, 0x28, 0xb5, 0xb8, 0x20, 0x3f, 0xaf, 0x38,
-	0x55, 0x28, 0x9f, 0x4b, 0x34, 0x0f, 0x22, 0x1b, 0x9f, 0x9b, 0x99, 0x17, 0x9f, 0x9e, 0x08, 0xb2,
-	0x24, 0x33, 0x39, 0x55, 0x82, 0x51, 0x81, 0x51, 0x83, 0xd3, 0xc9, 0xe6, 0xc4, 0x3d, 0x79, 0x86,
-	0x5b, 0xf7, 0xe4, 0xd5, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xa1, 0xd6,
-	0x43, 0x29, 0xdd, 0xe2, 0x94, 0x6c, 0xfd, 0x92, 0xca, 0x82, 0xd4, 0x62, 0x3d, 0x97, 0xd4, 0xe4,
-	0x4b, 0x5b, 0x74, 0xb9, 0xa0, 0xae, 0x73, 0x49, 0x4d, 0x0e, 0x12, 0xca, 0xc3, 0xb0, 0xd8, 0x68,
-	0x35, 0x23, 0x17, 0x2b, 0xd8, 0x51, 0x42, 0x0b, 0x19, 0xb9, 0x84, 0x30, 0x5d, 0x26, 0xa4, 0xad,
-	0x87, 0x19, 0x68, 0x7a, 0x38, 0xbc, 0x21, 0x65, 0x4c, 0x82, 0x62, 0x98, 0x9f, 0x95, 0x34, 0x9b,
-	0x2e, 0x3f, 0x99, 0xcc, 0xa4, 0x2c, 0xa4, 0xa8, 0x8f, 0x25, 0xfa, 0x50, 0x42, 0xc1, 0xc9, 0xed,
-	0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x74, 0x90, 0x43, 0x04, 0x6a, 0x4c,
-	0x7e, 0x51, 0x3a, 0x9c, 0xad, 0x9b, 0x58, 0x50, 0xa0, 0x5f, 0x01, 0x35, 0x38, 0x89, 0x0d, 0x1c,
-	0x59, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x49, 0xc3, 0x8f, 0xd9, 0x31, 0x02, 0x00, 0x00,
+	// 729 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x95, 0xcf, 0x4f, 0x13, 0x4d,
+	0x18, 0xc7, 0xbb, 0xbc, 0xef, 0xcb, 0x1b, 0x86, 0x44, 0x74, 0x82, 0x48, 0x1b, 0xb2, 0xd4, 0x35,
+	0x96, 0xf2, 0xa3, 0xbb, 0xb4, 0xdc, 0x8c, 0x17, 0x0a, 0x29, 0x26, 0xfe, 0x08, 0x36, 0x9e, 0x34,
+	0x71, 0xb3, 0xdd, 0x3e, 0xdd, 0x8e, 0xd0, 0x99, 0xd2, 0x99, 0xa2, 0x1c, 0xd5, 0x7f, 0xc0, 0xe8,
+	0xd5, 0x8b, 0x37, 0xff, 0x00, 0x8f, 0x46, 0xaf, 0x1c, 0x89, 0x5e, 0x8c, 0x07, 0x62, 0xc0, 0x3f,
+	0xc4, 0x74, 0x76, 0x76, 0xa0, 0xec, 0x16, 0xec, 0xa9, 0xbb, 0xf3, 0x7d, 0xe6, 0x79, 0x3e, 0xdf,
+	0x79, 0xe6, 0xe9, 0x22, 0xd3, 0x87, 0x6d, 0xe0, 0x82, 0x78, 0x4e, 0x8b, 0xd0, 0x06, 0x80, 0xb3,
+	0x5b, 0x74, 0x76, 0xba, 0xd0, 0xd9, 0xb3, 0xdb, 0x1d, 0x26, 0x18, 0xc6, 0x91, 0x6e, 0x87, 0xba,
+	0xbd, 0x5b, 0xcc, 0x4c, 0x06, 0x2c, 0x60, 0x52, 0x76, 0x7a, 0x4f, 0x61, 0x64, 0x66, 0x26, 0x60,
+	0x2c, 0xd8, 0x06, 0xc7, 0x6b, 0x13, 0xc7, 0xa3, 0x94, 0x09, 0x4f, 0x10, 0x46, 0xb9, 0x52, 0xd3,
+	0x3e, 0xe3, 0x2d, 0xc6, 0xdd, 0x70, 0x5b, 0xf8, 0xa2, 0xa4, 0x85, 0xf0, 0xcd, 0xa9, 0x79, 0x1c,
+	0xc2, 0xda, 0xce, 0x6e, 0xb1, 0x06, 0xc2, 0x2b, 0x3a, 0x6d, 0x2f, 0x20, 0x54, 0xe6, 0x51, 0xb1,
+	0xe6, 0xe9, 0xd8, 0x28, 0xca, 0x67, 0x24, 0xd2, 0xb3, 0x09, 0x76, 0x02, 0xa0, 0xc0, 0x89, 0xaa,
+	0x66, 0xa5, 0xd1, 0xb5, 0x87, 0xbd, 0x1a, 0x0f, 0x40, 0x3c, 0x67, 0x9d, 0xad, 0xfb, 0x84, 0x6e,
+	0x78, 0x7c, 0xb3, 0x43, 0x7c, 0xb0, 0xde, 0x1a, 0x68, 0x76, 0x80, 0x56, 0x05, 0xde, 0x66, 0x94,
+	0x03, 0x66, 0xe8, 0x2a, 0x0d, 0x55, 0xb7, 0x45, 0xa8, 0x1b, 0x78, 0x3d, 0x4b, 0xc4, 0x87, 0x69,
+	0x23, 0x6b, 0xe4, 0xc7, 0xca, 0xb7, 0xf7, 0x0f, 0x67, 0x53, 0x3f, 0x0f, 0x67, 0x73, 0x01, 0x11,
+	0xcd, 0x6e, 0xcd, 0xf6, 0x59, 0x4b, 0x99, 0x55, 0x3f, 0x05, 0x5e, 0xdf, 0x72, 0xc4, 0x5e, 0x1b,
+	0xb8, 0xbd, 0x0e, 0xfe, 0xb7, 0x4f, 0x05, 0xa4, 0xce, 0x62, 0x1d, 0xfc, 0x2a, 0xa6, 0x71, 0xa8,
+	0x88, 0x37, 0x5a, 0x58, 0xad, 0x3f, 0xeb, 0x72, 0xd1, 0x02, 0x2a, 0xac, 0x97, 0x11, 0x6f, 0x5c,
+	0xd3, 0xbc, 0x4f, 0xd1, 0xa4, 0x66, 0x74, 0x3d, 0xad, 0x4b, 0xdc, 0xf1, 0x52, 0xce, 0x8e, 0xb7,
+	0xd7, 0x8e, 0x67, 0x2b, 0xff, 0xdb, 0xb3, 0x55, 0xc5, 0x41, 0x9c, 0xa1, 0x89, 0xcc, 0x01, 0x47,
+	0x76, 0x87, 0x70, 0xc1, 0x3a, 0x7b, 0xb8, 0x82, 0xd0, 0x49, 0x1b, 0x4f, 0xea, 0x86, 0xae, 0x7b,
+	0x7d, 0xb4, 0xc3, 0xfb, 0xa6, 0xba, 0x69, 0x6f, 0x7a, 0x01, 0x54, 0x61, 0xa7, 0x0b, 0x5c, 0x54,
+	0x4f, 0xed, 0xb4, 0xbe, 0x1a, 0x28, 0x77, 0x7e, 0x29, 0x6d, 0xfa, 0x1e, 0xfa, 0xdf, 0x6f, 0x7a,
+	0x34, 0x00, 0x3e, 0x6d, 0x64, 0xff, 0xc9, 0x8f, 0x97, 0x96, 0x92, 0x7c, 0xc6, 0xf3, 0xac, 0xc9,
+	0x4d, 0xca, 0x6d, 0x94, 0x02, 0x6f, 0xf4, 0x19, 0x18, 0x91, 0x06, 0xe6, 0x2e, 0x34, 0x10, 0xa2,
+	0xf4, 0x39, 0xb8, 0x8c, 0x2e, 0x49, 0x03, 0x15, 0x80, 0x75, 0xa0, 0xac, 0xc5, 0xad, 0x27, 0x68,
+	0xaa, 0x7f, 0x45, 0x5b, 0x58, 0x45, 0xa8, 0x01, 0xe0, 0xd6, 0xe5, 0xaa, 0x72, 0x31, 0x93, 0xe4,
+	0x22, 0xda, 0xaa, 0xa8, 0xc7, 0x1a, 0x3a, 0xf9, 0x14, 0x9a, 0x94, 0xc9, 0x1f, 0x31, 0xe1, 0x6d,
+	0x57, 0x00, 0x78, 0xb9, 0xdb, 0xa1, 0x50, 0xb7, 0xb6, 0xd0, 0x4c, 0xd2, 0xba, 0x2e, 0x7d, 0x17,
+	0x5d, 0x11, 0x3d, 0xc9, 0x6d, 0x00, 0x70, 0xb7, 0x26, 0x45, 0xd5, 0xb7, 0x74, 0x9f, 0xed, 0xc8,
+	0xf0, 0x1a, 0x23, 0x54, 0x95, 0x9f, 0x10, 0xfd, 0x49, 0x4b, 0x9f, 0x47, 0xd1, 0x7f, 0xb2, 0x1a,
+	0xfe, 0x60, 0x20, 0x1c, 0x3f, 0x72, 0xbc, 0x98, 0x64, 0x6a, 0x40, 0x9f, 0x33, 0x2b, 0x43, 0x04,
+	0x47, 0x7e, 0xac, 0xf9, 0x57, 0xdf, 0x7f, 0xbf, 0x1b, 0xb9, 0x81, 0xaf, 0x3b, 0x09, 0x7f, 0x0e,
+	0x7d, 0x43, 0x8c, 0x3f, 0x1a, 0x08, 0xc7, 0xaf, 0xff, 0x39, 0x8c, 0xf1, 0xe0, 0xcc, 0xca, 0x10,
+	0xc1, 0x9a, 0x71, 0x59, 0x32, 0x2e, 0xe0, 0x7c, 0x12, 0x63, 0xd2, 0x00, 0xe3, 0x2f, 0x06, 0x4a,
+	0x0f, 0x1e, 0xba, 0xd2, 0x10, 0x07, 0xa5, 0xf6, 0x64, 0x6e, 0x0d, 0xbf, 0x47, 0xf3, 0x17, 0x25,
+	0xff, 0x22, 0x9e, 0xbf, 0xf0, 0x8c, 0xdd, 0xa6, 0x42, 0x7c, 0x6d, 0xa0, 0x31, 0x7d, 0xef, 0xb1,
+	0x35, 0xb0, 0xb8, 0x8e, 0xc9, 0x2c, 0x5c, 0x1c, 0xa3, 0x81, 0x72, 0x12, 0x28, 0x8b, 0xcd, 0x24,
+	0xa0, 0x93, 0xc9, 0xc2, 0xef, 0x0d, 0x34, 0x71, 0x66, 0x10, 0x70, 0x7e, 0x60, 0x9d, 0x33, 0x91,
+	0x99, 0xe5, 0xbf, 0x8d, 0xd4, 0x5c, 0x05, 0xc9, 0x35, 0x87, 0x6f, 0x26, 0x71, 0xc5, 0xc6, 0xae,
+	0x5c, 0xd9, 0x3f, 0x32, 0x8d, 0x83, 0x23, 0xd3, 0xf8, 0x75, 0x64, 0x1a, 0x6f, 0x8e, 0xcd, 0xd4,
+	0xc1, 0xb1, 0x99, 0xfa, 0x71, 0x6c, 0xa6, 0x1e, 0x2f, 0x9d, 0xfe, 0xc2, 0xa8, 0x54, 0xac, 0x13,
+	0xe8, 0xe7, 0x82, 0xd7, 0x6e, 0x3b, 0x2f, 0x54, 0xf2, 0xda, 0xa8, 0xfc, 0xf8, 0xad, 0xfc, 0x19,
+	0x00, 0xf2, 0xd8, 0x07, 0x64, 0xef, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -152,6 +533,17 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// NetworkMinGasPrice queries the network wide minimum gas price.
 	NetworkMinGasPrice(ctx context.Context, in *QueryNetworkMinGasPrice, opts ...grpc.CallOption) (*QueryNetworkMinGasPriceResponse, error)
+	// GasPriceAdjustment queries the parameters used to automatically adjust
+	// the network min gas price.
+	GasPriceAdjustment(ctx context.Context, in *QueryGasPriceAdjustment, opts ...grpc.CallOption) (*QueryGasPriceAdjustmentResponse, error)
+	// NetworkMinGasPriceHistory queries the changes to the network min gas
+	// price, oldest first.
+	NetworkMinGasPriceHistory(ctx context.Context, in *QueryNetworkMinGasPriceHistory, opts ...grpc.CallOption) (*QueryNetworkMinGasPriceHistoryResponse, error)
+	// FeeDenoms queries the denoms other than utia that can be used to pay fees
+	// and their exchange rates.
+	FeeDenoms(ctx context.Context, in *QueryFeeDenoms, opts ...grpc.CallOption) (*QueryFeeDenomsResponse, error)
+	// TotalFeesBurned queries the total amount of fees that have been burned.
+	TotalFeesBurned(ctx context.Context, in *QueryTotalFeesBurned, opts ...grpc.CallOption) (*QueryTotalFeesBurnedResponse, error)
 }
 
 type queryClient struct {
@@ -171,10 +563,57 @@ func (c *queryClient) NetworkMinGasPrice(ctx context.Context, in *QueryNetworkMi
 	return out, nil
 }
 
+func (c *queryClient) GasPriceAdjustment(ctx context.Context, in *QueryGasPriceAdjustment, opts ...grpc.CallOption) (*QueryGasPriceAdjustmentResponse, error) {
+	out := new(QueryGasPriceAdjustmentResponse)
+	err := c.cc.Invoke(ctx, "/celestia.minfee.v1.Query/GasPriceAdjustment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NetworkMinGasPriceHistory(ctx context.Context, in *QueryNetworkMinGasPriceHistory, opts ...grpc.CallOption) (*QueryNetworkMinGasPriceHistoryResponse, error) {
+	out := new(QueryNetworkMinGasPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/celestia.minfee.v1.Query/NetworkMinGasPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeDenoms(ctx context.Context, in *QueryFeeDenoms, opts ...grpc.CallOption) (*QueryFeeDenomsResponse, error) {
+	out := new(QueryFeeDenomsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.minfee.v1.Query/FeeDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalFeesBurned(ctx context.Context, in *QueryTotalFeesBurned, opts ...grpc.CallOption) (*QueryTotalFeesBurnedResponse, error) {
+	out := new(QueryTotalFeesBurnedResponse)
+	err := c.cc.Invoke(ctx, "/celestia.minfee.v1.Query/TotalFeesBurned", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// NetworkMinGasPrice queries the network wide minimum gas price.
 	NetworkMinGasPrice(context.Context, *QueryNetworkMinGasPrice) (*QueryNetworkMinGasPriceResponse, error)
+	// GasPriceAdjustment queries the parameters used to automatically adjust
+	// the network min gas price.
+	GasPriceAdjustment(context.Context, *QueryGasPriceAdjustment) (*QueryGasPriceAdjustmentResponse, error)
+	// NetworkMinGasPriceHistory queries the changes to the network min gas
+	// price, oldest first.
+	NetworkMinGasPriceHistory(context.Context, *QueryNetworkMinGasPriceHistory) (*QueryNetworkMinGasPriceHistoryResponse, error)
+	// FeeDenoms queries the denoms other than utia that can be used to pay fees
+	// and their exchange rates.
+	FeeDenoms(context.Context, *QueryFeeDenoms) (*QueryFeeDenomsResponse, error)
+	// TotalFeesBurned queries the total amount of fees that have been burned.
+	TotalFeesBurned(context.Context, *QueryTotalFeesBurned) (*QueryTotalFeesBurnedResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -184,6 +623,18 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) NetworkMinGasPrice(ctx context.Context, req *QueryNetworkMinGasPrice) (*QueryNetworkMinGasPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NetworkMinGasPrice not implemented")
 }
+func (*UnimplementedQueryServer) GasPriceAdjustment(ctx context.Context, req *QueryGasPriceAdjustment) (*QueryGasPriceAdjustmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPriceAdjustment not implemented")
+}
+func (*UnimplementedQueryServer) NetworkMinGasPriceHistory(ctx context.Context, req *QueryNetworkMinGasPriceHistory) (*QueryNetworkMinGasPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetworkMinGasPriceHistory not implemented")
+}
+func (*UnimplementedQueryServer) FeeDenoms(ctx context.Context, req *QueryFeeDenoms) (*QueryFeeDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeDenoms not implemented")
+}
+func (*UnimplementedQueryServer) TotalFeesBurned(ctx context.Context, req *QueryTotalFeesBurned) (*QueryTotalFeesBurnedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalFeesBurned not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -207,113 +658,1160 @@ func _Query_NetworkMinGasPrice_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "celestia.minfee.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "NetworkMinGasPrice",
-			Handler:    _Query_NetworkMinGasPrice_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "celestia/minfee/v1/query.proto",
+func _Query_GasPriceAdjustment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasPriceAdjustment)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasPriceAdjustment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.minfee.v1.Query/GasPriceAdjustment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPriceAdjustment(ctx, req.(*QueryGasPriceAdjustment))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryNetworkMinGasPrice) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_NetworkMinGasPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNetworkMinGasPriceHistory)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryNetworkMinGasPrice) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryNetworkMinGasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
+	if interceptor == nil {
+		return srv.(QueryServer).NetworkMinGasPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.minfee.v1.Query/NetworkMinGasPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NetworkMinGasPriceHistory(ctx, req.(*QueryNetworkMinGasPriceHistory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeDenoms)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.minfee.v1.Query/FeeDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeDenoms(ctx, req.(*QueryFeeDenoms))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalFeesBurned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalFeesBurned)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalFeesBurned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.minfee.v1.Query/TotalFeesBurned",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalFeesBurned(ctx, req.(*QueryTotalFeesBurned))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.minfee.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "NetworkMinGasPrice",
+			Handler:    _Query_NetworkMinGasPrice_Handler,
+		},
+		{
+			MethodName: "GasPriceAdjustment",
+			Handler:    _Query_GasPriceAdjustment_Handler,
+		},
+		{
+			MethodName: "NetworkMinGasPriceHistory",
+			Handler:    _Query_NetworkMinGasPriceHistory_Handler,
+		},
+		{
+			MethodName: "FeeDenoms",
+			Handler:    _Query_FeeDenoms_Handler,
+		},
+		{
+			MethodName: "TotalFeesBurned",
+			Handler:    _Query_TotalFeesBurned_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/minfee/v1/query.proto",
+}
+
+func (m *QueryNetworkMinGasPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworkMinGasPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworkMinGasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetworkMinGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworkMinGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworkMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NetworkMinGasPrice.Size()
+		i -= size
+		if _, err := m.NetworkMinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasPriceAdjustment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasPriceAdjustment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasPriceAdjustment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasPriceAdjustmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasPriceAdjustmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasPriceAdjustmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GasPriceAdjustment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetworkMinGasPriceHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworkMinGasPriceHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworkMinGasPriceHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNetworkMinGasPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNetworkMinGasPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNetworkMinGasPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalFeesBurned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalFeesBurned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalFeesBurned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalFeesBurnedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalFeesBurnedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalFeesBurnedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TotalFeesBurned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryNetworkMinGasPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNetworkMinGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NetworkMinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGasPriceAdjustment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGasPriceAdjustmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GasPriceAdjustment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNetworkMinGasPriceHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNetworkMinGasPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeDenoms) > 0 {
+		for _, e := range m.FeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTotalFeesBurned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTotalFeesBurnedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TotalFeesBurned.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryNetworkMinGasPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetworkMinGasPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetworkMinGasPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetworkMinGasPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetworkMinGasPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetworkMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkMinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NetworkMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasPriceAdjustment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasPriceAdjustment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasPriceAdjustment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasPriceAdjustmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasPriceAdjustmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasPriceAdjustmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceAdjustment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPriceAdjustment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetworkMinGasPriceHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetworkMinGasPriceHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetworkMinGasPriceHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNetworkMinGasPriceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNetworkMinGasPriceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNetworkMinGasPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, NetworkMinGasPriceChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryNetworkMinGasPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryNetworkMinGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return nil
 }
-
-func (m *QueryNetworkMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.NetworkMinGasPrice.Size()
-		i -= size
-		if _, err := m.NetworkMinGasPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryFeeDenoms) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeDenoms: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryNetworkMinGasPrice) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeeDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, FeeDenom{})
+			if err := m.FeeDenoms[len(m.FeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryNetworkMinGasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.NetworkMinGasPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryNetworkMinGasPrice) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalFeesBurned) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -336,10 +1834,10 @@ func (m *QueryNetworkMinGasPrice) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNetworkMinGasPrice: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalFeesBurned: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNetworkMinGasPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalFeesBurned: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -363,7 +1861,7 @@ func (m *QueryNetworkMinGasPrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNetworkMinGasPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTotalFeesBurnedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -386,17 +1884,17 @@ func (m *QueryNetworkMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNetworkMinGasPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTotalFeesBurnedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNetworkMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTotalFeesBurnedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkMinGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalFeesBurned", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -406,23 +1904,22 @@ func (m *QueryNetworkMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NetworkMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TotalFeesBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -68,6 +68,8 @@ func mintBlockProvision(ctx sdk.Context, k keeper.Keeper) {
 		panic(err)
 	}
 
+	k.RecordBlockProvision(ctx, ctx.BlockTime().Sub(*minter.PreviousBlockTime), toMintCoin.Amount)
+
 	if toMintCoin.Amount.IsInt64() {
 		defer telemetry.ModuleSetGauge(types.ModuleName, float32(toMintCoin.Amount.Int64()), "minted_tokens")
 	}
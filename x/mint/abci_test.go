@@ -6,14 +6,18 @@ import (
 	"time"
 
 	"github.com/celestiaorg/celestia-app/v3/app"
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
 	"github.com/celestiaorg/celestia-app/v3/test/util"
 	"github.com/celestiaorg/celestia-app/v3/x/mint"
+	"github.com/celestiaorg/celestia-app/v3/x/mint/keeper"
 	minttypes "github.com/celestiaorg/celestia-app/v3/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	tmlog "github.com/tendermint/tendermint/libs/log"
 	"github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
 )
 
 var oneYear = time.Duration(minttypes.NanosecondsPerYear)
@@ -131,3 +135,99 @@ func TestAnnualProvisions(t *testing.T) {
 		})
 	})
 }
+
+func TestBlockProvisions(t *testing.T) {
+	a, _ := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	header := types.Header{Version: version.Consensus{App: v3.Version}}
+	ctx := sdk.NewContext(a.CommitMultiStore(), header, false, tmlog.NewNopLogger())
+	genesisTime := a.MintKeeper.GetGenesisTime(ctx).GenesisTime
+	initialSupply := a.MintKeeper.StakingTokenSupply(ctx)
+	blockInterval := time.Second * 15
+
+	for height := int64(1); height <= 3; height++ {
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(genesisTime.Add(blockInterval * time.Duration(height)))
+		mint.BeginBlocker(ctx, a.MintKeeper)
+	}
+
+	// No block provision is minted at height 1 because there is no previous
+	// block time.
+	_, found := a.MintKeeper.GetBlockProvision(ctx, 1)
+	assert.False(t, found)
+
+	totalMinted := sdk.ZeroInt()
+	for height := int64(2); height <= 3; height++ {
+		provision, found := a.MintKeeper.GetBlockProvision(ctx, height)
+		require.True(t, found)
+		assert.Equal(t, height, provision.Height)
+		assert.Equal(t, blockInterval, provision.BlockTimeDelta)
+		assert.True(t, provision.Amount.IsPositive())
+		totalMinted = totalMinted.Add(provision.Amount)
+	}
+	assert.Equal(t, totalMinted, a.MintKeeper.GetTotalMinted(ctx))
+
+	baseline, ok := a.MintKeeper.GetBaselineSupply(ctx)
+	require.True(t, ok)
+	assert.Equal(t, initialSupply, baseline)
+	assert.Equal(t, initialSupply.Add(totalMinted), a.MintKeeper.StakingTokenSupply(ctx))
+
+	_, broken := keeper.SupplyGrowthInvariant(a.MintKeeper)(ctx)
+	assert.False(t, broken)
+
+	t.Run("prunes block provisions outside the retention window", func(t *testing.T) {
+		height := int64(2 + minttypes.BlockProvisionRetention)
+		ctx := ctx.WithBlockHeight(height).WithBlockTime(genesisTime.Add(blockInterval * time.Duration(height)))
+		_, found := a.MintKeeper.GetBlockProvision(ctx, 2)
+		require.True(t, found)
+		mint.BeginBlocker(ctx, a.MintKeeper)
+
+		_, found = a.MintKeeper.GetBlockProvision(ctx, 2)
+		assert.False(t, found)
+		_, found = a.MintKeeper.GetBlockProvision(ctx, 3)
+		assert.True(t, found)
+		_, found = a.MintKeeper.GetBlockProvision(ctx, height)
+		assert.True(t, found)
+
+		_, broken := keeper.SupplyGrowthInvariant(a.MintKeeper)(ctx)
+		assert.False(t, broken)
+	})
+
+	t.Run("exports and imports block provisions", func(t *testing.T) {
+		gs := a.MintKeeper.ExportGenesis(ctx)
+		require.NoError(t, minttypes.ValidateGenesis(*gs))
+		assert.Equal(t, a.MintKeeper.GetBlockProvisions(ctx), gs.BlockProvisions)
+		assert.Equal(t, a.MintKeeper.GetTotalMinted(ctx), gs.TotalMinted)
+		assert.Equal(t, baseline, gs.BaselineSupply)
+
+		b, _ := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+		bctx := sdk.NewContext(b.CommitMultiStore(), header, false, tmlog.NewNopLogger())
+		b.MintKeeper.InitGenesis(bctx, b.AccountKeeper, gs)
+		assert.Equal(t, gs, b.MintKeeper.ExportGenesis(bctx))
+	})
+
+	t.Run("invariant is broken if supply grows more than minted", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		coins := sdk.NewCoins(sdk.NewCoin(a.StakingKeeper.BondDenom(ctx), sdk.NewInt(1)))
+		require.NoError(t, a.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+
+		_, broken := keeper.SupplyGrowthInvariant(a.MintKeeper)(ctx)
+		assert.True(t, broken)
+	})
+}
+
+func TestBlockProvisionsNotRecordedBeforeV3(t *testing.T) {
+	a, _ := util.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	header := types.Header{Version: version.Consensus{App: v2.Version}}
+	ctx := sdk.NewContext(a.CommitMultiStore(), header, false, tmlog.NewNopLogger())
+	genesisTime := a.MintKeeper.GetGenesisTime(ctx).GenesisTime
+
+	for height := int64(1); height <= 2; height++ {
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(genesisTime.Add(time.Minute * time.Duration(height)))
+		mint.BeginBlocker(ctx, a.MintKeeper)
+	}
+
+	_, found := a.MintKeeper.GetBlockProvision(ctx, 2)
+	assert.False(t, found)
+	assert.True(t, a.MintKeeper.GetTotalMinted(ctx).IsZero())
+	_, ok := a.MintKeeper.GetBaselineSupply(ctx)
+	assert.False(t, ok)
+}
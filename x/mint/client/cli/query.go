@@ -2,6 +2,8 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -10,6 +12,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 )
 
+// FlagTime is the flag used to project the supply until a timestamp.
+const FlagTime = "time"
+
 // GetQueryCmd returns the CLI query commands for the mint module.
 func GetQueryCmd() *cobra.Command {
 	mintQueryCmd := &cobra.Command{
@@ -24,6 +29,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryInflationRate(),
 		GetCmdQueryAnnualProvisions(),
 		GetCmdQueryGenesisTime(),
+		GetCmdQuerySupplyProjection(),
+		GetCmdQueryBlockProvisions(),
 	)
 
 	return mintQueryCmd
@@ -114,3 +121,92 @@ func GetCmdQueryGenesisTime() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQuerySupplyProjection implements a command to return the projected
+// inflation rate, annual provisions and total supply for future years.
+func GetCmdQuerySupplyProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-projection [years]",
+		Short: "Query the projected inflation rate, annual provisions and total supply",
+		Long: `Query the projected inflation rate, annual provisions and total supply for
+each of the next [years] years based on the current minter state. If --time is
+set, the projection runs until the provided RFC3339 timestamp instead.`,
+		Example: fmt.Sprintf(`$ celestia-appd query %s supply-projection 10
+$ celestia-appd query %s supply-projection --%s 2030-01-01T00:00:00Z`, types.ModuleName, types.ModuleName, FlagTime),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			request := &types.QuerySupplyProjectionRequest{}
+			if len(args) == 1 {
+				request.Years, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid years %q: %w", args[0], err)
+				}
+			}
+
+			timeStr, err := cmd.Flags().GetString(FlagTime)
+			if err != nil {
+				return err
+			}
+			if timeStr != "" {
+				t, err := time.Parse(time.RFC3339, timeStr)
+				if err != nil {
+					return fmt.Errorf("invalid time %q: %w", timeStr, err)
+				}
+				request.Time = &t
+			}
+
+			res, err := queryClient.SupplyProjection(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagTime, "", "RFC3339 timestamp to project the supply until")
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBlockProvisions implements a command to return the amount minted
+// in each recent block.
+func GetCmdQueryBlockProvisions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "block-provisions",
+		Short: "Query the amount minted in each recent block",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			request := &types.QueryBlockProvisionsRequest{Pagination: pageReq}
+			res, err := queryClient.BlockProvisions(cmd.Context(), request)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "block-provisions")
+
+	return cmd
+}
@@ -157,6 +157,42 @@ func (s *IntegrationTestSuite) TestGetCmdQueryGenesisTime() {
 	}
 }
 
+// TestGetCmdQuerySupplyProjection tests that the CLI command for supply
+// projection returns a projection for the current block and each future year.
+func (s *IntegrationTestSuite) TestGetCmdQuerySupplyProjection() {
+	testCases := []struct {
+		name string
+		args []string
+		want int
+	}{
+		{
+			name: "years",
+			args: append([]string{"3"}, s.jsonArgs()...),
+			want: 4,
+		},
+		{
+			name: "time",
+			args: append([]string{fmt.Sprintf("--%s=%s", cli.FlagTime, time.Now().Add(time.Hour).Format(time.RFC3339))}, s.jsonArgs()...),
+			want: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQuerySupplyProjection()
+			out, err := clitestutil.ExecTestCLICmd(s.cctx.Context, cmd, tc.args)
+			s.Require().NoError(err)
+
+			var res mint.QuerySupplyProjectionResponse
+			s.Require().NoError(s.cctx.Codec.UnmarshalJSON(out.Bytes(), &res))
+			s.Require().Len(res.Projections, tc.want)
+			s.Require().Equal(mint.InitialInflationRateAsDec(), res.Projections[0].InflationRate)
+		})
+	}
+}
+
 // In order for 'go test' to run this suite, we need to create
 // a normal test function and pass our suite to suite.Run
 func TestMintIntegrationTestSuite(t *testing.T) {
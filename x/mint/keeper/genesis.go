@@ -20,10 +20,25 @@ func (k Keeper) InitGenesis(ctx sdk.Context, ak types.AccountKeeper, data *types
 	// new module account in the x/auth account store if it doesn't exist. See
 	// the x/auth keeper for more details.
 	ak.GetModuleAccount(ctx, types.ModuleName)
+
+	if len(data.BlockProvisions) == 0 {
+		return
+	}
+	for _, provision := range data.BlockProvisions {
+		k.SetBlockProvision(ctx, provision)
+	}
+	k.setInt(ctx, types.KeyTotalMinted, data.TotalMinted)
+	k.setInt(ctx, types.KeyBaselineSupply, data.BaselineSupply)
 }
 
 // ExportGenesis returns a x/mint GenesisState for the given context.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	bondDenom := k.GetMinter(ctx).BondDenom
-	return types.NewGenesisState(bondDenom)
+	gs := types.NewGenesisState(bondDenom)
+	if baseline, ok := k.GetBaselineSupply(ctx); ok {
+		gs.BlockProvisions = k.GetBlockProvisions(ctx)
+		gs.TotalMinted = k.GetTotalMinted(ctx)
+		gs.BaselineSupply = baseline
+	}
+	return gs
 }
@@ -5,6 +5,9 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v3/x/mint/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -32,3 +35,53 @@ func (k Keeper) GenesisTime(c context.Context, _ *types.QueryGenesisTimeRequest)
 
 	return &types.QueryGenesisTimeResponse{GenesisTime: genesisTime}, nil
 }
+
+// SupplyProjection projects the inflation rate, annual provisions and total
+// supply of the mint module from the current minter state.
+func (k Keeper) SupplyProjection(c context.Context, req *types.QuerySupplyProjectionRequest) (*types.QuerySupplyProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	minter := k.GetMinter(ctx)
+	genesisTime := *k.GetGenesisTime(ctx).GenesisTime
+	current := ctx.BlockTime()
+
+	end := types.ProjectionEndTime(genesisTime, current, req.Years)
+	if req.Time != nil {
+		end = *req.Time
+	}
+
+	projections, err := types.ProjectSupply(minter, genesisTime, current, k.StakingTokenSupply(ctx), end)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QuerySupplyProjectionResponse{Projections: projections}, nil
+}
+
+// BlockProvisions returns the recorded block provisions of the mint module.
+func (k Keeper) BlockProvisions(c context.Context, req *types.QueryBlockProvisionsRequest) (*types.QueryBlockProvisionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var provisions []types.BlockProvision
+	pageRes, err := query.Paginate(k.blockProvisionStore(ctx), req.Pagination, func(_ []byte, value []byte) error {
+		var provision types.BlockProvision
+		if err := k.cdc.Unmarshal(value, &provision); err != nil {
+			return err
+		}
+		provisions = append(provisions, provision)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryBlockProvisionsResponse{
+		BlockProvisions: provisions,
+		TotalMinted:     k.GetTotalMinted(ctx),
+		Pagination:      pageRes,
+	}, nil
+}
@@ -50,6 +50,22 @@ func (suite *MintTestSuite) TestGRPC() {
 	genesisTime, err := queryClient.GenesisTime(gocontext.Background(), &types.QueryGenesisTimeRequest{})
 	suite.Require().NoError(err)
 	suite.Require().Equal(genesisTime.GenesisTime, app.MintKeeper.GetGenesisTime(ctx).GenesisTime)
+
+	projection, err := queryClient.SupplyProjection(gocontext.Background(), &types.QuerySupplyProjectionRequest{Years: 5})
+	suite.Require().NoError(err)
+	suite.Require().Len(projection.Projections, 6)
+	suite.Require().Equal(app.MintKeeper.StakingTokenSupply(ctx), projection.Projections[0].TotalSupply)
+	for i := 1; i < len(projection.Projections); i++ {
+		suite.Require().True(projection.Projections[i].TotalSupply.GT(projection.Projections[i-1].TotalSupply))
+	}
+
+	_, err = queryClient.SupplyProjection(gocontext.Background(), &types.QuerySupplyProjectionRequest{Years: types.MaxProjectionYears + 1})
+	suite.Require().Error(err)
+
+	blockProvisions, err := queryClient.BlockProvisions(gocontext.Background(), &types.QueryBlockProvisionsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(blockProvisions.BlockProvisions)
+	suite.Require().Equal(app.MintKeeper.GetTotalMinted(ctx), blockProvisions.TotalMinted)
 }
 
 func TestMintTestSuite(t *testing.T) {
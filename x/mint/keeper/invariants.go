@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/x/mint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all mint invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "supply-growth", SupplyGrowthInvariant(k))
+}
+
+// SupplyGrowthInvariant checks that the total supply has not grown by more
+// than the cumulative number of tokens minted since block provisions started
+// being recorded. The supply may grow by less than the total minted because
+// other modules burn tokens.
+func SupplyGrowthInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		baseline, ok := k.GetBaselineSupply(ctx)
+		if !ok {
+			return sdk.FormatInvariant(types.ModuleName, "supply-growth", "block provisions are not recorded"), false
+		}
+		supply := k.StakingTokenSupply(ctx)
+		totalMinted := k.GetTotalMinted(ctx)
+		growth := supply.Sub(baseline)
+
+		broken := growth.GT(totalMinted)
+		return sdk.FormatInvariant(types.ModuleName, "supply-growth", fmt.Sprintf(
+			"\tsupply growth since baseline: %s\n\ttotal minted: %s\n", growth, totalMinted,
+		)), broken
+	}
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	"github.com/celestiaorg/celestia-app/v3/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RecordBlockProvision stores the amount minted in the current block, prunes
+// the block provision that fell out of the retention window and increments the
+// total minted. The total supply before the first recorded block provision is
+// stored as the baseline for the supply invariant. Block provisions are only
+// recorded for app versions > 2.
+func (k Keeper) RecordBlockProvision(ctx sdk.Context, blockTimeDelta time.Duration, amount math.Int) {
+	if ctx.BlockHeader().Version.App <= v2.Version {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.KeyBaselineSupply) {
+		k.setInt(ctx, types.KeyBaselineSupply, k.StakingTokenSupply(ctx).Sub(amount))
+	}
+
+	provision := types.BlockProvision{
+		Height:         ctx.BlockHeight(),
+		BlockTimeDelta: blockTimeDelta,
+		Amount:         amount,
+	}
+	k.SetBlockProvision(ctx, provision)
+	if pruneHeight := provision.Height - types.BlockProvisionRetention; pruneHeight > 0 {
+		store.Delete(types.BlockProvisionKey(pruneHeight))
+	}
+
+	k.setInt(ctx, types.KeyTotalMinted, k.GetTotalMinted(ctx).Add(amount))
+}
+
+// GetBlockProvision returns the block provision at height.
+func (k Keeper) GetBlockProvision(ctx sdk.Context, height int64) (types.BlockProvision, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.BlockProvisionKey(height))
+	if bz == nil {
+		return types.BlockProvision{}, false
+	}
+	var provision types.BlockProvision
+	k.cdc.MustUnmarshal(bz, &provision)
+	return provision, true
+}
+
+// GetBlockProvisions returns all recorded block provisions ordered by height.
+func (k Keeper) GetBlockProvisions(ctx sdk.Context) []types.BlockProvision {
+	iterator := k.blockProvisionStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+
+	var provisions []types.BlockProvision
+	for ; iterator.Valid(); iterator.Next() {
+		var provision types.BlockProvision
+		k.cdc.MustUnmarshal(iterator.Value(), &provision)
+		provisions = append(provisions, provision)
+	}
+	return provisions
+}
+
+// SetBlockProvision stores provision.
+func (k Keeper) SetBlockProvision(ctx sdk.Context, provision types.BlockProvision) {
+	ctx.KVStore(k.storeKey).Set(types.BlockProvisionKey(provision.Height), k.cdc.MustMarshal(&provision))
+}
+
+// blockProvisionStore returns a prefix store over all block provisions.
+func (k Keeper) blockProvisionStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBlockProvision)
+}
+
+// GetTotalMinted returns the cumulative number of tokens minted since block
+// provisions started being recorded.
+func (k Keeper) GetTotalMinted(ctx sdk.Context) math.Int {
+	return k.getInt(ctx, types.KeyTotalMinted)
+}
+
+// GetBaselineSupply returns the total supply before the first recorded block
+// provision and whether it has been set.
+func (k Keeper) GetBaselineSupply(ctx sdk.Context) (math.Int, bool) {
+	if !ctx.KVStore(k.storeKey).Has(types.KeyBaselineSupply) {
+		return math.ZeroInt(), false
+	}
+	return k.getInt(ctx, types.KeyBaselineSupply), true
+}
+
+func (k Keeper) getInt(ctx sdk.Context, key []byte) math.Int {
+	bz := ctx.KVStore(k.storeKey).Get(key)
+	if bz == nil {
+		return math.ZeroInt()
+	}
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+func (k Keeper) setInt(ctx sdk.Context, key []byte, amount math.Int) {
+	bz, err := amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	ctx.KVStore(k.storeKey).Set(key, bz)
+}
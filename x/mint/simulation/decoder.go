@@ -6,6 +6,7 @@ import (
 
 	"github.com/celestiaorg/celestia-app/v3/x/mint/types"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 )
 
@@ -24,6 +25,20 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &genesisTimeA)
 			cdc.MustUnmarshal(kvB.Value, &genesisTimeB)
 			return fmt.Sprintf("%v\n%v", genesisTimeA, genesisTimeB)
+		case bytes.HasPrefix(kvA.Key, types.KeyPrefixBlockProvision):
+			var provisionA, provisionB types.BlockProvision
+			cdc.MustUnmarshal(kvA.Value, &provisionA)
+			cdc.MustUnmarshal(kvB.Value, &provisionB)
+			return fmt.Sprintf("%v\n%v", provisionA, provisionB)
+		case bytes.Equal(kvA.Key, types.KeyTotalMinted), bytes.Equal(kvA.Key, types.KeyBaselineSupply):
+			var amountA, amountB sdk.Int
+			if err := amountA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := amountB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", amountA, amountB)
 		default:
 			panic(fmt.Sprintf("invalid mint key %X", kvA.Key))
 		}
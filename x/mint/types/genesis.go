@@ -1,19 +1,24 @@
 package types
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(bondDenom string) *GenesisState {
 	return &GenesisState{
-		BondDenom: bondDenom,
+		BondDenom:      bondDenom,
+		TotalMinted:    sdk.ZeroInt(),
+		BaselineSupply: sdk.ZeroInt(),
 	}
 }
 
 // DefaultGenesisState creates a default GenesisState object
 func DefaultGenesisState() *GenesisState {
-	return &GenesisState{
-		BondDenom: DefaultBondDenom,
-	}
+	return NewGenesisState(DefaultBondDenom)
 }
 
 // ValidateGenesis validates the provided genesis state to ensure the
@@ -22,5 +27,39 @@ func ValidateGenesis(data GenesisState) error {
 	if data.BondDenom == "" {
 		return errors.New("bond denom cannot be empty")
 	}
+	if data.TotalMinted.IsNil() || data.BaselineSupply.IsNil() {
+		// genesis files from before block provisions were recorded don't
+		// contain them.
+		if len(data.BlockProvisions) != 0 {
+			return errors.New("total minted and baseline supply must be set if there are block provisions")
+		}
+		return nil
+	}
+	if len(data.BlockProvisions) == 0 {
+		if !data.TotalMinted.IsZero() || !data.BaselineSupply.IsZero() {
+			return errors.New("total minted and baseline supply must be zero if there are no block provisions")
+		}
+		return nil
+	}
+	if data.BaselineSupply.IsNegative() {
+		return fmt.Errorf("baseline supply cannot be negative: %s", data.BaselineSupply)
+	}
+
+	sum := sdk.ZeroInt()
+	for i, provision := range data.BlockProvisions {
+		if provision.Height <= 0 {
+			return fmt.Errorf("block provision height must be positive: %d", provision.Height)
+		}
+		if i > 0 && provision.Height <= data.BlockProvisions[i-1].Height {
+			return fmt.Errorf("block provisions must be sorted by height without duplicates: %d", provision.Height)
+		}
+		if provision.Amount.IsNil() || provision.Amount.IsNegative() {
+			return fmt.Errorf("block provision amount at height %d cannot be negative", provision.Height)
+		}
+		sum = sum.Add(provision.Amount)
+	}
+	if data.TotalMinted.LT(sum) {
+		return fmt.Errorf("total minted %s is less than the sum of the block provisions %s", data.TotalMinted, sum)
+	}
 	return nil
 }
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
 	math "math"
@@ -26,6 +29,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// BondDenom is the denomination of the token that should be minted.
 	BondDenom string `protobuf:"bytes,2,opt,name=bond_denom,json=bondDenom,proto3" json:"bond_denom,omitempty"`
+	// BlockProvisions are the recorded block provisions. They are only recorded
+	// for app versions > 2.
+	BlockProvisions []BlockProvision `protobuf:"bytes,3,rep,name=block_provisions,json=blockProvisions,proto3" json:"block_provisions"`
+	// TotalMinted is the cumulative number of tokens minted since block
+	// provisions started being recorded.
+	TotalMinted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=total_minted,json=totalMinted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_minted"`
+	// BaselineSupply is the total supply before the first recorded block
+	// provision.
+	BaselineSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=baseline_supply,json=baselineSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"baseline_supply"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +80,13 @@ func (m *GenesisState) GetBondDenom() string {
 	return ""
 }
 
+func (m *GenesisState) GetBlockProvisions() []BlockProvision {
+	if m != nil {
+		return m.BlockProvisions
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "celestia.mint.v1.GenesisState")
 }
@@ -75,18 +94,29 @@ func init() {
 func init() { proto.RegisterFile("celestia/mint/v1/genesis.proto", fileDescriptor_1932cb996a3161e7) }
 
 var fileDescriptor_1932cb996a3161e7 = []byte{
-	// 176 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x4e, 0xcd, 0x49,
-	0x2d, 0x2e, 0xc9, 0x4c, 0xd4, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd,
-	0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x80, 0xc9, 0xeb, 0x81,
-	0xe4, 0xf5, 0xca, 0x0c, 0x95, 0x8c, 0xb9, 0x78, 0xdc, 0x21, 0x4a, 0x82, 0x4b, 0x12, 0x4b, 0x52,
-	0x85, 0x64, 0xb9, 0xb8, 0x92, 0xf2, 0xf3, 0x52, 0xe2, 0x53, 0x52, 0xf3, 0xf2, 0x73, 0x25, 0x98,
-	0x14, 0x18, 0x35, 0x38, 0x83, 0x38, 0x41, 0x22, 0x2e, 0x20, 0x01, 0x2f, 0x16, 0x0e, 0x46, 0x01,
-	0x26, 0x27, 0xaf, 0x13, 0x8f, 0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71,
-	0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x48, 0xcf,
-	0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5, 0x87, 0xd9, 0x95, 0x5f, 0x94, 0x0e, 0x67,
-	0xeb, 0x26, 0x16, 0x14, 0xe8, 0x57, 0x40, 0x5c, 0x57, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06,
-	0x76, 0x99, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x47, 0xd0, 0x42, 0xbb, 0x00, 0x00, 0x00,
+	// 347 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x91, 0xcf, 0x4e, 0xea, 0x40,
+	0x14, 0xc6, 0x5b, 0xe0, 0xde, 0x5c, 0x06, 0x72, 0x21, 0x8d, 0x8b, 0x8a, 0x71, 0x20, 0x2e, 0x0c,
+	0x1b, 0xa6, 0xa2, 0x5b, 0x57, 0x8d, 0x89, 0x81, 0xc4, 0x44, 0x61, 0xe7, 0xa6, 0xe9, 0x9f, 0x49,
+	0x9d, 0xd0, 0xce, 0x69, 0x98, 0x81, 0xc8, 0x5b, 0xf8, 0x30, 0x3e, 0x04, 0x2b, 0x43, 0x5c, 0x19,
+	0x17, 0xc4, 0xc0, 0x8b, 0x98, 0x99, 0x16, 0xe3, 0x9f, 0xad, 0xab, 0x9e, 0x9e, 0xef, 0x9b, 0xef,
+	0x37, 0x67, 0x0e, 0xc2, 0x21, 0x4d, 0xa8, 0x90, 0xcc, 0x77, 0x52, 0xc6, 0xa5, 0x33, 0xef, 0x3b,
+	0x31, 0xe5, 0x54, 0x30, 0x41, 0xb2, 0x29, 0x48, 0xb0, 0x9a, 0x3b, 0x9d, 0x28, 0x9d, 0xcc, 0xfb,
+	0xad, 0xbd, 0x18, 0x62, 0xd0, 0xa2, 0xa3, 0xaa, 0xdc, 0xd7, 0xda, 0x0f, 0x41, 0xa4, 0x20, 0xbc,
+	0x5c, 0xc8, 0x7f, 0x0a, 0xe9, 0xe0, 0x07, 0x42, 0x47, 0x69, 0xf1, 0xe8, 0xa9, 0x84, 0xea, 0x97,
+	0x39, 0x71, 0x2c, 0x7d, 0x49, 0xad, 0x43, 0x84, 0x02, 0xe0, 0x91, 0x17, 0x51, 0x0e, 0xa9, 0x5d,
+	0xea, 0x98, 0xdd, 0xea, 0xa8, 0xaa, 0x3a, 0x17, 0xaa, 0x61, 0xdd, 0xa0, 0x66, 0x90, 0x40, 0x38,
+	0x51, 0xa0, 0x39, 0x13, 0x0c, 0xb8, 0xb0, 0xcb, 0x9d, 0x72, 0xb7, 0x76, 0xda, 0x21, 0xdf, 0xaf,
+	0x4a, 0x5c, 0xe5, 0xbc, 0xde, 0x19, 0xdd, 0xca, 0x72, 0xdd, 0x36, 0x46, 0x8d, 0xe0, 0x4b, 0x57,
+	0x58, 0x1e, 0xaa, 0x4b, 0x90, 0x7e, 0xe2, 0xa9, 0x63, 0x34, 0xb2, 0x2b, 0x8a, 0xe9, 0x9e, 0x2b,
+	0xf3, 0xeb, 0xba, 0x7d, 0x1c, 0x33, 0x79, 0x37, 0x0b, 0x48, 0x08, 0x69, 0x31, 0x56, 0xf1, 0xe9,
+	0x89, 0x68, 0xe2, 0xc8, 0x45, 0x46, 0x05, 0x19, 0x70, 0xf9, 0xfc, 0xd8, 0x43, 0xc5, 0xd4, 0x03,
+	0x2e, 0x47, 0x35, 0x9d, 0x78, 0xa5, 0x03, 0x2d, 0x8a, 0x1a, 0x81, 0x2f, 0x68, 0xc2, 0x38, 0xf5,
+	0xc4, 0x2c, 0xcb, 0x92, 0x85, 0xfd, 0xe7, 0x17, 0x18, 0xff, 0x77, 0xa1, 0x63, 0x9d, 0x39, 0xac,
+	0xfc, 0x33, 0x9b, 0x25, 0x77, 0xb8, 0xdc, 0x60, 0x73, 0xb5, 0xc1, 0xe6, 0xdb, 0x06, 0x9b, 0x0f,
+	0x5b, 0x6c, 0xac, 0xb6, 0xd8, 0x78, 0xd9, 0x62, 0xe3, 0xf6, 0xe4, 0x33, 0xa5, 0x78, 0x2a, 0x98,
+	0xc6, 0x1f, 0x75, 0xcf, 0xcf, 0x32, 0xe7, 0x3e, 0x5f, 0x92, 0x66, 0x06, 0x7f, 0xf5, 0x8e, 0xce,
+	0xde, 0x07, 0x00, 0x2b, 0x81, 0x83, 0x74, 0x25, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -109,6 +139,40 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BaselineSupply.Size()
+		i -= size
+		if _, err := m.BaselineSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TotalMinted.Size()
+		i -= size
+		if _, err := m.TotalMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.BlockProvisions) > 0 {
+		for iNdEx := len(m.BlockProvisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockProvisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.BondDenom) > 0 {
 		i -= len(m.BondDenom)
 		copy(dAtA[i:], m.BondDenom)
@@ -140,6 +204,16 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.BlockProvisions) > 0 {
+		for _, e := range m.BlockProvisions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.TotalMinted.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BaselineSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -210,6 +284,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.BondDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockProvisions = append(m.BlockProvisions, BlockProvision{})
+			if err := m.BlockProvisions[len(m.BlockProvisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaselineSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaselineSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,11 +1,30 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 // KeyMinter is the key to use for the Minter in the mint store.
 var KeyMinter = []byte("Minter")
 
 // KeyGenesisTime is the key to use for GenesisTime in the mint store.
 var KeyGenesisTime = []byte("GenesisTime")
 
+// KeyTotalMinted is the key to use for the cumulative number of tokens minted
+// since block provisions started being recorded in the mint store.
+var KeyTotalMinted = []byte("TotalMinted")
+
+// KeyBaselineSupply is the key to use for the total supply at the height that
+// block provisions started being recorded in the mint store.
+var KeyBaselineSupply = []byte("BaselineSupply")
+
+// KeyPrefixBlockProvision is the key prefix to use for block provisions in the
+// mint store.
+var KeyPrefixBlockProvision = []byte("BlockProvision")
+
+// BlockProvisionKey returns the key for the block provision at height.
+func BlockProvisionKey(height int64) []byte {
+	return append(append([]byte{}, KeyPrefixBlockProvision...), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
 const (
 	// ModuleName is the name of the mint module.
 	ModuleName = "mint"
@@ -20,4 +39,11 @@ const (
 	QueryInflationRate    = "inflation_rate"
 	QueryAnnualProvisions = "annual_provisions"
 	QueryGenesisTime      = "genesis_time"
+
+	// BlockProvisionRetention is the number of recent blocks for which block
+	// provisions are retained. Older block provisions are pruned. It is a
+	// constant rather than a param because this module has no params: like the
+	// other constants of this module it is only subject to change via
+	// hardforks.
+	BlockProvisionRetention = 100_000
 )
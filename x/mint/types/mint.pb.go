@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -137,39 +138,104 @@ func (m *GenesisTime) GetGenesisTime() *time.Time {
 	return nil
 }
 
+// BlockProvision is the amount of tokens minted in a block.
+type BlockProvision struct {
+	// Height is the height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// BlockTimeDelta is the time elapsed between the previous block and this
+	// block.
+	BlockTimeDelta time.Duration `protobuf:"bytes,2,opt,name=block_time_delta,json=blockTimeDelta,proto3,stdduration" json:"block_time_delta"`
+	// Amount is the number of tokens minted in this block.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *BlockProvision) Reset()         { *m = BlockProvision{} }
+func (m *BlockProvision) String() string { return proto.CompactTextString(m) }
+func (*BlockProvision) ProtoMessage()    {}
+func (*BlockProvision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_962d7cf1c9c59571, []int{2}
+}
+func (m *BlockProvision) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockProvision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockProvision.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockProvision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockProvision.Merge(m, src)
+}
+func (m *BlockProvision) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockProvision) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockProvision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockProvision proto.InternalMessageInfo
+
+func (m *BlockProvision) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockProvision) GetBlockTimeDelta() time.Duration {
+	if m != nil {
+		return m.BlockTimeDelta
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Minter)(nil), "celestia.mint.v1.Minter")
 	proto.RegisterType((*GenesisTime)(nil), "celestia.mint.v1.GenesisTime")
+	proto.RegisterType((*BlockProvision)(nil), "celestia.mint.v1.BlockProvision")
 }
 
 func init() { proto.RegisterFile("celestia/mint/v1/mint.proto", fileDescriptor_962d7cf1c9c59571) }
 
 var fileDescriptor_962d7cf1c9c59571 = []byte{
-	// 383 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xc1, 0xca, 0xd3, 0x40,
-	0x10, 0xce, 0x96, 0x5a, 0xe8, 0x56, 0xa5, 0x8d, 0x1e, 0x62, 0xc5, 0xa4, 0xf4, 0x20, 0xbd, 0x34,
-	0xb1, 0x7a, 0xf5, 0x14, 0x0b, 0x82, 0x20, 0x94, 0xe0, 0xc9, 0x4b, 0xd8, 0xa4, 0xdb, 0x75, 0x69,
-	0xb2, 0x13, 0xb2, 0x9b, 0xa0, 0x6f, 0xd1, 0x87, 0xf1, 0x21, 0xea, 0xad, 0x78, 0x12, 0x0f, 0x55,
-	0xda, 0x17, 0x91, 0xcd, 0x26, 0xd5, 0xa3, 0x87, 0xff, 0xb4, 0x33, 0xf3, 0xcd, 0x7c, 0xdf, 0xec,
-	0xc7, 0xe0, 0xa7, 0x29, 0xcd, 0xa8, 0x54, 0x9c, 0x04, 0x39, 0x17, 0x2a, 0xa8, 0x57, 0xcd, 0xeb,
-	0x17, 0x25, 0x28, 0xb0, 0xc7, 0x1d, 0xe8, 0x37, 0xc5, 0x7a, 0x35, 0x7d, 0xcc, 0x80, 0x41, 0x03,
-	0x06, 0x3a, 0x32, 0x7d, 0xd3, 0x27, 0x29, 0xc8, 0x1c, 0x64, 0x6c, 0x00, 0x93, 0xb4, 0x90, 0xc7,
-	0x00, 0x58, 0x46, 0x83, 0x26, 0x4b, 0xaa, 0x5d, 0xa0, 0x78, 0x4e, 0xa5, 0x22, 0x79, 0x61, 0x1a,
-	0xe6, 0xdf, 0x7a, 0x78, 0xf0, 0x9e, 0x0b, 0x45, 0x4b, 0x3b, 0xc5, 0x0f, 0xb9, 0xd8, 0x65, 0x44,
-	0x71, 0x10, 0x71, 0x49, 0x14, 0x75, 0xd0, 0x0c, 0x2d, 0x86, 0xe1, 0xeb, 0xe3, 0xd9, 0xb3, 0x7e,
-	0x9e, 0xbd, 0xe7, 0x8c, 0xab, 0x4f, 0x55, 0xe2, 0xa7, 0x90, 0xb7, 0x22, 0xed, 0xb3, 0x94, 0xdb,
-	0x7d, 0xa0, 0xbe, 0x14, 0x54, 0xfa, 0x6b, 0x9a, 0x7e, 0xff, 0xba, 0xc4, 0xed, 0x0e, 0x6b, 0x9a,
-	0x46, 0x0f, 0x6e, 0x9c, 0x11, 0x51, 0xd4, 0xe6, 0x78, 0x42, 0x84, 0xa8, 0x48, 0xa6, 0xb7, 0xad,
-	0xb9, 0xe4, 0x20, 0xa4, 0xd3, 0xbb, 0x03, 0x9d, 0xb1, 0xa1, 0xdd, 0xdc, 0x58, 0xed, 0x0d, 0x7e,
-	0x54, 0x94, 0xb4, 0xe6, 0x50, 0xc9, 0x38, 0xc9, 0x20, 0xdd, 0xc7, 0xfa, 0xf3, 0x4e, 0x7f, 0x86,
-	0x16, 0xa3, 0x97, 0x53, 0xdf, 0x38, 0xe3, 0x77, 0xce, 0xf8, 0x1f, 0x3a, 0x67, 0xc2, 0xfe, 0xe1,
-	0x97, 0x87, 0xa2, 0x49, 0x37, 0x1c, 0xea, 0x59, 0x8d, 0xda, 0xcf, 0x30, 0x4e, 0x40, 0x6c, 0xe3,
-	0x2d, 0x15, 0x90, 0x3b, 0xf7, 0xf4, 0xd6, 0xd1, 0x50, 0x57, 0xd6, 0xba, 0x30, 0x8f, 0xf0, 0xe8,
-	0x2d, 0x15, 0x54, 0x72, 0xd9, 0x74, 0xbf, 0xc1, 0xf7, 0x99, 0x49, 0x8d, 0x30, 0xfa, 0x4f, 0xe1,
-	0x11, 0xfb, 0x4b, 0x12, 0xbe, 0x3b, 0x5e, 0x5c, 0x74, 0xba, 0xb8, 0xe8, 0xf7, 0xc5, 0x45, 0x87,
-	0xab, 0x6b, 0x9d, 0xae, 0xae, 0xf5, 0xe3, 0xea, 0x5a, 0x1f, 0x5f, 0xfc, 0x6b, 0x53, 0x7b, 0x28,
-	0x50, 0xb2, 0x5b, 0xbc, 0x24, 0x45, 0x11, 0x7c, 0x36, 0x77, 0xd5, 0x98, 0x96, 0x0c, 0x1a, 0xc9,
-	0x57, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x71, 0xfd, 0xfc, 0xbb, 0x75, 0x02, 0x00, 0x00,
+	// 472 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0xdb, 0x12, 0xd1, 0x0d, 0x44, 0xa9, 0x41, 0xc8, 0x0d, 0xc2, 0xa9, 0x72, 0x40, 0xbd,
+	0xc4, 0xa6, 0xe5, 0xca, 0xc9, 0x58, 0x42, 0x45, 0xaa, 0x54, 0x59, 0x3d, 0x71, 0xb1, 0xd6, 0xf6,
+	0x76, 0xb3, 0xaa, 0xbd, 0x63, 0x79, 0xd7, 0x11, 0xbc, 0x45, 0x8f, 0x3c, 0x08, 0x0f, 0x51, 0x0e,
+	0x48, 0x15, 0x27, 0xc4, 0xa1, 0xa0, 0xe4, 0x45, 0xd0, 0xfe, 0x38, 0x8d, 0xe0, 0x02, 0x12, 0x27,
+	0xef, 0xcc, 0x37, 0xf3, 0x7d, 0x33, 0xdf, 0x7a, 0xd1, 0xd3, 0x9c, 0x94, 0x44, 0x48, 0x86, 0xc3,
+	0x8a, 0x71, 0x19, 0x2e, 0x8e, 0xf4, 0x37, 0xa8, 0x1b, 0x90, 0xe0, 0x8e, 0x3a, 0x30, 0xd0, 0xc9,
+	0xc5, 0xd1, 0xf8, 0x31, 0x05, 0x0a, 0x1a, 0x0c, 0xd5, 0xc9, 0xd4, 0x8d, 0xf7, 0x73, 0x10, 0x15,
+	0x88, 0xd4, 0x00, 0x26, 0xb0, 0xd0, 0x84, 0x02, 0xd0, 0x92, 0x84, 0x3a, 0xca, 0xda, 0x8b, 0x50,
+	0xb2, 0x8a, 0x08, 0x89, 0xab, 0xda, 0x16, 0xf8, 0xbf, 0x17, 0x14, 0x6d, 0x83, 0x25, 0x03, 0x6e,
+	0xf0, 0xe9, 0xe7, 0x2d, 0xd4, 0x3f, 0x65, 0x5c, 0x92, 0xc6, 0xcd, 0xd1, 0x90, 0xf1, 0x8b, 0x52,
+	0xa3, 0x69, 0x83, 0x25, 0xf1, 0x9c, 0x03, 0xe7, 0x70, 0x37, 0x7a, 0x75, 0x7d, 0x3b, 0xe9, 0x7d,
+	0xbf, 0x9d, 0x3c, 0xa7, 0x4c, 0xce, 0xdb, 0x2c, 0xc8, 0xa1, 0xb2, 0x43, 0xd8, 0xcf, 0x4c, 0x14,
+	0x97, 0xa1, 0xfc, 0x50, 0x13, 0x11, 0xc4, 0x24, 0xff, 0xfa, 0x69, 0x86, 0xec, 0x8c, 0x31, 0xc9,
+	0x93, 0x87, 0x6b, 0xce, 0x04, 0x4b, 0xe2, 0x32, 0xb4, 0x87, 0x39, 0x6f, 0x71, 0xa9, 0xb6, 0x59,
+	0x30, 0xc1, 0x80, 0x0b, 0x6f, 0xeb, 0x3f, 0xe8, 0x8c, 0x0c, 0xed, 0xd9, 0x9a, 0xd5, 0x3d, 0x43,
+	0x8f, 0xea, 0x86, 0x2c, 0x18, 0xb4, 0x22, 0xcd, 0x4a, 0xc8, 0x2f, 0x53, 0x65, 0x8e, 0xb7, 0x73,
+	0xe0, 0x1c, 0x0e, 0x8e, 0xc7, 0x81, 0x31, 0x26, 0xe8, 0x8c, 0x09, 0xce, 0x3b, 0xe7, 0xa2, 0x9d,
+	0xab, 0x1f, 0x13, 0x27, 0xd9, 0xeb, 0x9a, 0x23, 0xd5, 0xab, 0x50, 0xf7, 0x19, 0x42, 0x19, 0xf0,
+	0x22, 0x2d, 0x08, 0x87, 0xca, 0xbb, 0xa7, 0xa6, 0x4e, 0x76, 0x55, 0x26, 0x56, 0x89, 0x69, 0x82,
+	0x06, 0x6f, 0x08, 0x27, 0x82, 0x09, 0x5d, 0xfd, 0x1a, 0x3d, 0xa0, 0x26, 0x34, 0xc2, 0xce, 0x5f,
+	0x0a, 0x0f, 0xe8, 0x1d, 0xc9, 0xf4, 0x8b, 0x83, 0x86, 0x7a, 0x80, 0xf5, 0x62, 0xee, 0x13, 0xd4,
+	0x9f, 0x13, 0x46, 0xe7, 0x52, 0x33, 0x6e, 0x27, 0x36, 0x72, 0x4f, 0xd1, 0xe8, 0x6e, 0xcd, 0xb4,
+	0x20, 0xa5, 0xc4, 0xda, 0xd9, 0xc1, 0xf1, 0xfe, 0x1f, 0x9a, 0xb1, 0xfd, 0x0b, 0xa2, 0xfb, 0xca,
+	0xf4, 0x8f, 0x4a, 0x76, 0x98, 0x75, 0x7b, 0xc6, 0xaa, 0xd5, 0x3d, 0x47, 0x7d, 0x5c, 0x41, 0xcb,
+	0xa5, 0xb7, 0xfd, 0xcf, 0xd7, 0x73, 0xc2, 0xe5, 0xc6, 0xf5, 0x9c, 0x70, 0x99, 0x58, 0xae, 0xe8,
+	0xed, 0xf5, 0xd2, 0x77, 0x6e, 0x96, 0xbe, 0xf3, 0x73, 0xe9, 0x3b, 0x57, 0x2b, 0xbf, 0x77, 0xb3,
+	0xf2, 0x7b, 0xdf, 0x56, 0x7e, 0xef, 0xdd, 0x8b, 0x4d, 0x5e, 0xfb, 0x30, 0xa0, 0xa1, 0xeb, 0xf3,
+	0x0c, 0xd7, 0x75, 0xf8, 0xde, 0xbc, 0x23, 0xad, 0x92, 0xf5, 0xf5, 0x3a, 0x2f, 0x7f, 0x0d, 0x00,
+	0xb9, 0x3b, 0xe0, 0xee, 0x65, 0x03, 0x00, 0x00,
 }
 
 func (m *Minter) Marshal() (dAtA []byte, err error) {
@@ -265,6 +331,52 @@ func (m *GenesisTime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlockProvision) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockProvision) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockProvision) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.BlockTimeDelta, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.BlockTimeDelta):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintMint(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMint(v)
 	base := offset
@@ -310,6 +422,22 @@ func (m *GenesisTime) Size() (n int) {
 	return n
 }
 
+func (m *BlockProvision) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.BlockTimeDelta)
+	n += 1 + l + sovMint(uint64(l))
+	l = m.Amount.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
 func sovMint(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -588,6 +716,142 @@ func (m *GenesisTime) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BlockProvision) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockProvision: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockProvision: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeDelta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.BlockTimeDelta, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMint(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
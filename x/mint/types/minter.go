@@ -42,7 +42,12 @@ func (m Minter) Validate() error {
 // the current block height in context. The inflation rate is expected to
 // decrease every year according to the schedule specified in the README.
 func (m Minter) CalculateInflationRate(ctx sdk.Context, genesis time.Time) sdk.Dec {
-	years := yearsSinceGenesis(genesis, ctx.BlockTime())
+	return inflationRateForYear(yearsSinceGenesis(genesis, ctx.BlockTime()))
+}
+
+// inflationRateForYear returns the inflation rate for the given number of
+// years since genesis.
+func inflationRateForYear(years int64) sdk.Dec {
 	inflationRate := InitialInflationRateAsDec().Mul(sdk.OneDec().Sub(DisinflationRateAsDec()).Power(uint64(years)))
 
 	if inflationRate.LT(TargetInflationRateAsDec()) {
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// MaxProjectionYears is the maximum number of years that can be projected by
+// ProjectSupply.
+const MaxProjectionYears = 100
+
+// ProjectSupply projects the inflation rate, annual provisions and total
+// supply from the current minter state until end. The first projection
+// reflects current. A projection is added for every genesis anniversary
+// between current and end, and a final projection is added for end if it does
+// not fall on an anniversary.
+//
+// Provisions are computed with CalculateBlockProvision over the period between
+// two projections. The chain truncates the provision of every block, so the
+// projected supply is an upper bound that may exceed the actual supply by at
+// most one utia per block.
+func ProjectSupply(minter Minter, genesis time.Time, current time.Time, supply math.Int, end time.Time) ([]SupplyProjection, error) {
+	if end.Before(current) {
+		return nil, fmt.Errorf("end time %v cannot be before current time %v", end, current)
+	}
+	year := yearsSinceGenesis(genesis, current)
+	if yearsSinceGenesis(genesis, end)-year > MaxProjectionYears {
+		return nil, fmt.Errorf("cannot project more than %d years", MaxProjectionYears)
+	}
+
+	if minter.AnnualProvisions.IsZero() {
+		// The minter is expected to have zero annual provisions at genesis.
+		minter.InflationRate = inflationRateForYear(year)
+		minter.AnnualProvisions = minter.InflationRate.MulInt(supply)
+	}
+
+	projections := []SupplyProjection{newSupplyProjection(year, current, minter, supply)}
+	previous := current
+	for {
+		year++
+		anniversary := GenesisAnniversary(genesis, year)
+		if anniversary.After(end) {
+			break
+		}
+		provision, err := minter.CalculateBlockProvision(anniversary, previous)
+		if err != nil {
+			return nil, err
+		}
+		supply = supply.Add(provision.Amount)
+		minter.InflationRate = inflationRateForYear(year)
+		minter.AnnualProvisions = minter.InflationRate.MulInt(supply)
+		projections = append(projections, newSupplyProjection(year, anniversary, minter, supply))
+		previous = anniversary
+	}
+
+	if end.After(previous) {
+		provision, err := minter.CalculateBlockProvision(end, previous)
+		if err != nil {
+			return nil, err
+		}
+		supply = supply.Add(provision.Amount)
+		projections = append(projections, newSupplyProjection(year-1, end, minter, supply))
+	}
+	return projections, nil
+}
+
+// ProjectionEndTime returns the genesis anniversary that occurs the given
+// number of years after the start of the current year. It returns current if
+// years is zero.
+func ProjectionEndTime(genesis time.Time, current time.Time, years uint64) time.Time {
+	if years == 0 {
+		return current
+	}
+	return GenesisAnniversary(genesis, yearsSinceGenesis(genesis, current)+int64(min(years, MaxProjectionYears+1)))
+}
+
+// GenesisAnniversary returns the time at which the given number of years have
+// elapsed since genesis.
+func GenesisAnniversary(genesis time.Time, years int64) time.Time {
+	return genesis.Add(time.Duration(years * NanosecondsPerYear))
+}
+
+func newSupplyProjection(year int64, t time.Time, minter Minter, supply math.Int) SupplyProjection {
+	return SupplyProjection{
+		Year:             uint64(year),
+		Time:             t,
+		InflationRate:    minter.InflationRate,
+		AnnualProvisions: minter.AnnualProvisions,
+		TotalSupply:      supply,
+	}
+}
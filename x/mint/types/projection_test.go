@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestProjectSupply(t *testing.T) {
+	genesis := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	supply := math.NewInt(1_000_000_000_000)
+	minter := DefaultMinter()
+	minter.AnnualProvisions = minter.InflationRate.MulInt(supply)
+	halfYear := time.Duration(NanosecondsPerYear / 2)
+
+	t.Run("projects each year boundary", func(t *testing.T) {
+		end := ProjectionEndTime(genesis, genesis, 3)
+		projections, err := ProjectSupply(minter, genesis, genesis, supply, end)
+		require.NoError(t, err)
+		require.Len(t, projections, 4)
+
+		want := supply
+		for i, projection := range projections {
+			assert.Equal(t, uint64(i), projection.Year)
+			assert.Equal(t, GenesisAnniversary(genesis, int64(i)), projection.Time)
+			assert.Equal(t, inflationRateForYear(int64(i)), projection.InflationRate)
+			assert.Equal(t, projection.InflationRate.MulInt(want), projection.AnnualProvisions)
+			assert.Equal(t, want, projection.TotalSupply)
+			want = want.Add(projection.AnnualProvisions.TruncateInt())
+		}
+		assert.Equal(t, math.NewInt(1_080_000_000_000), projections[1].TotalSupply)
+	})
+
+	t.Run("matches block provisions for a partial year", func(t *testing.T) {
+		current := genesis.Add(halfYear)
+		end := current.Add(time.Hour)
+		projections, err := ProjectSupply(minter, genesis, current, supply, end)
+		require.NoError(t, err)
+		require.Len(t, projections, 2)
+
+		provision, err := minter.CalculateBlockProvision(end, current)
+		require.NoError(t, err)
+		assert.Equal(t, end, projections[1].Time)
+		assert.Equal(t, supply.Add(provision.Amount), projections[1].TotalSupply)
+	})
+
+	t.Run("projects until a time after a year boundary", func(t *testing.T) {
+		current := genesis.Add(halfYear)
+		end := GenesisAnniversary(genesis, 1).Add(halfYear)
+		projections, err := ProjectSupply(minter, genesis, current, supply, end)
+		require.NoError(t, err)
+		require.Len(t, projections, 3)
+		assert.Equal(t, uint64(1), projections[1].Year)
+		assert.Equal(t, uint64(1), projections[2].Year)
+		assert.Equal(t, sdk.NewDecWithPrec(72, 3), projections[2].InflationRate)
+	})
+
+	t.Run("sets annual provisions at genesis", func(t *testing.T) {
+		projections, err := ProjectSupply(DefaultMinter(), genesis, genesis, supply, genesis)
+		require.NoError(t, err)
+		require.Len(t, projections, 1)
+		assert.Equal(t, minter.AnnualProvisions, projections[0].AnnualProvisions)
+	})
+
+	t.Run("rejects end before current", func(t *testing.T) {
+		_, err := ProjectSupply(minter, genesis, genesis.Add(time.Hour), supply, genesis)
+		require.Error(t, err)
+	})
+
+	t.Run("rejects too many years", func(t *testing.T) {
+		end := ProjectionEndTime(genesis, genesis, MaxProjectionYears+1)
+		_, err := ProjectSupply(minter, genesis, genesis, supply, end)
+		require.Error(t, err)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -275,6 +276,279 @@ func (m *QueryGenesisTimeResponse) GetGenesisTime() *time.Time {
 	return nil
 }
 
+// QuerySupplyProjectionRequest is the request type for the
+// Query/SupplyProjection RPC method.
+type QuerySupplyProjectionRequest struct {
+	// Years is the number of future years to project. It is ignored if Time is
+	// set.
+	Years uint64 `protobuf:"varint,1,opt,name=years,proto3" json:"years,omitempty"`
+	// Time is an optional future timestamp to project until. If set, the
+	// response contains a projection for every year boundary before Time and a
+	// final projection at Time.
+	Time *time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+}
+
+func (m *QuerySupplyProjectionRequest) Reset()         { *m = QuerySupplyProjectionRequest{} }
+func (m *QuerySupplyProjectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyProjectionRequest) ProtoMessage()    {}
+func (*QuerySupplyProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{6}
+}
+func (m *QuerySupplyProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyProjectionRequest.Merge(m, src)
+}
+func (m *QuerySupplyProjectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyProjectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyProjectionRequest proto.InternalMessageInfo
+
+func (m *QuerySupplyProjectionRequest) GetYears() uint64 {
+	if m != nil {
+		return m.Years
+	}
+	return 0
+}
+
+func (m *QuerySupplyProjectionRequest) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+// QuerySupplyProjectionResponse is the response type for the
+// Query/SupplyProjection RPC method.
+type QuerySupplyProjectionResponse struct {
+	// Projections are ordered by time. The first projection reflects the current
+	// block.
+	Projections []SupplyProjection `protobuf:"bytes,1,rep,name=projections,proto3" json:"projections"`
+}
+
+func (m *QuerySupplyProjectionResponse) Reset()         { *m = QuerySupplyProjectionResponse{} }
+func (m *QuerySupplyProjectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyProjectionResponse) ProtoMessage()    {}
+func (*QuerySupplyProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{7}
+}
+func (m *QuerySupplyProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyProjectionResponse.Merge(m, src)
+}
+func (m *QuerySupplyProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyProjectionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySupplyProjectionResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyProjectionResponse) GetProjections() []SupplyProjection {
+	if m != nil {
+		return m.Projections
+	}
+	return nil
+}
+
+// SupplyProjection is the projected state of the mint module at a point in
+// time.
+type SupplyProjection struct {
+	// Year is the number of years elapsed since genesis.
+	Year uint64 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// Time is the timestamp that this projection applies to.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// InflationRate is the projected inflation rate.
+	InflationRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=inflation_rate,json=inflationRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_rate"`
+	// AnnualProvisions is the projected annual provisions.
+	AnnualProvisions github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=annual_provisions,json=annualProvisions,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"annual_provisions"`
+	// TotalSupply is the projected total supply of the bond denom.
+	TotalSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=total_supply,json=totalSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_supply"`
+}
+
+func (m *SupplyProjection) Reset()         { *m = SupplyProjection{} }
+func (m *SupplyProjection) String() string { return proto.CompactTextString(m) }
+func (*SupplyProjection) ProtoMessage()    {}
+func (*SupplyProjection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{8}
+}
+func (m *SupplyProjection) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SupplyProjection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyProjection.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SupplyProjection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyProjection.Merge(m, src)
+}
+func (m *SupplyProjection) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyProjection) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyProjection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SupplyProjection proto.InternalMessageInfo
+
+func (m *SupplyProjection) GetYear() uint64 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *SupplyProjection) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+// QueryBlockProvisionsRequest is the request type for the
+// Query/BlockProvisions RPC method.
+type QueryBlockProvisionsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlockProvisionsRequest) Reset()         { *m = QueryBlockProvisionsRequest{} }
+func (m *QueryBlockProvisionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockProvisionsRequest) ProtoMessage()    {}
+func (*QueryBlockProvisionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{9}
+}
+func (m *QueryBlockProvisionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockProvisionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockProvisionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockProvisionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockProvisionsRequest.Merge(m, src)
+}
+func (m *QueryBlockProvisionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockProvisionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockProvisionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockProvisionsRequest proto.InternalMessageInfo
+
+func (m *QueryBlockProvisionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryBlockProvisionsResponse is the response type for the
+// Query/BlockProvisions RPC method.
+type QueryBlockProvisionsResponse struct {
+	// BlockProvisions are ordered by height.
+	BlockProvisions []BlockProvision `protobuf:"bytes,1,rep,name=block_provisions,json=blockProvisions,proto3" json:"block_provisions"`
+	// TotalMinted is the cumulative number of tokens minted since block
+	// provisions started being recorded.
+	TotalMinted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=total_minted,json=totalMinted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_minted"`
+	Pagination  *query.PageResponse                    `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBlockProvisionsResponse) Reset()         { *m = QueryBlockProvisionsResponse{} }
+func (m *QueryBlockProvisionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlockProvisionsResponse) ProtoMessage()    {}
+func (*QueryBlockProvisionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1ed5b0ae449a133, []int{10}
+}
+func (m *QueryBlockProvisionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlockProvisionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlockProvisionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBlockProvisionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlockProvisionsResponse.Merge(m, src)
+}
+func (m *QueryBlockProvisionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlockProvisionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlockProvisionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBlockProvisionsResponse proto.InternalMessageInfo
+
+func (m *QueryBlockProvisionsResponse) GetBlockProvisions() []BlockProvision {
+	if m != nil {
+		return m.BlockProvisions
+	}
+	return nil
+}
+
+func (m *QueryBlockProvisionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryInflationRateRequest)(nil), "celestia.mint.v1.QueryInflationRateRequest")
 	proto.RegisterType((*QueryInflationRateResponse)(nil), "celestia.mint.v1.QueryInflationRateResponse")
@@ -282,44 +556,68 @@ func init() {
 	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "celestia.mint.v1.QueryAnnualProvisionsResponse")
 	proto.RegisterType((*QueryGenesisTimeRequest)(nil), "celestia.mint.v1.QueryGenesisTimeRequest")
 	proto.RegisterType((*QueryGenesisTimeResponse)(nil), "celestia.mint.v1.QueryGenesisTimeResponse")
+	proto.RegisterType((*QuerySupplyProjectionRequest)(nil), "celestia.mint.v1.QuerySupplyProjectionRequest")
+	proto.RegisterType((*QuerySupplyProjectionResponse)(nil), "celestia.mint.v1.QuerySupplyProjectionResponse")
+	proto.RegisterType((*SupplyProjection)(nil), "celestia.mint.v1.SupplyProjection")
+	proto.RegisterType((*QueryBlockProvisionsRequest)(nil), "celestia.mint.v1.QueryBlockProvisionsRequest")
+	proto.RegisterType((*QueryBlockProvisionsResponse)(nil), "celestia.mint.v1.QueryBlockProvisionsResponse")
 }
 
 func init() { proto.RegisterFile("celestia/mint/v1/query.proto", fileDescriptor_a1ed5b0ae449a133) }
 
 var fileDescriptor_a1ed5b0ae449a133 = []byte{
-	// 499 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x4d, 0x6e, 0x13, 0x31,
-	0x1c, 0xc5, 0x63, 0xbe, 0x16, 0x4e, 0x8b, 0x82, 0x85, 0x44, 0x3b, 0x0d, 0x13, 0x98, 0x8a, 0xaa,
-	0xa5, 0xd4, 0x26, 0xe5, 0x04, 0x04, 0x24, 0x04, 0x2b, 0x88, 0xca, 0x06, 0x16, 0x91, 0x13, 0x5c,
-	0x63, 0x91, 0xb1, 0xdd, 0xb1, 0x27, 0xa2, 0x12, 0x2b, 0x2e, 0x40, 0x25, 0x16, 0x1c, 0x80, 0xcb,
-	0x74, 0x59, 0x89, 0x0d, 0xb0, 0x28, 0x28, 0xe1, 0x20, 0x68, 0x3c, 0x9e, 0x90, 0xaf, 0x91, 0x22,
-	0x56, 0x71, 0xe6, 0xfd, 0xe7, 0xff, 0x7e, 0xe3, 0xf7, 0x60, 0xbd, 0xc7, 0xfa, 0xcc, 0x58, 0x41,
-	0x49, 0x2c, 0xa4, 0x25, 0x83, 0x26, 0x39, 0x4a, 0x59, 0x72, 0x8c, 0x75, 0xa2, 0xac, 0x42, 0xb5,
-	0x42, 0xc5, 0x99, 0x8a, 0x07, 0xcd, 0xe0, 0x3a, 0x57, 0x5c, 0x39, 0x91, 0x64, 0xa7, 0x7c, 0x2e,
-	0xa8, 0x73, 0xa5, 0x78, 0x9f, 0x11, 0xaa, 0x05, 0xa1, 0x52, 0x2a, 0x4b, 0xad, 0x50, 0xd2, 0x78,
-	0x75, 0x63, 0xce, 0xc3, 0x6d, 0xcb, 0xc5, 0x86, 0x7f, 0xd5, 0xfd, 0xeb, 0xa6, 0x87, 0xc4, 0x8a,
-	0x98, 0x19, 0x4b, 0x63, 0x9d, 0x0f, 0x44, 0x1b, 0x70, 0xfd, 0x45, 0x86, 0xf4, 0x54, 0x1e, 0xf6,
-	0xdd, 0xda, 0x36, 0xb5, 0xac, 0xcd, 0x8e, 0x52, 0x66, 0x6c, 0x64, 0x60, 0xb0, 0x48, 0x34, 0x5a,
-	0x49, 0xc3, 0xd0, 0x4b, 0x78, 0x55, 0x14, 0x42, 0x27, 0xa1, 0x96, 0xad, 0x81, 0x5b, 0x60, 0x7b,
-	0xa5, 0x85, 0x4f, 0xcf, 0x1b, 0x95, 0x9f, 0xe7, 0x8d, 0x2d, 0x2e, 0xec, 0xdb, 0xb4, 0x8b, 0x7b,
-	0x2a, 0x26, 0x3d, 0x65, 0x62, 0x65, 0xfc, 0xcf, 0x9e, 0x79, 0xf3, 0x8e, 0xd8, 0x63, 0xcd, 0x0c,
-	0x7e, 0xcc, 0x7a, 0xed, 0x55, 0x31, 0xb9, 0x3e, 0x0a, 0x61, 0xdd, 0x99, 0x3e, 0x94, 0x32, 0xa5,
-	0xfd, 0xe7, 0x89, 0x1a, 0x08, 0x93, 0x7d, 0x6e, 0x01, 0xf5, 0x01, 0xde, 0x2c, 0xd1, 0x3d, 0xd7,
-	0x6b, 0x78, 0x8d, 0x3a, 0xad, 0xa3, 0xc7, 0xe2, 0x7f, 0xa2, 0xd5, 0xe8, 0x8c, 0x49, 0xb4, 0x0e,
-	0x6f, 0x38, 0xf7, 0x27, 0x4c, 0x32, 0x23, 0xcc, 0x81, 0x88, 0xc7, 0xb7, 0xd5, 0x81, 0x6b, 0xf3,
-	0x92, 0x67, 0x7a, 0x04, 0x57, 0x78, 0xfe, 0xb8, 0x93, 0x25, 0xe0, 0x70, 0xaa, 0xfb, 0x01, 0xce,
-	0xe3, 0xc1, 0x45, 0x3c, 0xf8, 0xa0, 0x88, 0xa7, 0x75, 0xe9, 0xe4, 0x57, 0x03, 0xb4, 0xab, 0xfc,
-	0xdf, 0xb2, 0xfd, 0x1f, 0x17, 0xe1, 0x65, 0xe7, 0x80, 0xbe, 0x00, 0xb8, 0x3a, 0x15, 0x0a, 0xda,
-	0xc5, 0xb3, 0x65, 0xc2, 0xa5, 0xb9, 0x06, 0xf7, 0x96, 0x1b, 0xce, 0xd9, 0xa3, 0xdd, 0x8f, 0xdf,
-	0xfe, 0x7c, 0xbe, 0x70, 0x07, 0x6d, 0x16, 0x57, 0xe5, 0x7b, 0xd6, 0x65, 0x96, 0x36, 0xc9, 0x74,
-	0x05, 0xd0, 0x57, 0x00, 0x6b, 0xb3, 0xc9, 0x20, 0x5c, 0xe2, 0x57, 0x12, 0x71, 0x40, 0x96, 0x9e,
-	0xf7, 0x88, 0xd8, 0x21, 0x6e, 0xa3, 0xad, 0x85, 0x88, 0x73, 0x6d, 0x40, 0x9f, 0x00, 0xac, 0x4e,
-	0xc4, 0x84, 0x76, 0x4a, 0x0c, 0xe7, 0x53, 0x0e, 0xee, 0x2e, 0x33, 0xea, 0xb1, 0x76, 0x1c, 0xd6,
-	0x26, 0xba, 0xbd, 0x10, 0x6b, 0xb2, 0x10, 0xad, 0x67, 0xa7, 0xc3, 0x10, 0x9c, 0x0d, 0x43, 0xf0,
-	0x7b, 0x18, 0x82, 0x93, 0x51, 0x58, 0x39, 0x1b, 0x85, 0x95, 0xef, 0xa3, 0xb0, 0xf2, 0xea, 0xfe,
-	0x64, 0x57, 0xbd, 0xb5, 0x4a, 0xf8, 0xf8, 0xbc, 0x47, 0xb5, 0x26, 0xef, 0xf3, 0xd5, 0xae, 0xb9,
-	0xdd, 0x2b, 0xae, 0x4e, 0x0f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xd1, 0xb5, 0x7b, 0x2f, 0x7e,
-	0x04, 0x00, 0x00,
+	// 810 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xee, 0x96, 0x42, 0xcc, 0x14, 0xa4, 0x4e, 0x48, 0x2c, 0x4b, 0x69, 0x71, 0x51, 0xe4, 0x87,
+	0xcc, 0x5a, 0xf4, 0xe0, 0xd5, 0x6a, 0x24, 0x90, 0x98, 0xc0, 0x8a, 0x17, 0x3d, 0x34, 0xd3, 0x32,
+	0xac, 0x0b, 0xed, 0xce, 0xd2, 0x99, 0x36, 0x36, 0xf1, 0xe4, 0xdd, 0x48, 0xe2, 0xc1, 0x83, 0x1e,
+	0x4c, 0xfc, 0x67, 0x38, 0x92, 0x78, 0x31, 0x1e, 0xd0, 0x80, 0x89, 0xff, 0x86, 0xd9, 0x99, 0xe9,
+	0xaf, 0xfd, 0x11, 0xab, 0x72, 0x62, 0xbb, 0xef, 0xcd, 0xfb, 0xbe, 0xf7, 0xcd, 0x7b, 0xdf, 0x02,
+	0x72, 0x55, 0x52, 0x23, 0x8c, 0x3b, 0xd8, 0xac, 0x3b, 0x2e, 0x37, 0x5b, 0x45, 0xf3, 0xb0, 0x49,
+	0x1a, 0x6d, 0xe4, 0x35, 0x28, 0xa7, 0x30, 0xd3, 0x89, 0x22, 0x3f, 0x8a, 0x5a, 0x45, 0x7d, 0xca,
+	0xa6, 0x36, 0x15, 0x41, 0xd3, 0x7f, 0x92, 0x79, 0x7a, 0xce, 0xa6, 0xd4, 0xae, 0x11, 0x13, 0x7b,
+	0x8e, 0x89, 0x5d, 0x97, 0x72, 0xcc, 0x1d, 0xea, 0x32, 0x15, 0x9d, 0x09, 0x61, 0x88, 0x6a, 0x32,
+	0x58, 0x50, 0x47, 0xc5, 0xaf, 0x4a, 0x73, 0xcf, 0xe4, 0x4e, 0x9d, 0x30, 0x8e, 0xeb, 0x9e, 0x4a,
+	0x58, 0xae, 0x52, 0x56, 0xa7, 0xcc, 0xac, 0x60, 0x46, 0x24, 0x39, 0xb3, 0x55, 0xac, 0x10, 0x8e,
+	0x8b, 0xa6, 0x87, 0x6d, 0xc7, 0x15, 0x50, 0x32, 0xd7, 0x98, 0x01, 0xd3, 0xdb, 0x7e, 0xc6, 0x86,
+	0xbb, 0x57, 0x13, 0xef, 0x2d, 0xcc, 0x89, 0x45, 0x0e, 0x9b, 0x84, 0x71, 0x83, 0x01, 0x3d, 0x2a,
+	0xc8, 0x3c, 0xea, 0x32, 0x02, 0x9f, 0x82, 0xcb, 0x4e, 0x27, 0x50, 0x6e, 0x60, 0x4e, 0xb2, 0xda,
+	0x9c, 0xb6, 0x38, 0x5e, 0x42, 0xc7, 0xa7, 0x85, 0xc4, 0xb7, 0xd3, 0xc2, 0x82, 0xed, 0xf0, 0x17,
+	0xcd, 0x0a, 0xaa, 0xd2, 0xba, 0xa9, 0x18, 0xc9, 0x3f, 0xab, 0x6c, 0xf7, 0xc0, 0xe4, 0x6d, 0x8f,
+	0x30, 0xf4, 0x90, 0x54, 0xad, 0x09, 0xa7, 0xbf, 0xbc, 0x91, 0x07, 0x39, 0x01, 0x7a, 0xdf, 0x75,
+	0x9b, 0xb8, 0xb6, 0xd5, 0xa0, 0x2d, 0x87, 0xf9, 0xd2, 0x74, 0x48, 0xbd, 0x02, 0xb3, 0x31, 0x71,
+	0xc5, 0xeb, 0x39, 0xb8, 0x82, 0x45, 0xac, 0xec, 0x75, 0x83, 0xff, 0x48, 0x2d, 0x83, 0x03, 0x20,
+	0xc6, 0x34, 0xb8, 0x2a, 0xd0, 0xd7, 0x89, 0x4b, 0x98, 0xc3, 0x76, 0x9c, 0x7a, 0x57, 0xad, 0x32,
+	0xc8, 0x86, 0x43, 0x8a, 0xd3, 0x03, 0x30, 0x6e, 0xcb, 0xd7, 0x65, 0xff, 0xb6, 0x04, 0x9d, 0xf4,
+	0x9a, 0x8e, 0xe4, 0x55, 0xa2, 0xce, 0x55, 0xa2, 0x9d, 0xce, 0x55, 0x96, 0x52, 0x47, 0xdf, 0x0b,
+	0x9a, 0x95, 0xb6, 0x7b, 0xc5, 0x8c, 0x7d, 0xa5, 0xcc, 0x93, 0xa6, 0xe7, 0xd5, 0xda, 0x5b, 0x0d,
+	0xba, 0x4f, 0xaa, 0x42, 0x36, 0x49, 0x00, 0x4e, 0x81, 0xd1, 0x36, 0xc1, 0x0d, 0xd9, 0x6c, 0xca,
+	0x92, 0x3f, 0xe0, 0x5d, 0x90, 0x12, 0x90, 0xc9, 0x21, 0x21, 0x45, 0xb6, 0x71, 0x00, 0x66, 0x63,
+	0xb0, 0x54, 0x47, 0x9b, 0x20, 0xed, 0x75, 0xdf, 0xfa, 0x90, 0x23, 0x8b, 0xe9, 0x35, 0x03, 0x05,
+	0xc7, 0x1f, 0x05, 0x0b, 0x94, 0x52, 0xfe, 0x1d, 0x58, 0xfd, 0x87, 0x8d, 0x5f, 0x49, 0x90, 0x09,
+	0xe6, 0x41, 0x08, 0x52, 0x7e, 0x03, 0xaa, 0x19, 0xf1, 0x0c, 0xef, 0x0d, 0xdd, 0xcb, 0x25, 0x1f,
+	0xa5, 0xd7, 0x4f, 0xc4, 0xb0, 0x8e, 0x5c, 0xc0, 0xb0, 0x46, 0xcf, 0x5a, 0xea, 0x62, 0x66, 0x0d,
+	0x6e, 0x83, 0x71, 0x4e, 0x39, 0xae, 0x95, 0x99, 0xd0, 0x26, 0x3b, 0xfa, 0xd7, 0x75, 0x37, 0x5c,
+	0x6e, 0xa5, 0x45, 0x0d, 0x29, 0xaf, 0x41, 0xc0, 0x8c, 0xb8, 0xd6, 0x52, 0x8d, 0x56, 0x0f, 0x42,
+	0xbb, 0x05, 0x1f, 0x01, 0xd0, 0x73, 0x08, 0x35, 0xa4, 0x0b, 0x48, 0x96, 0x45, 0xbe, 0x9d, 0x20,
+	0xe9, 0x75, 0xca, 0x4e, 0xd0, 0x16, 0xb6, 0x3b, 0xe3, 0x6f, 0xf5, 0x9d, 0x34, 0xde, 0x24, 0x41,
+	0x2e, 0x1a, 0x47, 0x4d, 0xcf, 0x36, 0xc8, 0x54, 0xfc, 0xd0, 0xe0, 0x8a, 0xfa, 0x23, 0x34, 0x17,
+	0x1e, 0xa1, 0xc1, 0x22, 0x6a, 0x80, 0x26, 0x2b, 0x83, 0xa5, 0x7b, 0x6a, 0xf9, 0xc7, 0xc8, 0x6e,
+	0x36, 0xf9, 0x1f, 0x6a, 0x3d, 0x16, 0x25, 0xe0, 0xfa, 0x80, 0x1c, 0x23, 0x42, 0x8e, 0x9b, 0x7f,
+	0x94, 0x43, 0xb6, 0xd8, 0xaf, 0xc7, 0xda, 0x87, 0x31, 0x30, 0x2a, 0xf4, 0x80, 0xef, 0x35, 0x30,
+	0x31, 0x60, 0xa7, 0x70, 0x25, 0xdc, 0x70, 0xac, 0x23, 0xeb, 0xb7, 0x86, 0x4b, 0x96, 0x14, 0x8c,
+	0x95, 0xd7, 0x5f, 0x7e, 0xbe, 0x4b, 0xde, 0x80, 0xf3, 0x9d, 0x96, 0xd5, 0xd7, 0x44, 0x7e, 0x0b,
+	0x06, 0xf7, 0x01, 0x7e, 0xd6, 0x40, 0x26, 0xe8, 0xa9, 0x10, 0xc5, 0xe0, 0xc5, 0x98, 0xb3, 0x6e,
+	0x0e, 0x9d, 0xaf, 0x28, 0x22, 0x41, 0x71, 0x11, 0x2e, 0x44, 0x52, 0x0c, 0xed, 0x16, 0x7c, 0xab,
+	0x81, 0x74, 0x9f, 0xc1, 0xc2, 0xa5, 0x18, 0xc0, 0xb0, 0x3f, 0xeb, 0xcb, 0xc3, 0xa4, 0x2a, 0x5a,
+	0x4b, 0x82, 0xd6, 0x3c, 0xbc, 0x16, 0x49, 0xab, 0xdf, 0xca, 0xe1, 0x27, 0x2d, 0xc2, 0xbc, 0xe2,
+	0x74, 0x8b, 0xb1, 0x6e, 0xdd, 0x1c, 0x3a, 0x3f, 0x7c, 0xb5, 0xc1, 0x7f, 0x15, 0xa4, 0x5b, 0x94,
+	0x7b, 0x06, 0x0b, 0x3f, 0x6a, 0x60, 0x32, 0xb0, 0x89, 0x70, 0x35, 0x06, 0x31, 0xda, 0x19, 0x74,
+	0x34, 0x6c, 0xba, 0xe2, 0xb7, 0x2c, 0xf8, 0x5d, 0x87, 0x46, 0x98, 0x5f, 0x70, 0xf1, 0x4b, 0x9b,
+	0xc7, 0x67, 0x79, 0xed, 0xe4, 0x2c, 0xaf, 0xfd, 0x38, 0xcb, 0x6b, 0x47, 0xe7, 0xf9, 0xc4, 0xc9,
+	0x79, 0x3e, 0xf1, 0xf5, 0x3c, 0x9f, 0x78, 0x76, 0xbb, 0x7f, 0x6b, 0x55, 0x1d, 0xda, 0xb0, 0xbb,
+	0xcf, 0xab, 0xd8, 0xf3, 0xcc, 0x97, 0xb2, 0xb2, 0xd8, 0xe1, 0xca, 0x98, 0xf8, 0x16, 0xdc, 0xf9,
+	0x3d, 0x00, 0xa6, 0x13, 0xce, 0x8e, 0xa6, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -340,6 +638,12 @@ type QueryClient interface {
 	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
 	// GenesisTime returns the genesis time.
 	GenesisTime(ctx context.Context, in *QueryGenesisTimeRequest, opts ...grpc.CallOption) (*QueryGenesisTimeResponse, error)
+	// SupplyProjection projects the inflation rate, annual provisions and total
+	// supply for future years based on the current minter state.
+	SupplyProjection(ctx context.Context, in *QuerySupplyProjectionRequest, opts ...grpc.CallOption) (*QuerySupplyProjectionResponse, error)
+	// BlockProvisions returns the amount minted in each recent block. Only the
+	// most recent blocks are retained.
+	BlockProvisions(ctx context.Context, in *QueryBlockProvisionsRequest, opts ...grpc.CallOption) (*QueryBlockProvisionsResponse, error)
 }
 
 type queryClient struct {
@@ -377,6 +681,24 @@ func (c *queryClient) GenesisTime(ctx context.Context, in *QueryGenesisTimeReque
 	return out, nil
 }
 
+func (c *queryClient) SupplyProjection(ctx context.Context, in *QuerySupplyProjectionRequest, opts ...grpc.CallOption) (*QuerySupplyProjectionResponse, error) {
+	out := new(QuerySupplyProjectionResponse)
+	err := c.cc.Invoke(ctx, "/celestia.mint.v1.Query/SupplyProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlockProvisions(ctx context.Context, in *QueryBlockProvisionsRequest, opts ...grpc.CallOption) (*QueryBlockProvisionsResponse, error) {
+	out := new(QueryBlockProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/celestia.mint.v1.Query/BlockProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// InflationRate returns the current inflation rate.
@@ -385,6 +707,12 @@ type QueryServer interface {
 	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
 	// GenesisTime returns the genesis time.
 	GenesisTime(context.Context, *QueryGenesisTimeRequest) (*QueryGenesisTimeResponse, error)
+	// SupplyProjection projects the inflation rate, annual provisions and total
+	// supply for future years based on the current minter state.
+	SupplyProjection(context.Context, *QuerySupplyProjectionRequest) (*QuerySupplyProjectionResponse, error)
+	// BlockProvisions returns the amount minted in each recent block. Only the
+	// most recent blocks are retained.
+	BlockProvisions(context.Context, *QueryBlockProvisionsRequest) (*QueryBlockProvisionsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -400,6 +728,12 @@ func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) GenesisTime(ctx context.Context, req *QueryGenesisTimeRequest) (*QueryGenesisTimeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GenesisTime not implemented")
 }
+func (*UnimplementedQueryServer) SupplyProjection(ctx context.Context, req *QuerySupplyProjectionRequest) (*QuerySupplyProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyProjection not implemented")
+}
+func (*UnimplementedQueryServer) BlockProvisions(ctx context.Context, req *QueryBlockProvisionsRequest) (*QueryBlockProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockProvisions not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -459,6 +793,42 @@ func _Query_GenesisTime_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SupplyProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.mint.v1.Query/SupplyProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyProjection(ctx, req.(*QuerySupplyProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.mint.v1.Query/BlockProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockProvisions(ctx, req.(*QueryBlockProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "celestia.mint.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -475,6 +845,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GenesisTime",
 			Handler:    _Query_GenesisTime_Handler,
 		},
+		{
+			MethodName: "SupplyProjection",
+			Handler:    _Query_SupplyProjection_Handler,
+		},
+		{
+			MethodName: "BlockProvisions",
+			Handler:    _Query_BlockProvisions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "celestia/mint/v1/query.proto",
@@ -648,67 +1026,302 @@ func (m *QueryGenesisTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySupplyProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryInflationRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QuerySupplyProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInflationRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySupplyProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.InflationRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Time != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintQuery(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Years != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Years))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAnnualProvisionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySupplyProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAnnualProvisionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QuerySupplyProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGenesisTimeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySupplyProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
-func (m *QueryGenesisTimeResponse) Size() (n int) {
+	if len(m.Projections) > 0 {
+		for iNdEx := len(m.Projections) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Projections[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyProjection) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyProjection) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyProjection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalSupply.Size()
+		i -= size
+		if _, err := m.TotalSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InflationRate.Size()
+		i -= size
+		if _, err := m.InflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintQuery(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x12
+	if m.Year != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Year))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.TotalMinted.Size()
+		i -= size
+		if _, err := m.TotalMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.BlockProvisions) > 0 {
+		for iNdEx := len(m.BlockProvisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockProvisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryInflationRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInflationRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAnnualProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAnnualProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGenesisTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGenesisTimeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -721,6 +1334,91 @@ func (m *QueryGenesisTimeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySupplyProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Years != 0 {
+		n += 1 + sovQuery(uint64(m.Years))
+	}
+	if m.Time != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplyProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Projections) > 0 {
+		for _, e := range m.Projections {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SupplyProjection) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Year != 0 {
+		n += 1 + sovQuery(uint64(m.Year))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.InflationRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlockProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlockProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockProvisions) > 0 {
+		for _, e := range m.BlockProvisions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalMinted.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1129,6 +1827,635 @@ func (m *QueryGenesisTimeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySupplyProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Years", wireType)
+			}
+			m.Years = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Years |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Projections", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Projections = append(m.Projections, SupplyProjection{})
+			if err := m.Projections[len(m.Projections)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyProjection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyProjection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyProjection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Year", wireType)
+			}
+			m.Year = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Year |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalSupply", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockProvisions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockProvisions = append(m.BlockProvisions, BlockProvision{})
+			if err := m.BlockProvisions[len(m.BlockProvisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalMinted", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,43 @@
+package ante
+
+import (
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NamespaceKeeper defines the expected nsreg keeper.
+type NamespaceKeeper interface {
+	CheckBlobSigner(ctx sdk.Context, signer string, namespaces [][]byte) error
+}
+
+// BlobSignerDecorator rejects a MsgPayForBlobs that pays for a blob in a
+// registered namespace if its signer is neither the owner of the namespace nor
+// on its allowlist. Only applies to app version > 2.
+type BlobSignerDecorator struct {
+	k NamespaceKeeper
+}
+
+func NewBlobSignerDecorator(k NamespaceKeeper) BlobSignerDecorator {
+	return BlobSignerDecorator{k}
+}
+
+// AnteHandle implements the AnteHandler interface.
+func (d BlobSignerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if ctx.BlockHeader().Version.App <= v2.Version {
+		return next(ctx, tx, simulate)
+	}
+
+	// Looking up the registrations doesn't consume the gas of the transaction
+	// which would otherwise exceed the estimates of PayForBlobs.
+	checkCtx := ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	for _, m := range tx.GetMsgs() {
+		if pfb, ok := m.(*blobtypes.MsgPayForBlobs); ok {
+			if err := d.k.CheckBlobSigner(checkCtx, pfb.Signer, pfb.Namespaces); err != nil {
+				return ctx, err
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,89 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/celestia-app/v3/x/nsreg/ante"
+	"github.com/celestiaorg/celestia-app/v3/x/nsreg/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+func TestBlobSignerDecorator(t *testing.T) {
+	owner := sdk.AccAddress("owner_______________").String()
+	stranger := sdk.AccAddress("stranger____________").String()
+	registered := share.MustNewV0Namespace([]byte("registered")).Bytes()
+	keeper := mockNamespaceKeeper{registered: registered, owner: owner}
+
+	testCases := []struct {
+		name       string
+		msg        sdk.Msg
+		appVersion uint64
+		wantErr    error
+	}{
+		{
+			name:       "PFB from the owner",
+			msg:        &blobtypes.MsgPayForBlobs{Signer: owner, Namespaces: [][]byte{registered}},
+			appVersion: v3.Version,
+		},
+		{
+			name:       "PFB from a stranger",
+			msg:        &blobtypes.MsgPayForBlobs{Signer: stranger, Namespaces: [][]byte{registered}},
+			appVersion: v3.Version,
+			wantErr:    types.ErrUnauthorizedBlobOwner,
+		},
+		{
+			name:       "PFB from a stranger in app version 2",
+			msg:        &blobtypes.MsgPayForBlobs{Signer: stranger, Namespaces: [][]byte{registered}},
+			appVersion: v2.Version,
+		},
+		{
+			name:       "not a PFB",
+			msg:        banktypes.NewMsgSend(sdk.AccAddress(stranger), sdk.AccAddress(owner), nil),
+			appVersion: v3.Version,
+		},
+	}
+
+	txConfig := encoding.MakeConfig(app.ModuleEncodingRegisters...).TxConfig
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			txBuilder := txConfig.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msg))
+			tx := txBuilder.GetTx()
+
+			decorator := ante.NewBlobSignerDecorator(keeper)
+			ctx := sdk.Context{}.WithBlockHeader(tmproto.Header{Version: version.Consensus{App: tc.appVersion}})
+			_, err := decorator.AnteHandle(ctx, tx, false, mockNext)
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+// mockNamespaceKeeper has a single registered namespace that only its owner
+// can pay for blobs in.
+type mockNamespaceKeeper struct {
+	registered []byte
+	owner      string
+}
+
+func (m mockNamespaceKeeper) CheckBlobSigner(_ sdk.Context, signer string, namespaces [][]byte) error {
+	for _, namespace := range namespaces {
+		if string(namespace) == string(m.registered) && signer != m.owner {
+			return types.ErrUnauthorizedBlobOwner
+		}
+	}
+	return nil
+}
+
+func mockNext(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+	return ctx, nil
+}
@@ -0,0 +1,185 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/celestiaorg/celestia-app/v3/x/nsreg/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+)
+
+// FlagAllowlist sets the signers other than the owner that can pay for blobs
+// in a namespace when registering it.
+const FlagAllowlist = "allowlist"
+
+// GetTxCmd returns the transaction commands for this module.
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdRegisterNamespace())
+	cmd.AddCommand(CmdUpdateAllowlist())
+	cmd.AddCommand(CmdTransferNamespace())
+	cmd.AddCommand(CmdRenewNamespace())
+	return cmd
+}
+
+func CmdRegisterNamespace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [namespaceID]",
+		Short: "Register a namespace so that only its owner and allowlist can pay for blobs in it",
+		Long: `Register a namespace so that only its owner and allowlist can pay for blobs in
+it until the registration expires. The registration fee is burned.
+
+The namespaceID is the user-specifiable portion of a version 0 namespace.
+The namespaceID must be a hex encoded string of 10 bytes.
+`,
+		Example: "register 0x00010203040506070809 --allowlist celestia1...,celestia1... --from owner",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			namespace, err := parseNamespace(args[0])
+			if err != nil {
+				return err
+			}
+			allowlistArg, err := cmd.Flags().GetStringSlice(FlagAllowlist)
+			if err != nil {
+				return err
+			}
+			allowlist, err := parseAddresses(allowlistArg)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterNamespace(clientCtx.GetFromAddress(), namespace, allowlist)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().StringSlice(FlagAllowlist, nil, "Comma separated addresses of the signers other than the owner that can pay for blobs in the namespace")
+	return cmd
+}
+
+func CmdUpdateAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-allowlist [namespaceID] [address...]",
+		Short:   "Replace the allowlist of a namespace. Passing no addresses clears it",
+		Example: "update-allowlist 0x00010203040506070809 celestia1... celestia1... --from owner",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			namespace, err := parseNamespace(args[0])
+			if err != nil {
+				return err
+			}
+			allowlist, err := parseAddresses(args[1:])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateAllowlist(clientCtx.GetFromAddress(), namespace, allowlist)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdTransferNamespace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer [namespaceID] [new-owner]",
+		Short:   "Transfer a namespace to a new owner",
+		Example: "transfer 0x00010203040506070809 celestia1... --from owner",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			namespace, err := parseNamespace(args[0])
+			if err != nil {
+				return err
+			}
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferNamespace(clientCtx.GetFromAddress(), namespace, newOwner)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRenewNamespace() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "renew [namespaceID]",
+		Short:   "Extend the registration of a namespace by the registration period. The registration fee is burned",
+		Example: "renew 0x00010203040506070809 --from owner",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			namespace, err := parseNamespace(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRenewNamespace(clientCtx.GetFromAddress(), namespace)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// parseNamespace returns the version 0 namespace of a hex encoded namespace
+// ID.
+func parseNamespace(arg string) (share.Namespace, error) {
+	namespaceID, err := hex.DecodeString(strings.TrimPrefix(arg, "0x"))
+	if err != nil {
+		return share.Namespace{}, fmt.Errorf("failed to decode hex namespace ID: %w", err)
+	}
+	return share.NewV0Namespace(namespaceID)
+}
+
+func parseAddresses(args []string) ([]sdk.AccAddress, error) {
+	addresses := make([]sdk.AccAddress, len(args))
+	for i, arg := range args {
+		addr, err := sdk.AccAddressFromBech32(arg)
+		if err != nil {
+			return nil, err
+		}
+		addresses[i] = addr
+	}
+	return addresses, nil
+}
@@ -0,0 +1,97 @@
+package nsreg_test
+
+import (
+	"testing"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	"github.com/celestiaorg/celestia-app/v3/app/encoding"
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	"github.com/celestiaorg/celestia-app/v3/pkg/user"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	"github.com/celestiaorg/celestia-app/v3/test/util/blobfactory"
+	"github.com/celestiaorg/celestia-app/v3/test/util/testfactory"
+	"github.com/celestiaorg/celestia-app/v3/x/nsreg/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+const (
+	owner    = "owner"
+	member   = "member"
+	stranger = "stranger"
+)
+
+// TestBlobSignerIntegration enables the registry, registers a namespace with
+// the real application and checks that only the owner and the allowlist can
+// pay for blobs in it.
+func TestBlobSignerIntegration(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleEncodingRegisters...)
+	testApp, kr := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams(), owner, member, stranger)
+	namespace := share.MustNewV0Namespace([]byte("nsreg"))
+
+	ctx := testApp.NewContext(false, tmproto.Header{})
+	supplyBefore := testApp.BankKeeper.GetSupply(ctx, appconsts.BondDenom)
+
+	ownerSigner := newSigner(t, testApp, kr, encCfg, owner)
+	msg := types.NewMsgRegisterNamespace(
+		testfactory.GetAddress(kr, owner),
+		namespace,
+		[]sdk.AccAddress{testfactory.GetAddress(kr, member)},
+	)
+	registerTx, err := ownerSigner.CreateTx([]sdk.Msg{msg}, blobfactory.FeeTxOpts(1e9)...)
+	require.NoError(t, err)
+
+	// the registry is disabled by default
+	require.False(t, testApp.NsregKeeper.GetParams(ctx).Enabled)
+	params := testApp.NsregKeeper.GetParams(ctx)
+	params.Enabled = true
+	params.EnforcementDelay = 0
+	testApp.NsregKeeper.SetParams(ctx, params)
+
+	res := testApp.DeliverTx(abci.RequestDeliverTx{Tx: registerTx})
+	require.EqualValues(t, abci.CodeTypeOK, res.Code, res.Log)
+	testApp.EndBlock(abci.RequestEndBlock{})
+	testApp.Commit()
+
+	ctx = testApp.NewContext(true, tmproto.Header{})
+	registration, found := testApp.NsregKeeper.GetRegistration(ctx, namespace.Bytes())
+	require.True(t, found)
+	require.Equal(t, testfactory.GetAddress(kr, owner).String(), registration.Owner)
+	// the registration fee is burned
+	supplyAfter := testApp.BankKeeper.GetSupply(ctx, appconsts.BondDenom)
+	require.Equal(t, supplyBefore.Sub(types.DefaultRegistrationFee[0]), supplyAfter)
+
+	testCases := []struct {
+		account  string
+		wantCode uint32
+	}{
+		{account: owner, wantCode: abci.CodeTypeOK},
+		{account: member, wantCode: abci.CodeTypeOK},
+		{account: stranger, wantCode: types.ErrUnauthorizedBlobOwner.ABCICode()},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.account, func(t *testing.T) {
+			signer := newSigner(t, testApp, kr, encCfg, tc.account)
+			blob, err := share.NewV0Blob(namespace, []byte("data"))
+			require.NoError(t, err)
+			blobTx, _, err := signer.CreatePayForBlobs(tc.account, []*share.Blob{blob}, blobfactory.FeeTxOpts(1e9)...)
+			require.NoError(t, err)
+
+			res := testApp.CheckTx(abci.RequestCheckTx{Type: abci.CheckTxType_New, Tx: blobTx})
+			require.Equal(t, tc.wantCode, res.Code, res.Log)
+		})
+	}
+}
+
+func newSigner(t *testing.T, testApp *app.App, kr keyring.Keyring, encCfg encoding.Config, name string) *user.Signer {
+	ctx := testApp.NewContext(true, tmproto.Header{})
+	account := testApp.AccountKeeper.GetAccount(ctx, testfactory.GetAddress(kr, name))
+	signer, err := user.NewSigner(kr, encCfg.TxConfig, testutil.ChainID, appconsts.LatestVersion,
+		user.NewAccount(name, account.GetAccountNumber(), account.GetSequence()))
+	require.NoError(t, err)
+	return signer
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v3/x/nsreg/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = Keeper{}
+
+func (k Keeper) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
+
+// Registration returns the registration of a namespace and whether it has
+// expired.
+func (k Keeper) Registration(c context.Context, req *types.QueryRegistrationRequest) (*types.QueryRegistrationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := types.ValidateNamespace(req.Namespace); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	registration, found := k.GetRegistration(ctx, req.Namespace)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "namespace %X is not registered", req.Namespace)
+	}
+	return &types.QueryRegistrationResponse{
+		Registration: registration,
+		Expired:      registration.IsExpired(ctx.BlockTime()),
+	}, nil
+}
+
+// Registrations returns the registrations ordered by namespace, optionally
+// only those of an owner.
+func (k Keeper) Registrations(c context.Context, req *types.QueryRegistrationsRequest) (*types.QueryRegistrationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Owner); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var registrations []types.Registration
+	pageRes, err := query.FilteredPaginate(k.registrationStore(ctx), req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
+		var registration types.Registration
+		if err := k.cdc.Unmarshal(value, &registration); err != nil {
+			return false, err
+		}
+		if req.Owner != "" && registration.Owner != req.Owner {
+			return false, nil
+		}
+		if accumulate {
+			registrations = append(registrations, registration)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryRegistrationsResponse{Registrations: registrations, Pagination: pageRes}, nil
+}
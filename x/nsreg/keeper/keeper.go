@@ -0,0 +1,124 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/celestiaorg/celestia-app/v3/x/nsreg/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// BankKeeper defines the expected bank keeper.
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// Keeper handles all the state changes for the nsreg module.
+type Keeper struct {
+	cdc        codec.BinaryCodec
+	storeKey   storetypes.StoreKey
+	paramStore paramtypes.Subspace
+	bankKeeper BankKeeper
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	ps paramtypes.Subspace,
+	bankKeeper BankKeeper,
+) *Keeper {
+	if !ps.HasKeyTable() {
+		ps = ps.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return &Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		paramStore: ps,
+		bankKeeper: bankKeeper,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetParams gets all parameters as types.Params
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramStore.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramStore.SetParamSet(ctx, &params)
+}
+
+// GetRegistration returns the registration of a namespace, expired or not.
+func (k Keeper) GetRegistration(ctx sdk.Context, namespace []byte) (types.Registration, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.RegistrationKey(namespace))
+	if bz == nil {
+		return types.Registration{}, false
+	}
+	var registration types.Registration
+	k.cdc.MustUnmarshal(bz, &registration)
+	return registration, true
+}
+
+// SetRegistration sets the registration of a namespace.
+func (k Keeper) SetRegistration(ctx sdk.Context, registration types.Registration) {
+	ctx.KVStore(k.storeKey).Set(types.RegistrationKey(registration.Namespace), k.cdc.MustMarshal(&registration))
+}
+
+// GetAllRegistrations returns all registrations ordered by namespace.
+func (k Keeper) GetAllRegistrations(ctx sdk.Context) []types.Registration {
+	var registrations []types.Registration
+	iterator := k.registrationStore(ctx).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var registration types.Registration
+		k.cdc.MustUnmarshal(iterator.Value(), &registration)
+		registrations = append(registrations, registration)
+	}
+	return registrations
+}
+
+func (k Keeper) registrationStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.RegistrationKeyPrefix)
+}
+
+// CheckBlobSigner returns an error if the registry is enabled, any of the
+// namespaces has a registration that is enforced and the signer is neither its
+// owner nor on its allowlist.
+func (k Keeper) CheckBlobSigner(ctx sdk.Context, signer string, namespaces [][]byte) error {
+	if !k.GetParams(ctx).Enabled {
+		return nil
+	}
+	for _, namespace := range namespaces {
+		registration, found := k.GetRegistration(ctx, namespace)
+		if !found || !registration.IsEnforced(ctx.BlockTime()) {
+			continue
+		}
+		if !registration.IsAllowed(signer) {
+			return types.ErrUnauthorizedBlobOwner.Wrapf("signer %s, namespace %X", signer, namespace)
+		}
+	}
+	return nil
+}
+
+// chargeRegistrationFee burns the registration fee from the payer.
+func (k Keeper) chargeRegistrationFee(ctx sdk.Context, payer sdk.AccAddress, fee sdk.Coins) error {
+	if fee.IsZero() {
+		return nil
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, fee); err != nil {
+		return err
+	}
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, fee)
+}
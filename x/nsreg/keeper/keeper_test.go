@@ -0,0 +1,304 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	"github.com/celestiaorg/celestia-app/v3/x/nsreg/keeper"
+	"github.com/celestiaorg/celestia-app/v3/x/nsreg/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+	tmdb "github.com/tendermint/tm-db"
+)
+
+var (
+	owner     = sdk.AccAddress("owner_______________")
+	alice     = sdk.AccAddress("alice_______________")
+	bob       = sdk.AccAddress("bob_________________")
+	namespace = share.MustNewV0Namespace([]byte("nsreg"))
+	fee       = sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 1000))
+	period    = 24 * time.Hour
+	delay     = time.Hour
+	genesis   = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestRegisterNamespace(t *testing.T) {
+	k, bank, ctx := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	_, err := msgServer.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(owner, namespace, []sdk.AccAddress{alice}))
+	require.NoError(t, err)
+	require.Equal(t, fee, bank.burned)
+
+	registration, found := k.GetRegistration(ctx, namespace.Bytes())
+	require.True(t, found)
+	require.Equal(t, owner.String(), registration.Owner)
+	require.Equal(t, []string{alice.String()}, registration.Allowlist)
+	require.Equal(t, genesis.Add(period), registration.Expiration)
+	require.Equal(t, genesis.Add(delay), registration.EnforcementStart)
+
+	// the namespace can't be registered again until it expires
+	_, err = msgServer.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(bob, namespace, nil))
+	require.ErrorIs(t, err, types.ErrNamespaceRegistered)
+
+	ctx = ctx.WithBlockTime(registration.Expiration)
+	_, err = msgServer.RegisterNamespace(ctx, types.NewMsgRegisterNamespace(bob, namespace, nil))
+	require.NoError(t, err)
+	registration, _ = k.GetRegistration(ctx, namespace.Bytes())
+	require.Equal(t, bob.String(), registration.Owner)
+	require.Empty(t, registration.Allowlist)
+}
+
+func TestRegisterNamespaceDisabled(t *testing.T) {
+	k, bank, ctx := setupKeeper(t)
+	params := k.GetParams(ctx)
+	params.Enabled = false
+	k.SetParams(ctx, params)
+
+	_, err := keeper.NewMsgServerImpl(k).RegisterNamespace(ctx, types.NewMsgRegisterNamespace(owner, namespace, nil))
+	require.ErrorIs(t, err, types.ErrRegistryDisabled)
+	require.True(t, bank.burned.IsZero())
+}
+
+func TestRegisterNamespaceAllowlistTooLarge(t *testing.T) {
+	k, bank, ctx := setupKeeper(t)
+	params := k.GetParams(ctx)
+	params.MaxAllowlistSize = 1
+	k.SetParams(ctx, params)
+
+	_, err := keeper.NewMsgServerImpl(k).RegisterNamespace(ctx, types.NewMsgRegisterNamespace(owner, namespace, []sdk.AccAddress{alice, bob}))
+	require.ErrorIs(t, err, types.ErrInvalidAllowlist)
+	require.True(t, bank.burned.IsZero())
+}
+
+func TestUpdateAllowlist(t *testing.T) {
+	k, _, ctx := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	_, err := msgServer.UpdateAllowlist(ctx, types.NewMsgUpdateAllowlist(owner, namespace, []sdk.AccAddress{alice}))
+	require.ErrorIs(t, err, types.ErrNamespaceNotFound)
+
+	register(t, k, ctx, owner)
+
+	_, err = msgServer.UpdateAllowlist(ctx, types.NewMsgUpdateAllowlist(alice, namespace, []sdk.AccAddress{alice}))
+	require.ErrorIs(t, err, types.ErrNotOwner)
+
+	_, err = msgServer.UpdateAllowlist(ctx, types.NewMsgUpdateAllowlist(owner, namespace, []sdk.AccAddress{alice, bob}))
+	require.NoError(t, err)
+	registration, _ := k.GetRegistration(ctx, namespace.Bytes())
+	require.Equal(t, []string{alice.String(), bob.String()}, registration.Allowlist)
+
+	_, err = msgServer.UpdateAllowlist(ctx.WithBlockTime(registration.Expiration), types.NewMsgUpdateAllowlist(owner, namespace, nil))
+	require.ErrorIs(t, err, types.ErrRegistrationExpired)
+}
+
+func TestTransferNamespace(t *testing.T) {
+	k, _, ctx := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+	register(t, k, ctx, owner, alice, bob)
+
+	_, err := msgServer.TransferNamespace(ctx, types.NewMsgTransferNamespace(alice, namespace, alice))
+	require.ErrorIs(t, err, types.ErrNotOwner)
+
+	_, err = msgServer.TransferNamespace(ctx, types.NewMsgTransferNamespace(owner, namespace, alice))
+	require.NoError(t, err)
+
+	registration, _ := k.GetRegistration(ctx, namespace.Bytes())
+	require.Equal(t, alice.String(), registration.Owner)
+	// the new owner is removed from the allowlist
+	require.Equal(t, []string{bob.String()}, registration.Allowlist)
+	require.NoError(t, registration.Validate(types.DefaultMaxAllowlistSize))
+}
+
+func TestRenewNamespace(t *testing.T) {
+	k, bank, ctx := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+	register(t, k, ctx, owner)
+
+	_, err := msgServer.RenewNamespace(ctx, types.NewMsgRenewNamespace(alice, namespace))
+	require.ErrorIs(t, err, types.ErrNotOwner)
+
+	// renewing before the registration expires extends it from its expiration
+	_, err = msgServer.RenewNamespace(ctx, types.NewMsgRenewNamespace(owner, namespace))
+	require.NoError(t, err)
+	registration, _ := k.GetRegistration(ctx, namespace.Bytes())
+	require.Equal(t, genesis.Add(2*period), registration.Expiration)
+	require.Equal(t, fee.Add(fee...), bank.burned)
+
+	// renewing after the registration expired extends it from the block time
+	later := genesis.Add(10 * period)
+	_, err = msgServer.RenewNamespace(ctx.WithBlockTime(later), types.NewMsgRenewNamespace(owner, namespace))
+	require.NoError(t, err)
+	registration, _ = k.GetRegistration(ctx, namespace.Bytes())
+	require.Equal(t, later.Add(period), registration.Expiration)
+}
+
+func TestCheckBlobSigner(t *testing.T) {
+	k, _, ctx := setupKeeper(t)
+	other := share.MustNewV0Namespace([]byte("other"))
+	register(t, k, ctx, owner, alice)
+	enforced := genesis.Add(delay)
+
+	testCases := []struct {
+		name       string
+		signer     sdk.AccAddress
+		namespaces []share.Namespace
+		blockTime  time.Time
+		disabled   bool
+		wantErr    bool
+	}{
+		{
+			name:       "owner",
+			signer:     owner,
+			namespaces: []share.Namespace{namespace},
+			blockTime:  enforced,
+		},
+		{
+			name:       "allowlisted signer",
+			signer:     alice,
+			namespaces: []share.Namespace{other, namespace},
+			blockTime:  enforced,
+		},
+		{
+			name:       "unregistered namespace",
+			signer:     bob,
+			namespaces: []share.Namespace{other},
+			blockTime:  enforced,
+		},
+		{
+			name:       "unlisted signer",
+			signer:     bob,
+			namespaces: []share.Namespace{other, namespace},
+			blockTime:  enforced,
+			wantErr:    true,
+		},
+		{
+			name:       "unlisted signer before the allowlist is enforced",
+			signer:     bob,
+			namespaces: []share.Namespace{namespace},
+			blockTime:  genesis,
+		},
+		{
+			name:       "unlisted signer while the registry is disabled",
+			signer:     bob,
+			namespaces: []share.Namespace{namespace},
+			blockTime:  enforced,
+			disabled:   true,
+		},
+		{
+			name:       "unlisted signer after the registration expired",
+			signer:     bob,
+			namespaces: []share.Namespace{namespace},
+			blockTime:  genesis.Add(period),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			namespaces := make([][]byte, len(tc.namespaces))
+			for i, ns := range tc.namespaces {
+				namespaces[i] = ns.Bytes()
+			}
+			ctx, _ := ctx.CacheContext()
+			params := k.GetParams(ctx)
+			params.Enabled = !tc.disabled
+			k.SetParams(ctx, params)
+			err := k.CheckBlobSigner(ctx.WithBlockTime(tc.blockTime), tc.signer.String(), namespaces)
+			if tc.wantErr {
+				require.ErrorIs(t, err, types.ErrUnauthorizedBlobOwner)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestQueryRegistrations(t *testing.T) {
+	k, _, ctx := setupKeeper(t)
+	register(t, k, ctx, owner)
+	other := share.MustNewV0Namespace([]byte("other"))
+	_, err := keeper.NewMsgServerImpl(k).RegisterNamespace(ctx, types.NewMsgRegisterNamespace(alice, other, nil))
+	require.NoError(t, err)
+
+	resp, err := k.Registration(ctx, &types.QueryRegistrationRequest{Namespace: namespace.Bytes()})
+	require.NoError(t, err)
+	require.Equal(t, owner.String(), resp.Registration.Owner)
+	require.False(t, resp.Expired)
+
+	resp, err = k.Registration(ctx.WithBlockTime(genesis.Add(period)), &types.QueryRegistrationRequest{Namespace: namespace.Bytes()})
+	require.NoError(t, err)
+	require.True(t, resp.Expired)
+
+	_, err = k.Registration(ctx, &types.QueryRegistrationRequest{Namespace: share.MustNewV0Namespace([]byte("none")).Bytes()})
+	require.Error(t, err)
+
+	all, err := k.Registrations(ctx, &types.QueryRegistrationsRequest{})
+	require.NoError(t, err)
+	require.Len(t, all.Registrations, 2)
+
+	owned, err := k.Registrations(ctx, &types.QueryRegistrationsRequest{Owner: alice.String(), Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, owned.Registrations, 1)
+	require.Equal(t, other.Bytes(), owned.Registrations[0].Namespace)
+	require.EqualValues(t, 1, owned.Pagination.Total)
+}
+
+func register(t *testing.T, k keeper.Keeper, ctx sdk.Context, owner sdk.AccAddress, allowlist ...sdk.AccAddress) {
+	_, err := keeper.NewMsgServerImpl(k).RegisterNamespace(ctx, types.NewMsgRegisterNamespace(owner, namespace, allowlist))
+	require.NoError(t, err)
+}
+
+func setupKeeper(t *testing.T) (keeper.Keeper, *mockBankKeeper, sdk.Context) {
+	storeKey := sdk.NewKVStoreKey(types.StoreKey)
+	paramsStoreKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	paramsTStoreKey := storetypes.NewTransientStoreKey(paramtypes.TStoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(paramsStoreKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(paramsTStoreKey, storetypes.StoreTypeTransient, nil)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+	subspace := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), paramsStoreKey, paramsTStoreKey, types.ModuleName)
+
+	bank := &mockBankKeeper{}
+	k := keeper.NewKeeper(cdc, storeKey, subspace, bank)
+	header := tmproto.Header{Height: 1, Time: genesis, Version: version.Consensus{App: v3.Version}}
+	ctx := sdk.NewContext(stateStore, header, false, log.NewNopLogger())
+	k.SetParams(ctx, types.NewParams(fee, period, types.DefaultMaxAllowlistSize, true, delay))
+	return *k, bank, ctx
+}
+
+type mockBankKeeper struct {
+	burned sdk.Coins
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ sdk.Context, _ sdk.AccAddress, recipientModule string, _ sdk.Coins) error {
+	if recipientModule != types.ModuleName {
+		panic("unexpected transfer")
+	}
+	return nil
+}
+
+func (m *mockBankKeeper) BurnCoins(_ sdk.Context, moduleName string, amt sdk.Coins) error {
+	if moduleName != types.ModuleName {
+		panic("unexpected burn")
+	}
+	m.burned = m.burned.Add(amt...)
+	return nil
+}
@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/celestiaorg/celestia-app/v3/x/nsreg/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the nsreg MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// RegisterNamespace registers a namespace that is not registered or whose
+// registration has expired. The allowlist is only enforced once the
+// enforcement delay has passed.
+func (k msgServer) RegisterNamespace(goCtx context.Context, msg *types.MsgRegisterNamespace) (*types.MsgRegisterNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	if !params.Enabled {
+		return nil, types.ErrRegistryDisabled
+	}
+	if existing, found := k.GetRegistration(ctx, msg.Namespace); found && !existing.IsExpired(ctx.BlockTime()) {
+		return nil, types.ErrNamespaceRegistered.Wrapf("namespace %X is owned by %s until %s", msg.Namespace, existing.Owner, existing.Expiration)
+	}
+	if err := checkAllowlistSize(msg.Allowlist, params); err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.chargeRegistrationFee(ctx, owner, params.RegistrationFee); err != nil {
+		return nil, err
+	}
+
+	registration := types.Registration{
+		Namespace:  msg.Namespace,
+		Owner:      msg.Owner,
+		Allowlist:  msg.Allowlist,
+		Expiration: ctx.BlockTime().Add(params.RegistrationPeriod),
+		// Users of the namespace other than the owner get time to notice
+		// the registration before they are locked out of it.
+		EnforcementStart: ctx.BlockTime().Add(params.EnforcementDelay),
+	}
+	k.SetRegistration(ctx, registration)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRegisterNamespace{
+		Namespace:        registration.Namespace,
+		Owner:            registration.Owner,
+		Expiration:       registration.Expiration,
+		EnforcementStart: registration.EnforcementStart,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterNamespaceResponse{}, nil
+}
+
+// UpdateAllowlist replaces the allowlist of a namespace.
+func (k msgServer) UpdateAllowlist(goCtx context.Context, msg *types.MsgUpdateAllowlist) (*types.MsgUpdateAllowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	registration, err := k.ownedRegistration(ctx, msg.Owner, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if registration.IsExpired(ctx.BlockTime()) {
+		return nil, types.ErrRegistrationExpired.Wrapf("namespace %X expired at %s", msg.Namespace, registration.Expiration)
+	}
+	if err := checkAllowlistSize(msg.Allowlist, k.GetParams(ctx)); err != nil {
+		return nil, err
+	}
+
+	registration.Allowlist = msg.Allowlist
+	k.SetRegistration(ctx, registration)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventUpdateAllowlist{
+		Namespace: registration.Namespace,
+		Allowlist: registration.Allowlist,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateAllowlistResponse{}, nil
+}
+
+// TransferNamespace transfers a namespace to a new owner. The new owner is
+// removed from the allowlist as the owner is always allowed.
+func (k msgServer) TransferNamespace(goCtx context.Context, msg *types.MsgTransferNamespace) (*types.MsgTransferNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	registration, err := k.ownedRegistration(ctx, msg.Owner, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if registration.IsExpired(ctx.BlockTime()) {
+		return nil, types.ErrRegistrationExpired.Wrapf("namespace %X expired at %s", msg.Namespace, registration.Expiration)
+	}
+
+	allowlist := make([]string, 0, len(registration.Allowlist))
+	for _, signer := range registration.Allowlist {
+		if signer != msg.NewOwner {
+			allowlist = append(allowlist, signer)
+		}
+	}
+	registration.Owner = msg.NewOwner
+	registration.Allowlist = allowlist
+	k.SetRegistration(ctx, registration)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventTransferNamespace{
+		Namespace: registration.Namespace,
+		OldOwner:  msg.Owner,
+		NewOwner:  msg.NewOwner,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgTransferNamespaceResponse{}, nil
+}
+
+// RenewNamespace extends the registration of a namespace by the registration
+// period. A registration that has expired can still be renewed by its owner
+// as long as nobody else has registered the namespace since.
+func (k msgServer) RenewNamespace(goCtx context.Context, msg *types.MsgRenewNamespace) (*types.MsgRenewNamespaceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	registration, err := k.ownedRegistration(ctx, msg.Owner, msg.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.chargeRegistrationFee(ctx, owner, params.RegistrationFee); err != nil {
+		return nil, err
+	}
+
+	start := registration.Expiration
+	if registration.IsExpired(ctx.BlockTime()) {
+		start = ctx.BlockTime()
+	}
+	registration.Expiration = start.Add(params.RegistrationPeriod)
+	k.SetRegistration(ctx, registration)
+
+	err = ctx.EventManager().EmitTypedEvent(&types.EventRenewNamespace{
+		Namespace:  registration.Namespace,
+		Expiration: registration.Expiration,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgRenewNamespaceResponse{}, nil
+}
+
+// ownedRegistration returns the registration of a namespace if it is owned by
+// owner.
+func (k msgServer) ownedRegistration(ctx sdk.Context, owner string, namespace []byte) (types.Registration, error) {
+	registration, found := k.GetRegistration(ctx, namespace)
+	if !found {
+		return types.Registration{}, types.ErrNamespaceNotFound.Wrapf("namespace %X", namespace)
+	}
+	if registration.Owner != owner {
+		return types.Registration{}, types.ErrNotOwner.Wrapf("namespace %X is owned by %s", namespace, registration.Owner)
+	}
+	return registration, nil
+}
+
+func checkAllowlistSize(allowlist []string, params types.Params) error {
+	if len(allowlist) > int(params.MaxAllowlistSize) {
+		return types.ErrInvalidAllowlist.Wrapf("allowlist has %d signers, max %d", len(allowlist), params.MaxAllowlistSize)
+	}
+	return nil
+}
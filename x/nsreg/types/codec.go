@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the nsreg types on the provided
+// LegacyAmino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRegisterNamespace{}, URLMsgRegisterNamespace, nil)
+	cdc.RegisterConcrete(&MsgUpdateAllowlist{}, URLMsgUpdateAllowlist, nil)
+	cdc.RegisterConcrete(&MsgTransferNamespace{}, URLMsgTransferNamespace, nil)
+	cdc.RegisterConcrete(&MsgRenewNamespace{}, URLMsgRenewNamespace, nil)
+}
+
+// RegisterInterfaces registers the nsreg module types on the provided
+// registry.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterNamespace{},
+		&MsgUpdateAllowlist{},
+		&MsgTransferNamespace{},
+		&MsgRenewNamespace{},
+	)
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+)
+
+var (
+	ErrInvalidNamespace      = errors.Register(ModuleName, 1, "invalid namespace")
+	ErrNamespaceRegistered   = errors.Register(ModuleName, 2, "namespace is already registered")
+	ErrNamespaceNotFound     = errors.Register(ModuleName, 3, "namespace is not registered")
+	ErrNotOwner              = errors.Register(ModuleName, 4, "signer is not the owner of the namespace")
+	ErrRegistrationExpired   = errors.Register(ModuleName, 5, "registration has expired")
+	ErrInvalidAllowlist      = errors.Register(ModuleName, 6, "invalid allowlist")
+	ErrUnauthorizedBlobOwner = errors.Register(ModuleName, 7, "signer is not allowed to pay for blobs in the namespace")
+	ErrRegistryDisabled      = errors.Register(ModuleName, 8, "namespace registry is disabled")
+)
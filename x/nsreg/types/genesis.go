@@ -0,0 +1,34 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default genesis state.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	seen := make(map[string]struct{}, len(gs.Registrations))
+	for i, registration := range gs.Registrations {
+		if err := ValidateNamespace(registration.Namespace); err != nil {
+			return fmt.Errorf("registration %d: %w", i, err)
+		}
+		if _, exists := seen[string(registration.Namespace)]; exists {
+			return fmt.Errorf("registration %d: duplicate namespace %X", i, registration.Namespace)
+		}
+		seen[string(registration.Namespace)] = struct{}{}
+		if err := registration.Validate(gs.Params.MaxAllowlistSize); err != nil {
+			return fmt.Errorf("registration %d: %w", i, err)
+		}
+	}
+	return nil
+}
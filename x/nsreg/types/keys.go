@@ -0,0 +1,20 @@
+package types
+
+const (
+	ModuleName = "nsreg"
+
+	// StoreKey is the store key used to persist the registrations. It is only
+	// mounted from app version 3 onwards.
+	StoreKey     = ModuleName
+	QuerierRoute = ModuleName
+	RouterKey    = ModuleName
+)
+
+// RegistrationKeyPrefix is the store key prefix for the registrations which
+// are keyed by their namespace.
+var RegistrationKeyPrefix = []byte{0x01}
+
+// RegistrationKey returns the store key of the registration of a namespace.
+func RegistrationKey(namespace []byte) []byte {
+	return append(append([]byte{}, RegistrationKeyPrefix...), namespace...)
+}
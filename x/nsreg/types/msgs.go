@@ -0,0 +1,226 @@
+package types
+
+import (
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/go-square/v2/share"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+)
+
+const (
+	URLMsgRegisterNamespace = "/celestia.nsreg.v1.MsgRegisterNamespace"
+	URLMsgUpdateAllowlist   = "/celestia.nsreg.v1.MsgUpdateAllowlist"
+	URLMsgTransferNamespace = "/celestia.nsreg.v1.MsgTransferNamespace"
+	URLMsgRenewNamespace    = "/celestia.nsreg.v1.MsgRenewNamespace"
+)
+
+var (
+	_ sdk.Msg            = &MsgRegisterNamespace{}
+	_ sdk.Msg            = &MsgUpdateAllowlist{}
+	_ sdk.Msg            = &MsgTransferNamespace{}
+	_ sdk.Msg            = &MsgRenewNamespace{}
+	_ legacytx.LegacyMsg = &MsgRegisterNamespace{}
+	_ legacytx.LegacyMsg = &MsgUpdateAllowlist{}
+	_ legacytx.LegacyMsg = &MsgTransferNamespace{}
+	_ legacytx.LegacyMsg = &MsgRenewNamespace{}
+)
+
+var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+func NewMsgRegisterNamespace(owner sdk.AccAddress, namespace share.Namespace, allowlist []sdk.AccAddress) *MsgRegisterNamespace {
+	return &MsgRegisterNamespace{
+		Owner:     owner.String(),
+		Namespace: namespace.Bytes(),
+		Allowlist: addressStrings(allowlist),
+	}
+}
+
+func (msg *MsgRegisterNamespace) GetSigners() []sdk.AccAddress {
+	return mustSigners(msg.Owner)
+}
+
+func (msg *MsgRegisterNamespace) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+	if err := ValidateNamespace(msg.Namespace); err != nil {
+		return err
+	}
+	return ValidateAllowlist(msg.Owner, msg.Allowlist)
+}
+
+// GetSignBytes implements legacytx.LegacyMsg.
+func (msg *MsgRegisterNamespace) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements legacytx.LegacyMsg.
+func (msg *MsgRegisterNamespace) Route() string {
+	return RouterKey
+}
+
+// Type implements legacytx.LegacyMsg.
+func (msg *MsgRegisterNamespace) Type() string {
+	return URLMsgRegisterNamespace
+}
+
+func NewMsgUpdateAllowlist(owner sdk.AccAddress, namespace share.Namespace, allowlist []sdk.AccAddress) *MsgUpdateAllowlist {
+	return &MsgUpdateAllowlist{
+		Owner:     owner.String(),
+		Namespace: namespace.Bytes(),
+		Allowlist: addressStrings(allowlist),
+	}
+}
+
+func (msg *MsgUpdateAllowlist) GetSigners() []sdk.AccAddress {
+	return mustSigners(msg.Owner)
+}
+
+func (msg *MsgUpdateAllowlist) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+	if err := ValidateNamespace(msg.Namespace); err != nil {
+		return err
+	}
+	return ValidateAllowlist(msg.Owner, msg.Allowlist)
+}
+
+// GetSignBytes implements legacytx.LegacyMsg.
+func (msg *MsgUpdateAllowlist) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements legacytx.LegacyMsg.
+func (msg *MsgUpdateAllowlist) Route() string {
+	return RouterKey
+}
+
+// Type implements legacytx.LegacyMsg.
+func (msg *MsgUpdateAllowlist) Type() string {
+	return URLMsgUpdateAllowlist
+}
+
+func NewMsgTransferNamespace(owner sdk.AccAddress, namespace share.Namespace, newOwner sdk.AccAddress) *MsgTransferNamespace {
+	return &MsgTransferNamespace{
+		Owner:     owner.String(),
+		Namespace: namespace.Bytes(),
+		NewOwner:  newOwner.String(),
+	}
+}
+
+func (msg *MsgTransferNamespace) GetSigners() []sdk.AccAddress {
+	return mustSigners(msg.Owner)
+}
+
+func (msg *MsgTransferNamespace) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.NewOwner); err != nil {
+		return err
+	}
+	return ValidateNamespace(msg.Namespace)
+}
+
+// GetSignBytes implements legacytx.LegacyMsg.
+func (msg *MsgTransferNamespace) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements legacytx.LegacyMsg.
+func (msg *MsgTransferNamespace) Route() string {
+	return RouterKey
+}
+
+// Type implements legacytx.LegacyMsg.
+func (msg *MsgTransferNamespace) Type() string {
+	return URLMsgTransferNamespace
+}
+
+func NewMsgRenewNamespace(owner sdk.AccAddress, namespace share.Namespace) *MsgRenewNamespace {
+	return &MsgRenewNamespace{
+		Owner:     owner.String(),
+		Namespace: namespace.Bytes(),
+	}
+}
+
+func (msg *MsgRenewNamespace) GetSigners() []sdk.AccAddress {
+	return mustSigners(msg.Owner)
+}
+
+func (msg *MsgRenewNamespace) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return err
+	}
+	return ValidateNamespace(msg.Namespace)
+}
+
+// GetSignBytes implements legacytx.LegacyMsg.
+func (msg *MsgRenewNamespace) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements legacytx.LegacyMsg.
+func (msg *MsgRenewNamespace) Route() string {
+	return RouterKey
+}
+
+// Type implements legacytx.LegacyMsg.
+func (msg *MsgRenewNamespace) Type() string {
+	return URLMsgRenewNamespace
+}
+
+// ValidateNamespace returns an error if the namespace is not one that blobs
+// can be paid for in and therefore can't be registered.
+func ValidateNamespace(namespace []byte) error {
+	ns, err := share.NewNamespaceFromBytes(namespace)
+	if err != nil {
+		return ErrInvalidNamespace.Wrap(err.Error())
+	}
+	if err := blobtypes.ValidateBlobNamespace(ns); err != nil {
+		return ErrInvalidNamespace.Wrap(err.Error())
+	}
+	return nil
+}
+
+// ValidateAllowlist returns an error if an address of the allowlist is
+// invalid, duplicated or the owner's. The size of the allowlist is bounded by
+// a param and is checked by the keeper.
+func ValidateAllowlist(owner string, allowlist []string) error {
+	seen := make(map[string]struct{}, len(allowlist))
+	for _, signer := range allowlist {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return ErrInvalidAllowlist.Wrapf("invalid address %s: %s", signer, err)
+		}
+		if signer == owner {
+			return ErrInvalidAllowlist.Wrap("the owner is always allowed and can not be on the allowlist")
+		}
+		if _, exists := seen[signer]; exists {
+			return ErrInvalidAllowlist.Wrapf("duplicate address %s", signer)
+		}
+		seen[signer] = struct{}{}
+	}
+	return nil
+}
+
+func mustSigners(address string) []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{addr}
+}
+
+func addressStrings(addresses []sdk.AccAddress) []string {
+	if len(addresses) == 0 {
+		return nil
+	}
+	strs := make([]string, len(addresses))
+	for i, addr := range addresses {
+		strs[i] = addr.String()
+	}
+	return strs
+}
@@ -0,0 +1,130 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/pkg/appconsts"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"gopkg.in/yaml.v2"
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	KeyRegistrationFee    = []byte("RegistrationFee")
+	KeyRegistrationPeriod = []byte("RegistrationPeriod")
+	KeyMaxAllowlistSize   = []byte("MaxAllowlistSize")
+	KeyEnabled            = []byte("Enabled")
+	KeyEnforcementDelay   = []byte("EnforcementDelay")
+
+	// DefaultRegistrationFee is 10 TIA.
+	DefaultRegistrationFee = sdk.NewCoins(sdk.NewInt64Coin(appconsts.BondDenom, 10_000_000))
+	// DefaultRegistrationPeriod is a year.
+	DefaultRegistrationPeriod        = 365 * 24 * time.Hour
+	DefaultMaxAllowlistSize   uint32 = 100
+	// DefaultEnabled disables the registry until governance enables it.
+	DefaultEnabled = false
+	// DefaultEnforcementDelay is two weeks.
+	DefaultEnforcementDelay = 14 * 24 * time.Hour
+)
+
+// ParamKeyTable returns the param key table for the nsreg module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new Params instance.
+func NewParams(registrationFee sdk.Coins, registrationPeriod time.Duration, maxAllowlistSize uint32, enabled bool, enforcementDelay time.Duration) Params {
+	return Params{
+		RegistrationFee:    registrationFee,
+		RegistrationPeriod: registrationPeriod,
+		MaxAllowlistSize:   maxAllowlistSize,
+		Enabled:            enabled,
+		EnforcementDelay:   enforcementDelay,
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultRegistrationFee, DefaultRegistrationPeriod, DefaultMaxAllowlistSize, DefaultEnabled, DefaultEnforcementDelay)
+}
+
+// ParamSetPairs gets the list of param key-value pairs.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRegistrationFee, &p.RegistrationFee, validateRegistrationFee),
+		paramtypes.NewParamSetPair(KeyRegistrationPeriod, &p.RegistrationPeriod, validateRegistrationPeriod),
+		paramtypes.NewParamSetPair(KeyMaxAllowlistSize, &p.MaxAllowlistSize, validateMaxAllowlistSize),
+		paramtypes.NewParamSetPair(KeyEnabled, &p.Enabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyEnforcementDelay, &p.EnforcementDelay, validateEnforcementDelay),
+	}
+}
+
+// Validate validates the set of params.
+func (p Params) Validate() error {
+	if err := validateRegistrationFee(p.RegistrationFee); err != nil {
+		return err
+	}
+	if err := validateRegistrationPeriod(p.RegistrationPeriod); err != nil {
+		return err
+	}
+	if err := validateMaxAllowlistSize(p.MaxAllowlistSize); err != nil {
+		return err
+	}
+	if err := validateEnabled(p.Enabled); err != nil {
+		return err
+	}
+	return validateEnforcementDelay(p.EnforcementDelay)
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+func validateRegistrationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return v.Validate()
+}
+
+func validateRegistrationPeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v <= 0 {
+		return fmt.Errorf("registration period must be positive: %s", v)
+	}
+	return nil
+}
+
+func validateMaxAllowlistSize(i interface{}) error {
+	if _, ok := i.(uint32); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateEnforcementDelay(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if v < 0 {
+		return fmt.Errorf("enforcement delay must not be negative: %s", v)
+	}
+	return nil
+}
@@ -0,0 +1,537 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/nsreg/v1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// registration_fee is burned every time a namespace is registered or
+	// renewed.
+	RegistrationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=registration_fee,json=registrationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"registration_fee" yaml:"registration_fee"`
+	// registration_period is how long a registration or renewal lasts.
+	RegistrationPeriod time.Duration `protobuf:"bytes,2,opt,name=registration_period,json=registrationPeriod,proto3,stdduration" json:"registration_period" yaml:"registration_period"`
+	// max_allowlist_size is the maximum number of signers on the allowlist of a
+	// namespace.
+	MaxAllowlistSize uint32 `protobuf:"varint,3,opt,name=max_allowlist_size,json=maxAllowlistSize,proto3" json:"max_allowlist_size,omitempty" yaml:"max_allowlist_size"`
+	// enabled allows namespaces to be registered and the allowlists of
+	// registered namespaces to be enforced. It is disabled by default.
+	Enabled bool `protobuf:"varint,4,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+	// enforcement_delay is how long after a namespace is registered its
+	// allowlist starts to be enforced.
+	EnforcementDelay time.Duration `protobuf:"bytes,5,opt,name=enforcement_delay,json=enforcementDelay,proto3,stdduration" json:"enforcement_delay" yaml:"enforcement_delay"`
+}
+
+func (m *Params) Reset()      { *m = Params{} }
+func (*Params) ProtoMessage() {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_07098ece915304ed, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRegistrationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RegistrationFee
+	}
+	return nil
+}
+
+func (m *Params) GetRegistrationPeriod() time.Duration {
+	if m != nil {
+		return m.RegistrationPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAllowlistSize() uint32 {
+	if m != nil {
+		return m.MaxAllowlistSize
+	}
+	return 0
+}
+
+func (m *Params) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Params) GetEnforcementDelay() time.Duration {
+	if m != nil {
+		return m.EnforcementDelay
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "celestia.nsreg.v1.Params")
+}
+
+func init() { proto.RegisterFile("celestia/nsreg/v1/params.proto", fileDescriptor_07098ece915304ed) }
+
+var fileDescriptor_07098ece915304ed = []byte{
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xb1, 0x8e, 0xd3, 0x40,
+	0x10, 0x86, 0x6d, 0x72, 0x1c, 0xc8, 0x08, 0xc8, 0x19, 0x24, 0x7c, 0x91, 0xb0, 0x23, 0x0b, 0xa1,
+	0x14, 0xdc, 0xae, 0x0c, 0xdd, 0x75, 0x98, 0x13, 0x4d, 0x9a, 0x53, 0xe8, 0x68, 0xa2, 0xb5, 0x3d,
+	0x31, 0x2b, 0xd6, 0x5e, 0x6b, 0x77, 0x13, 0x92, 0x7b, 0x0a, 0xe8, 0xae, 0xa4, 0xe6, 0x49, 0xae,
+	0xbc, 0x92, 0x2a, 0x87, 0x92, 0x37, 0xc8, 0x0b, 0x80, 0xb2, 0xbb, 0x46, 0x21, 0x20, 0x51, 0x79,
+	0x3c, 0xff, 0xf8, 0xf3, 0xff, 0x6b, 0xc6, 0x0b, 0x73, 0x60, 0x20, 0x15, 0x25, 0xb8, 0x96, 0x02,
+	0x4a, 0x3c, 0x4b, 0x70, 0x43, 0x04, 0xa9, 0x24, 0x6a, 0x04, 0x57, 0xdc, 0x3f, 0x6a, 0x75, 0xa4,
+	0x75, 0x34, 0x4b, 0x7a, 0x8f, 0x4b, 0x5e, 0x72, 0xad, 0xe2, 0x6d, 0x65, 0x06, 0x7b, 0x61, 0xc9,
+	0x79, 0xc9, 0x00, 0xeb, 0xb7, 0x6c, 0x3a, 0xc1, 0xc5, 0x54, 0x10, 0x45, 0x79, 0xdd, 0xea, 0x39,
+	0x97, 0x15, 0x97, 0x38, 0x23, 0x12, 0xf0, 0x2c, 0xc9, 0x40, 0x91, 0x04, 0xe7, 0x9c, 0x5a, 0x3d,
+	0xfe, 0xd9, 0xf1, 0x0e, 0xcf, 0xf5, 0x9f, 0xfd, 0x2f, 0xae, 0xd7, 0x15, 0x50, 0x52, 0xa9, 0x0c,
+	0x61, 0x3c, 0x01, 0x08, 0xdc, 0x7e, 0x67, 0x70, 0xef, 0xe5, 0x31, 0x32, 0x18, 0xb4, 0xc5, 0x20,
+	0x8b, 0x41, 0x6f, 0x38, 0xad, 0xd3, 0xe1, 0xd5, 0x32, 0x72, 0x36, 0xcb, 0xe8, 0xc9, 0x82, 0x54,
+	0xec, 0x34, 0xde, 0x07, 0xc4, 0xdf, 0x6e, 0xa2, 0x41, 0x49, 0xd5, 0x87, 0x69, 0x86, 0x72, 0x5e,
+	0x61, 0x6b, 0xc7, 0x3c, 0x4e, 0x64, 0xf1, 0x11, 0xab, 0x45, 0x03, 0x52, 0xb3, 0xe4, 0xe8, 0xe1,
+	0xee, 0xe7, 0x6f, 0x01, 0x7c, 0xe1, 0x3d, 0xfa, 0x83, 0xd8, 0x80, 0xa0, 0xbc, 0x08, 0x6e, 0xf5,
+	0x5d, 0xed, 0xca, 0x84, 0x47, 0x6d, 0x78, 0x74, 0x66, 0xc3, 0xa7, 0xcf, 0xad, 0xab, 0xde, 0x3f,
+	0x5c, 0x19, 0x46, 0x7c, 0x79, 0x13, 0xb9, 0x23, 0x7f, 0x57, 0x39, 0xd7, 0x82, 0x3f, 0xf4, 0xfc,
+	0x8a, 0xcc, 0xc7, 0x84, 0x31, 0xfe, 0x89, 0x51, 0xa9, 0xc6, 0x92, 0x5e, 0x40, 0xd0, 0xe9, 0xbb,
+	0x83, 0xfb, 0xe9, 0xd3, 0xcd, 0x32, 0x3a, 0x36, 0xcc, 0xbf, 0x67, 0xe2, 0x51, 0xb7, 0x22, 0xf3,
+	0xd7, 0x6d, 0xef, 0x1d, 0xbd, 0x00, 0xff, 0x85, 0x77, 0x07, 0x6a, 0x92, 0x31, 0x28, 0x82, 0x83,
+	0xbe, 0x3b, 0xb8, 0x9b, 0xfa, 0x9b, 0x65, 0xf4, 0xc0, 0x10, 0xac, 0x10, 0x8f, 0xda, 0x11, 0x9f,
+	0x79, 0x47, 0x50, 0x4f, 0xb8, 0xc8, 0xa1, 0x82, 0x5a, 0x8d, 0x0b, 0x60, 0x64, 0x11, 0xdc, 0xfe,
+	0x5f, 0xd8, 0x67, 0x36, 0x6c, 0xd0, 0x62, 0xf7, 0x08, 0x26, 0x6a, 0x77, 0xa7, 0x7f, 0xb6, 0x6d,
+	0x9f, 0x1e, 0x5c, 0x7e, 0x8d, 0x9c, 0x74, 0x78, 0xb5, 0x0a, 0xdd, 0xeb, 0x55, 0xe8, 0xfe, 0x58,
+	0x85, 0xee, 0xe7, 0x75, 0xe8, 0x5c, 0xaf, 0x43, 0xe7, 0xfb, 0x3a, 0x74, 0xde, 0x27, 0xbb, 0x7b,
+	0xb3, 0xf7, 0xc8, 0x45, 0xf9, 0xbb, 0x3e, 0x21, 0x4d, 0x83, 0xe7, 0xf6, 0x82, 0xf5, 0x1a, 0xb3,
+	0x43, 0xed, 0xee, 0xd5, 0xaf, 0x01, 0x00, 0xd3, 0x47, 0x36, 0xd2, 0xe0, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.EnforcementDelay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.EnforcementDelay):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxAllowlistSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAllowlistSize))
+		i--
+		dAtA[i] = 0x18
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RegistrationPeriod, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RegistrationPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x12
+	if len(m.RegistrationFee) > 0 {
+		for iNdEx := len(m.RegistrationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RegistrationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RegistrationFee) > 0 {
+		for _, e := range m.RegistrationFee {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RegistrationPeriod)
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxAllowlistSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxAllowlistSize))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.EnforcementDelay)
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistrationFee = append(m.RegistrationFee, types.Coin{})
+			if err := m.RegistrationFee[len(m.RegistrationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RegistrationPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAllowlistSize", wireType)
+			}
+			m.MaxAllowlistSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAllowlistSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforcementDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.EnforcementDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate returns an error if the owner or allowlist of the registration is
+// invalid.
+func (r Registration) Validate(maxAllowlistSize uint32) error {
+	if _, err := sdk.AccAddressFromBech32(r.Owner); err != nil {
+		return err
+	}
+	if len(r.Allowlist) > int(maxAllowlistSize) {
+		return ErrInvalidAllowlist.Wrapf("allowlist has %d signers, max %d", len(r.Allowlist), maxAllowlistSize)
+	}
+	return ValidateAllowlist(r.Owner, r.Allowlist)
+}
+
+// IsExpired returns true if the registration has expired at the given time.
+func (r Registration) IsExpired(now time.Time) bool {
+	return !now.Before(r.Expiration)
+}
+
+// IsEnforced returns true if the allowlist of the registration is enforced at
+// the given time, that is after its enforcement start and before it expires.
+func (r Registration) IsEnforced(now time.Time) bool {
+	return !now.Before(r.EnforcementStart) && !r.IsExpired(now)
+}
+
+// IsAllowed returns true if the signer is the owner or on the allowlist.
+func (r Registration) IsAllowed(signer string) bool {
+	if signer == r.Owner {
+		return true
+	}
+	for _, allowed := range r.Allowlist {
+		if signer == allowed {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package paramfilter
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	paramsutils "github.com/cosmos/cosmos-sdk/x/params/client/utils"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the CLI query commands for the param filter.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s", ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdQueryPolicies())
+	cmd.AddCommand(CmdSimulateParamChange())
+	return cmd
+}
+
+func CmdQueryPolicies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "policies",
+		Short:   "Query for the blocked parameters, the parameter policies and the pending parameter changes",
+		Args:    cobra.NoArgs,
+		Example: "policies",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := NewQueryClient(clientCtx)
+			resp, err := queryClient.Policies(cmd.Context(), &QueryPolicies{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSimulateParamChange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "simulate-param-change [proposal-file]",
+		Short: "Simulate the execution of a param change proposal",
+		Long: `Simulate the execution of a param change proposal against the current state.
+The proposal file has the same format as for 'tx gov submit-legacy-proposal param-change'.
+The response contains either the error with which the proposal would fail or the
+resulting parameter values.`,
+		Args:    cobra.ExactArgs(1),
+		Example: "simulate-param-change proposal.json",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			p, err := paramsutils.ParseParamChangeProposalJSON(clientCtx.LegacyAmino, args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := NewQueryClient(clientCtx)
+			resp, err := queryClient.SimulateParamChange(cmd.Context(), &QuerySimulateParamChange{Changes: p.Changes.ToParamChanges()})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,54 @@
+package paramfilter
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultGenesis returns the default genesis state. It has no policies, the
+// app provides its own default policies.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool, len(gs.Policies))
+	for i, policy := range gs.Policies {
+		if err := policy.Validate(); err != nil {
+			return fmt.Errorf("policy %d: %w", i, err)
+		}
+		id := paramID(policy.Subspace, policy.Key)
+		if seen[id] {
+			return fmt.Errorf("policy %d: duplicate policy for %s.%s", i, policy.Subspace, policy.Key)
+		}
+		seen[id] = true
+	}
+	for i, change := range gs.PendingChanges {
+		if change.Subspace == "" || change.Key == "" {
+			return fmt.Errorf("pending change %d: must specify a subspace and a key", i)
+		}
+	}
+	return nil
+}
+
+// InitGenesis initializes the paramfilter module's state from a provided
+// genesis state.
+func InitGenesis(ctx sdk.Context, k Keeper, genState GenesisState) {
+	for _, policy := range genState.Policies {
+		k.SetPolicy(ctx, policy)
+	}
+	for _, change := range genState.PendingChanges {
+		k.SchedulePendingChange(ctx, change)
+	}
+}
+
+// ExportGenesis returns the paramfilter module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k Keeper) *GenesisState {
+	return &GenesisState{
+		Policies:       k.GetPolicies(ctx),
+		PendingChanges: k.GetPendingChanges(ctx),
+	}
+}
@@ -12,24 +12,49 @@ import (
 )
 
 // ParamBlockList keeps track of parameters that cannot be changed by governance
-// proposals
+// proposals. Its keeper stores the policies that restrict how other
+// parameters can be changed.
 type ParamBlockList struct {
-	params map[string]bool
+	blocked []BlockedParam
+	params  map[string]bool
+	keeper  Keeper
 }
 
 // NewParamBlockList creates a new ParamBlockList that can be used to block gov
 // proposals that attempt to change locked parameters.
 func NewParamBlockList(blockedParams ...[2]string) ParamBlockList {
 	consolidatedParams := make(map[string]bool, len(blockedParams))
+	blocked := make([]BlockedParam, 0, len(blockedParams))
 	for _, param := range blockedParams {
-		consolidatedParams[fmt.Sprintf("%s-%s", param[0], param[1])] = true
+		id := paramID(param[0], param[1])
+		if !consolidatedParams[id] {
+			blocked = append(blocked, BlockedParam{Subspace: param[0], Key: param[1]})
+		}
+		consolidatedParams[id] = true
 	}
-	return ParamBlockList{params: consolidatedParams}
+	return ParamBlockList{blocked: blocked, params: consolidatedParams}
+}
+
+// WithKeeper returns a copy of the ParamBlockList that enforces the policies
+// stored by the keeper from app version 3 onwards. The keeper also stores the
+// changes that are delayed by a policy.
+func (pbl ParamBlockList) WithKeeper(keeper Keeper) ParamBlockList {
+	return ParamBlockList{blocked: pbl.blocked, params: pbl.params, keeper: keeper}
+}
+
+// BlockedParams returns the blocked parameters in the order in which they
+// were added.
+func (pbl ParamBlockList) BlockedParams() []BlockedParam {
+	return append([]BlockedParam{}, pbl.blocked...)
 }
 
 // IsBlocked returns true if the given parameter is blocked.
 func (pbl ParamBlockList) IsBlocked(subspace string, key string) bool {
-	return pbl.params[fmt.Sprintf("%s-%s", subspace, key)]
+	return pbl.params[paramID(subspace, key)]
+}
+
+func paramID(subspace, key string) string {
+	return fmt.Sprintf("%s-%s", subspace, key)
 }
 
 // GovHandler creates a new governance Handler for a ParamChangeProposal using
@@ -38,7 +63,8 @@ func (pbl ParamBlockList) GovHandler(pk paramskeeper.Keeper) govtypes.Handler {
 	return func(ctx sdk.Context, content govtypes.Content) error {
 		switch c := content.(type) {
 		case *proposal.ParameterChangeProposal:
-			return pbl.handleParameterChangeProposal(ctx, pk, c)
+			_, err := pbl.handleParameterChangeProposal(ctx, pk, c)
+			return err
 
 		default:
 			return sdkerrors.Wrapf(legacysdkerrors.ErrUnknownRequest, "unrecognized param proposal content type: %T", c)
@@ -46,32 +72,84 @@ func (pbl ParamBlockList) GovHandler(pk paramskeeper.Keeper) govtypes.Handler {
 	}
 }
 
+// handleParameterChangeProposal executes the proposal and returns the changes
+// that are delayed by their policy.
 func (pbl ParamBlockList) handleParameterChangeProposal(
 	ctx sdk.Context,
 	pk paramskeeper.Keeper,
 	p *proposal.ParameterChangeProposal,
-) error {
+) ([]PendingParamChange, error) {
 	// throw an error if any of the parameter changes are blocked
 	for _, c := range p.Changes {
 		if pbl.IsBlocked(c.Subspace, c.Key) {
-			return ErrBlockedParameter
+			return nil, ErrBlockedParameter
+		}
+	}
+
+	// throw an error if any of the parameter changes violate their policy
+	policiesEnabled := storeMounted(ctx)
+	if policiesEnabled {
+		for _, c := range p.Changes {
+			if err := pbl.checkPolicy(ctx, pk, c); err != nil {
+				return nil, err
+			}
 		}
 	}
 
+	pending := []PendingParamChange{}
 	for _, c := range p.Changes {
 		ss, ok := pk.GetSubspace(c.Subspace)
 		if !ok {
-			return sdkerrors.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
+			return nil, sdkerrors.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
 		}
 
 		pk.Logger(ctx).Info(
 			fmt.Sprintf("attempt to set new parameter value; key: %s, value: %s", c.Key, c.Value),
 		)
 
+		policy, hasPolicy := pbl.keeper.GetPolicy(ctx, c.Subspace, c.Key)
+		if policiesEnabled && hasPolicy && policy.Delay > 0 {
+			// validate the value now so that the change can't fail once it
+			// takes effect, unless the parameter's validation changes
+			cacheCtx, _ := ctx.CacheContext()
+			if err := ss.Update(cacheCtx, []byte(c.Key), []byte(c.Value)); err != nil {
+				return nil, sdkerrors.Wrapf(proposal.ErrSettingParameter, "key: %s, value: %s, err: %s", c.Key, c.Value, err.Error())
+			}
+			change := PendingParamChange{
+				Subspace:      c.Subspace,
+				Key:           c.Key,
+				Value:         c.Value,
+				EffectiveTime: ctx.BlockTime().Add(policy.Delay),
+			}
+			pbl.keeper.SchedulePendingChange(ctx, change)
+			pending = append(pending, change)
+			continue
+		}
+
 		if err := ss.Update(ctx, []byte(c.Key), []byte(c.Value)); err != nil {
-			return sdkerrors.Wrapf(proposal.ErrSettingParameter, "key: %s, value: %s, err: %s", c.Key, c.Value, err.Error())
+			return nil, sdkerrors.Wrapf(proposal.ErrSettingParameter, "key: %s, value: %s, err: %s", c.Key, c.Value, err.Error())
 		}
 	}
 
+	return pending, nil
+}
+
+// checkPolicy returns an error if the parameter change violates the policy of
+// the parameter or if the parameter has a pending change.
+func (pbl ParamBlockList) checkPolicy(ctx sdk.Context, pk paramskeeper.Keeper, c proposal.ParamChange) error {
+	policy, ok := pbl.keeper.GetPolicy(ctx, c.Subspace, c.Key)
+	if !ok {
+		return nil
+	}
+	ss, ok := pk.GetSubspace(c.Subspace)
+	if !ok {
+		return sdkerrors.Wrap(proposal.ErrUnknownSubspace, c.Subspace)
+	}
+	if pbl.keeper.HasPendingChange(ctx, c.Subspace, c.Key) {
+		return sdkerrors.Wrapf(ErrPolicyViolation, "%s.%s has a pending change", c.Subspace, c.Key)
+	}
+	if err := policy.Check(ss.GetRaw(ctx, []byte(c.Key)), []byte(c.Value)); err != nil {
+		return sdkerrors.Wrapf(ErrPolicyViolation, "%s.%s: %s", c.Subspace, c.Key, err)
+	}
 	return nil
 }
@@ -0,0 +1,79 @@
+package paramfilter
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ QueryServer = &QueryServerImpl{}
+
+// QueryServerImpl wraps the ParamBlockList and implements the paramfilter gRPC
+// query server.
+type QueryServerImpl struct {
+	blockList    ParamBlockList
+	paramsKeeper paramskeeper.Keeper
+}
+
+// NewQueryServerImpl creates a new QueryServerImpl.
+func NewQueryServerImpl(pbl ParamBlockList, pk paramskeeper.Keeper) *QueryServerImpl {
+	return &QueryServerImpl{blockList: pbl, paramsKeeper: pk}
+}
+
+// Policies returns the blocked parameters, the policies and the pending
+// parameter changes.
+func (q *QueryServerImpl) Policies(ctx context.Context, _ *QueryPolicies) (*QueryPoliciesResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &QueryPoliciesResponse{
+		BlockedParams:  q.blockList.BlockedParams(),
+		Policies:       q.blockList.keeper.GetPolicies(sdkCtx),
+		PendingChanges: q.blockList.keeper.GetPendingChanges(sdkCtx),
+	}, nil
+}
+
+// SimulateParamChange executes a ParameterChangeProposal with the requested
+// changes in a branch of the current state. The branch is discarded so the
+// simulation never affects the state.
+func (q *QueryServerImpl) SimulateParamChange(ctx context.Context, req *QuerySimulateParamChange) (*QuerySimulateParamChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+
+	p := proposal.NewParameterChangeProposal("simulation", "simulation", req.Changes)
+	if err := p.ValidateBasic(); err != nil {
+		return simulationError(err), nil
+	}
+	pending, err := q.blockList.handleParameterChangeProposal(cacheCtx, q.paramsKeeper, p)
+	if err != nil {
+		return simulationError(err), nil
+	}
+
+	values := make([]proposal.ParamChange, 0, len(req.Changes))
+	for _, c := range req.Changes {
+		ss, _ := q.paramsKeeper.GetSubspace(c.Subspace)
+		values = append(values, proposal.NewParamChange(c.Subspace, c.Key, string(ss.GetRaw(cacheCtx, []byte(c.Key)))))
+	}
+	return &QuerySimulateParamChangeResponse{Values: values, PendingChanges: pending}, nil
+}
+
+func simulationError(err error) *QuerySimulateParamChangeResponse {
+	codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+	return &QuerySimulateParamChangeResponse{Error: err.Error(), Codespace: codespace, Code: code}
+}
+
+// RegisterGRPCGatewayRoutes mounts the paramfilter query service's GRPC-gateway
+// routes on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	err := RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
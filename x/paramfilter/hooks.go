@@ -0,0 +1,30 @@
+package paramfilter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+var _ govtypes.GovHooks = Hooks{}
+
+// Hooks sets the id of the proposal that scheduled a pending parameter change
+// once the proposal has been executed.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the governance hooks of the paramfilter keeper.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterProposalVotingPeriodEnded is called after the messages of a passed
+// proposal have been executed.
+func (h Hooks) AfterProposalVotingPeriodEnded(ctx sdk.Context, proposalID uint64) {
+	h.k.setProposalID(ctx, proposalID)
+}
+
+func (h Hooks) AfterProposalSubmission(sdk.Context, uint64)              {}
+func (h Hooks) AfterProposalDeposit(sdk.Context, uint64, sdk.AccAddress) {}
+func (h Hooks) AfterProposalVote(sdk.Context, uint64, sdk.AccAddress)    {}
+func (h Hooks) AfterProposalFailedMinDeposit(sdk.Context, uint64)        {}
@@ -0,0 +1,220 @@
+package paramfilter
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
+)
+
+var (
+	// sequenceKey is the store key for the number of pending changes that
+	// have been scheduled.
+	sequenceKey = []byte{0x00}
+	// pendingKeyPrefix is the store key prefix for the pending changes. They
+	// are keyed by their effective time followed by their sequence number so
+	// that they are iterated in the order in which they take effect.
+	pendingKeyPrefix = []byte{0x01}
+	// policyKeyPrefix is the store key prefix for the policies. They are
+	// keyed by their subspace and key separated by a zero byte so that they
+	// are iterated in the order of their subspace and key.
+	policyKeyPrefix = []byte{0x02}
+)
+
+// PendingChangeHooks is notified when a pending parameter change takes
+// effect.
+type PendingChangeHooks interface {
+	// AfterPendingChangeApplied is called after the parameter change of the
+	// proposal with proposalID has taken effect.
+	AfterPendingChangeApplied(ctx sdk.Context, subspace, key string, proposalID uint64)
+}
+
+// Keeper stores the param policies and the parameter changes that have been
+// approved by governance but that only take effect after the delay required
+// by their policy.
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeKey     storetypes.StoreKey
+	paramsKeeper paramskeeper.Keeper
+	hooks        PendingChangeHooks
+}
+
+// NewKeeper creates a new paramfilter Keeper.
+func NewKeeper(cdc codec.BinaryCodec, storeKey storetypes.StoreKey, paramsKeeper paramskeeper.Keeper) Keeper {
+	return Keeper{
+		cdc:          cdc,
+		storeKey:     storeKey,
+		paramsKeeper: paramsKeeper,
+	}
+}
+
+// SetHooks sets the hooks that are notified when a pending parameter change
+// takes effect.
+func (k *Keeper) SetHooks(hooks PendingChangeHooks) *Keeper {
+	k.hooks = hooks
+	return k
+}
+
+// SetPolicy stores the policy of a parameter, replacing its previous policy
+// if any.
+func (k Keeper) SetPolicy(ctx sdk.Context, policy ParamPolicy) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), policyKeyPrefix)
+	store.Set(policyKey(policy.Subspace, policy.Key), k.cdc.MustMarshal(&policy))
+}
+
+// GetPolicy returns the policy of the given parameter.
+func (k Keeper) GetPolicy(ctx sdk.Context, subspace, key string) (ParamPolicy, bool) {
+	if !storeMounted(ctx) {
+		return ParamPolicy{}, false
+	}
+	bz := prefix.NewStore(ctx.KVStore(k.storeKey), policyKeyPrefix).Get(policyKey(subspace, key))
+	if bz == nil {
+		return ParamPolicy{}, false
+	}
+	var policy ParamPolicy
+	k.cdc.MustUnmarshal(bz, &policy)
+	return policy, true
+}
+
+// GetPolicies returns the policies ordered by subspace and key.
+func (k Keeper) GetPolicies(ctx sdk.Context) []ParamPolicy {
+	policies := []ParamPolicy{}
+	if !storeMounted(ctx) {
+		return policies
+	}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), policyKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var policy ParamPolicy
+		k.cdc.MustUnmarshal(iterator.Value(), &policy)
+		policies = append(policies, policy)
+	}
+	return policies
+}
+
+func policyKey(subspace, key string) []byte {
+	return append(append([]byte(subspace), 0x00), key...)
+}
+
+// SchedulePendingChange stores a parameter change that takes effect at its
+// effective time.
+func (k Keeper) SchedulePendingChange(ctx sdk.Context, change PendingParamChange) {
+	store := ctx.KVStore(k.storeKey)
+	sequence := uint64(0)
+	if bz := store.Get(sequenceKey); bz != nil {
+		sequence = binary.BigEndian.Uint64(bz)
+	}
+	store.Set(sequenceKey, binary.BigEndian.AppendUint64(nil, sequence+1))
+
+	key := append(sdk.FormatTimeBytes(change.EffectiveTime), binary.BigEndian.AppendUint64(nil, sequence)...)
+	prefix.NewStore(store, pendingKeyPrefix).Set(key, k.cdc.MustMarshal(&change))
+}
+
+// GetPendingChanges returns the parameter changes that have not taken effect
+// yet, ordered by their effective time.
+func (k Keeper) GetPendingChanges(ctx sdk.Context) []PendingParamChange {
+	changes := []PendingParamChange{}
+	if !storeMounted(ctx) {
+		return changes
+	}
+	iterator := prefix.NewStore(ctx.KVStore(k.storeKey), pendingKeyPrefix).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var change PendingParamChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// HasPendingChange returns true if the parameter has a change that has not
+// taken effect yet.
+func (k Keeper) HasPendingChange(ctx sdk.Context, subspace, key string) bool {
+	for _, change := range k.GetPendingChanges(ctx) {
+		if change.Subspace == subspace && change.Key == key {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyPendingChanges applies the parameter changes whose effective time has
+// been reached. A change that fails to apply, e.g. because the subspace has
+// since been removed, is logged and dropped.
+func (k Keeper) ApplyPendingChanges(ctx sdk.Context) {
+	if !storeMounted(ctx) {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), pendingKeyPrefix)
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
+	var applied [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		applied = append(applied, iterator.Key())
+		var change PendingParamChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		if err := k.apply(ctx, change); err != nil {
+			k.paramsKeeper.Logger(ctx).Error(
+				fmt.Sprintf("failed to apply pending parameter change; key: %s, value: %s, err: %s", change.Key, change.Value, err),
+			)
+		}
+	}
+	iterator.Close()
+
+	for _, key := range applied {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) apply(ctx sdk.Context, change PendingParamChange) error {
+	ss, ok := k.paramsKeeper.GetSubspace(change.Subspace)
+	if !ok {
+		return fmt.Errorf("unknown subspace %s", change.Subspace)
+	}
+	cacheCtx, write := ctx.CacheContext()
+	if err := ss.Update(cacheCtx, []byte(change.Key), []byte(change.Value)); err != nil {
+		return err
+	}
+	write()
+	k.paramsKeeper.Logger(ctx).Info(
+		fmt.Sprintf("applied pending parameter change; key: %s, value: %s", change.Key, change.Value),
+	)
+	if k.hooks != nil {
+		k.hooks.AfterPendingChangeApplied(ctx, change.Subspace, change.Key, change.ProposalId)
+	}
+	return nil
+}
+
+// setProposalID sets the proposal id of the pending changes that don't have
+// one yet, i.e. those scheduled by the proposal that was just executed.
+func (k Keeper) setProposalID(ctx sdk.Context, proposalID uint64) {
+	if !storeMounted(ctx) {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), pendingKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	updated := make(map[string]PendingParamChange)
+	for ; iterator.Valid(); iterator.Next() {
+		var change PendingParamChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		if change.ProposalId == 0 {
+			change.ProposalId = proposalID
+			updated[string(iterator.Key())] = change
+		}
+	}
+	iterator.Close()
+
+	for key, change := range updated {
+		store.Set([]byte(key), k.cdc.MustMarshal(&change))
+	}
+}
+
+// storeMounted returns true if the paramfilter store is mounted at the app
+// version of ctx.
+func storeMounted(ctx sdk.Context) bool {
+	return ctx.BlockHeader().Version.App > v2.Version
+}
@@ -0,0 +1,134 @@
+package paramfilter
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+const (
+	// consensusVersion defines the current x/paramfilter module consensus
+	// version.
+	consensusVersion uint64 = 1
+)
+
+var (
+	_ module.AppModule      = AppModule{}
+	_ module.AppModuleBasic = AppModuleBasic{}
+)
+
+// AppModuleBasic implements the AppModuleBasic interface for the paramfilter
+// module.
+type AppModuleBasic struct {
+	// DefaultPolicies are the policies of the default genesis state.
+	DefaultPolicies []ParamPolicy
+}
+
+// Name returns the paramfilter module's name.
+func (AppModuleBasic) Name() string {
+	return ModuleName
+}
+
+// RegisterLegacyAminoCodec does nothing because the module has no messages.
+func (AppModuleBasic) RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {}
+
+// RegisterInterfaces does nothing because the module has no messages.
+func (AppModuleBasic) RegisterInterfaces(_ cdctypes.InterfaceRegistry) {}
+
+// DefaultGenesis returns the paramfilter module's default genesis state.
+func (amb AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	genState := DefaultGenesis()
+	genState.Policies = amb.DefaultPolicies
+	return cdc.MustMarshalJSON(genState)
+}
+
+// ValidateGenesis performs genesis state validation for the paramfilter
+// module.
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, _ client.TxEncodingConfig, bz json.RawMessage) error {
+	var genState GenesisState
+	if err := cdc.UnmarshalJSON(bz, &genState); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", ModuleName, err)
+	}
+	return genState.Validate()
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the module.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	RegisterGRPCGatewayRoutes(clientCtx, mux)
+}
+
+// GetTxCmd returns nil because the module has no messages.
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return nil
+}
+
+// GetQueryCmd returns the paramfilter module's root query command.
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return GetQueryCmd()
+}
+
+// AppModule implements the AppModule interface for the paramfilter module.
+type AppModule struct {
+	AppModuleBasic
+	keeper Keeper
+}
+
+// NewAppModule creates a new AppModule object. The default policies are
+// stored when the module is added by an upgrade.
+func NewAppModule(keeper Keeper, defaultPolicies ...ParamPolicy) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{DefaultPolicies: defaultPolicies},
+		keeper:         keeper,
+	}
+}
+
+// RegisterInvariants does nothing because there are no invariants to enforce.
+func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+
+// Route returns an empty route because the module has no messages.
+func (AppModule) Route() sdk.Route {
+	return sdk.Route{}
+}
+
+// QuerierRoute returns the query routing key used for ABCI queries.
+func (AppModule) QuerierRoute() string {
+	return ModuleName
+}
+
+// LegacyQuerierHandler returns nil because there are no legacy queriers.
+func (AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier {
+	return nil
+}
+
+// RegisterServices does nothing because the query service needs the
+// ParamBlockList and is therefore registered by the app.
+func (AppModule) RegisterServices(_ module.Configurator) {}
+
+// InitGenesis performs the paramfilter module's genesis initialization. It
+// returns no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, gs json.RawMessage) []abci.ValidatorUpdate {
+	var genState GenesisState
+	cdc.MustUnmarshalJSON(gs, &genState)
+
+	InitGenesis(ctx, am.keeper, genState)
+
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns the paramfilter module's exported genesis state as raw
+// JSON bytes.
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	genState := ExportGenesis(ctx, am.keeper)
+	return cdc.MustMarshalJSON(genState)
+}
+
+// ConsensusVersion returns the consensus version of this module.
+func (AppModule) ConsensusVersion() uint64 { return consensusVersion }
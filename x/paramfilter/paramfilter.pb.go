@@ -0,0 +1,974 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/paramfilter/v1/paramfilter.proto
+
+package paramfilter
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ParamPolicy restricts how a parameter can be changed by a
+// ParameterChangeProposal.
+type ParamPolicy struct {
+	Subspace string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
+	Key      string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// Min is the optional lower bound of the parameter.
+	Min *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min,omitempty"`
+	// Max is the optional upper bound of the parameter.
+	Max *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=max,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max,omitempty"`
+	// MaxRelativeChange is the optional maximum change of the parameter by a
+	// single proposal relative to its current value, e.g. 0.5 allows the
+	// parameter to change by at most 50%.
+	MaxRelativeChange *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=max_relative_change,json=maxRelativeChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_relative_change,omitempty"`
+	// Delay is the time between the execution of a proposal and the new value
+	// taking effect.
+	Delay time.Duration `protobuf:"bytes,6,opt,name=delay,proto3,stdduration" json:"delay"`
+}
+
+func (m *ParamPolicy) Reset()         { *m = ParamPolicy{} }
+func (m *ParamPolicy) String() string { return proto.CompactTextString(m) }
+func (*ParamPolicy) ProtoMessage()    {}
+func (*ParamPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea68c64e44781809, []int{0}
+}
+func (m *ParamPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParamPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParamPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParamPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParamPolicy.Merge(m, src)
+}
+func (m *ParamPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParamPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParamPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParamPolicy proto.InternalMessageInfo
+
+func (m *ParamPolicy) GetSubspace() string {
+	if m != nil {
+		return m.Subspace
+	}
+	return ""
+}
+
+func (m *ParamPolicy) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ParamPolicy) GetDelay() time.Duration {
+	if m != nil {
+		return m.Delay
+	}
+	return 0
+}
+
+// PendingParamChange is a parameter change of an executed proposal that takes
+// effect once the block time reaches EffectiveTime.
+type PendingParamChange struct {
+	Subspace      string    `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
+	Key           string    `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value         string    `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	EffectiveTime time.Time `protobuf:"bytes,4,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+	// ProposalId is the id of the proposal that made the change.
+	ProposalId uint64 `protobuf:"varint,5,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *PendingParamChange) Reset()         { *m = PendingParamChange{} }
+func (m *PendingParamChange) String() string { return proto.CompactTextString(m) }
+func (*PendingParamChange) ProtoMessage()    {}
+func (*PendingParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea68c64e44781809, []int{1}
+}
+func (m *PendingParamChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingParamChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingParamChange.Merge(m, src)
+}
+func (m *PendingParamChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingParamChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingParamChange proto.InternalMessageInfo
+
+func (m *PendingParamChange) GetSubspace() string {
+	if m != nil {
+		return m.Subspace
+	}
+	return ""
+}
+
+func (m *PendingParamChange) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *PendingParamChange) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *PendingParamChange) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return time.Time{}
+}
+
+func (m *PendingParamChange) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ParamPolicy)(nil), "celestia.paramfilter.v1.ParamPolicy")
+	proto.RegisterType((*PendingParamChange)(nil), "celestia.paramfilter.v1.PendingParamChange")
+}
+
+func init() {
+	proto.RegisterFile("celestia/paramfilter/v1/paramfilter.proto", fileDescriptor_ea68c64e44781809)
+}
+
+var fileDescriptor_ea68c64e44781809 = []byte{
+	// 451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
+	0x14, 0x8e, 0x9b, 0xa6, 0x2a, 0x17, 0x81, 0xe0, 0xa8, 0x84, 0x9b, 0xc1, 0xae, 0x3a, 0xa0, 0x32,
+	0xc4, 0x56, 0xca, 0x02, 0x6b, 0xc8, 0x02, 0x5d, 0x22, 0x8b, 0x89, 0x25, 0xba, 0x9c, 0x5f, 0x9c,
+	0x53, 0x6d, 0xdf, 0xc9, 0x77, 0x8e, 0x9c, 0xff, 0xa2, 0x23, 0x7f, 0x08, 0x7f, 0x03, 0xea, 0x58,
+	0x31, 0x21, 0x86, 0x82, 0x92, 0x7f, 0x82, 0x11, 0xdd, 0x8f, 0x44, 0x16, 0x4c, 0xa8, 0x93, 0xdf,
+	0x7b, 0xdf, 0xbb, 0x4f, 0xdf, 0x7d, 0xdf, 0x19, 0xbd, 0xa2, 0x90, 0x83, 0x54, 0x8c, 0xc4, 0x82,
+	0x54, 0xa4, 0x58, 0xb0, 0x5c, 0x41, 0x15, 0xaf, 0x46, 0xed, 0x36, 0x12, 0x15, 0x57, 0x1c, 0xbf,
+	0xd8, 0xad, 0x46, 0x6d, 0x6c, 0x35, 0x1a, 0x9c, 0x64, 0x3c, 0xe3, 0x66, 0x27, 0xd6, 0x95, 0x5d,
+	0x1f, 0x9c, 0x52, 0x2e, 0x0b, 0x2e, 0x67, 0x16, 0xb0, 0x8d, 0x83, 0x82, 0x8c, 0xf3, 0x2c, 0x87,
+	0xd8, 0x74, 0xf3, 0x7a, 0x11, 0xa7, 0x75, 0x45, 0x14, 0xe3, 0xa5, 0xc3, 0xc3, 0xbf, 0x71, 0xc5,
+	0x0a, 0x90, 0x8a, 0x14, 0xc2, 0x2e, 0x9c, 0xff, 0x3e, 0x40, 0xfd, 0xa9, 0x16, 0x31, 0xe5, 0x39,
+	0xa3, 0x6b, 0x3c, 0x40, 0xc7, 0xb2, 0x9e, 0x4b, 0x41, 0x28, 0xf8, 0xde, 0x99, 0x77, 0xf1, 0x28,
+	0xd9, 0xf7, 0xf8, 0x29, 0xea, 0x5e, 0xc3, 0xda, 0x3f, 0x30, 0x63, 0x5d, 0xe2, 0x0f, 0xa8, 0x5b,
+	0xb0, 0xd2, 0xef, 0xea, 0xc9, 0xf8, 0xcd, 0x8f, 0xfb, 0xf0, 0x65, 0xc6, 0xd4, 0xb2, 0x9e, 0x47,
+	0x94, 0x17, 0x4e, 0xa8, 0xfb, 0x0c, 0x65, 0x7a, 0x1d, 0xab, 0xb5, 0x00, 0x19, 0x4d, 0x80, 0x7e,
+	0xfb, 0x32, 0x44, 0xee, 0x1e, 0x13, 0xa0, 0x89, 0x26, 0x31, 0x5c, 0xa4, 0xf1, 0x0f, 0x1f, 0xcc,
+	0x45, 0x1a, 0xbc, 0x44, 0xcf, 0x0b, 0xd2, 0xcc, 0x2a, 0xc8, 0x89, 0x62, 0x2b, 0x98, 0xd1, 0x25,
+	0x29, 0x33, 0xf0, 0x7b, 0x0f, 0xe4, 0x7e, 0x56, 0x90, 0x26, 0x71, 0x9c, 0xef, 0x0c, 0x25, 0x7e,
+	0x8b, 0x7a, 0x29, 0xe4, 0x64, 0xed, 0x1f, 0x9d, 0x79, 0x17, 0xfd, 0xcb, 0xd3, 0xc8, 0x1a, 0x1e,
+	0xed, 0x0c, 0x8f, 0x26, 0x2e, 0x90, 0xf1, 0xf1, 0xed, 0x7d, 0xd8, 0xf9, 0xfc, 0x33, 0xf4, 0x12,
+	0x7b, 0xe2, 0xfc, 0xab, 0x87, 0xf0, 0x14, 0xca, 0x94, 0x95, 0x99, 0x49, 0xc0, 0x31, 0xfe, 0x5f,
+	0x02, 0x27, 0xa8, 0xb7, 0x22, 0x79, 0x0d, 0x36, 0x83, 0xc4, 0x36, 0xf8, 0x0a, 0x3d, 0x81, 0xc5,
+	0x02, 0xa8, 0xb9, 0xbc, 0x8e, 0xdc, 0xd8, 0xda, 0xbf, 0x1c, 0xfc, 0x23, 0xef, 0xe3, 0xee, 0x3d,
+	0x58, 0x7d, 0x37, 0x5a, 0xdf, 0xe3, 0xfd, 0x59, 0x8d, 0xe2, 0x10, 0xf5, 0x45, 0xc5, 0x05, 0x97,
+	0x24, 0x9f, 0xb1, 0xd4, 0x98, 0x78, 0x98, 0xa0, 0xdd, 0xe8, 0x7d, 0x3a, 0xbe, 0xba, 0xdd, 0x04,
+	0xde, 0xdd, 0x26, 0xf0, 0x7e, 0x6d, 0x02, 0xef, 0x66, 0x1b, 0x74, 0xee, 0xb6, 0x41, 0xe7, 0xfb,
+	0x36, 0xe8, 0x7c, 0x1a, 0xb5, 0x6d, 0x76, 0x6f, 0x9e, 0x57, 0xd9, 0xbe, 0x1e, 0x12, 0x21, 0xe2,
+	0xa6, 0xfd, 0x87, 0xcc, 0x8f, 0x8c, 0xb4, 0xd7, 0x7f, 0x06, 0x00, 0xda, 0x45, 0xba, 0x45, 0x4f,
+	0x03, 0x00, 0x00,
+}
+
+func (m *ParamPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Delay, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParamfilter(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.MaxRelativeChange != nil {
+		{
+			size := m.MaxRelativeChange.Size()
+			i -= size
+			if _, err := m.MaxRelativeChange.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintParamfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Max != nil {
+		{
+			size := m.Max.Size()
+			i -= size
+			if _, err := m.Max.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintParamfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Min != nil {
+		{
+			size := m.Min.Size()
+			i -= size
+			if _, err := m.Min.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintParamfilter(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintParamfilter(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintParamfilter(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingParamChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingParamChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintParamfilter(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x28
+	}
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EffectiveTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParamfilter(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintParamfilter(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintParamfilter(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintParamfilter(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParamfilter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParamfilter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovParamfilter(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovParamfilter(uint64(l))
+	}
+	if m.Min != nil {
+		l = m.Min.Size()
+		n += 1 + l + sovParamfilter(uint64(l))
+	}
+	if m.Max != nil {
+		l = m.Max.Size()
+		n += 1 + l + sovParamfilter(uint64(l))
+	}
+	if m.MaxRelativeChange != nil {
+		l = m.MaxRelativeChange.Size()
+		n += 1 + l + sovParamfilter(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Delay)
+	n += 1 + l + sovParamfilter(uint64(l))
+	return n
+}
+
+func (m *PendingParamChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovParamfilter(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovParamfilter(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovParamfilter(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovParamfilter(uint64(l))
+	if m.ProposalId != 0 {
+		n += 1 + sovParamfilter(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func sovParamfilter(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParamfilter(x uint64) (n int) {
+	return sovParamfilter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParamfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Min", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Min = &v
+			if err := m.Min.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Max", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.Max = &v
+			if err := m.Max.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRelativeChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.MaxRelativeChange = &v
+			if err := m.MaxRelativeChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Delay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParamfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingParamChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParamfilter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingParamChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamfilter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParamfilter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParamfilter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParamfilter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParamfilter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParamfilter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParamfilter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParamfilter
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParamfilter
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParamfilter
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParamfilter        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParamfilter          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParamfilter = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,84 @@
+package paramfilter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate returns an error if the policy is malformed.
+func (p ParamPolicy) Validate() error {
+	if p.Subspace == "" || p.Key == "" {
+		return fmt.Errorf("policy must specify a subspace and a key")
+	}
+	if p.Min != nil && p.Max != nil && p.Min.GT(*p.Max) {
+		return fmt.Errorf("policy of %s.%s: min %s cannot be greater than max %s", p.Subspace, p.Key, p.Min, p.Max)
+	}
+	if p.MaxRelativeChange != nil && p.MaxRelativeChange.IsNegative() {
+		return fmt.Errorf("policy of %s.%s: max relative change cannot be negative: %s", p.Subspace, p.Key, p.MaxRelativeChange)
+	}
+	if p.Delay < 0 {
+		return fmt.Errorf("policy of %s.%s: delay cannot be negative: %s", p.Subspace, p.Key, p.Delay)
+	}
+	return nil
+}
+
+// IsNumeric returns true if the policy restricts the value of the parameter
+// which must therefore be numeric.
+func (p ParamPolicy) IsNumeric() bool {
+	return p.Min != nil || p.Max != nil || p.MaxRelativeChange != nil
+}
+
+// Check returns an error if changing the parameter from the JSON encoded
+// current value to the JSON encoded new value violates the policy. The
+// relative change is not restricted if the parameter has not been set or is
+// zero.
+func (p ParamPolicy) Check(current, value []byte) error {
+	if !p.IsNumeric() {
+		return nil
+	}
+	newValue, err := parseNumericParam(value)
+	if err != nil {
+		return err
+	}
+	if p.Min != nil && newValue.LT(*p.Min) {
+		return fmt.Errorf("%s is below the min %s", newValue, p.Min)
+	}
+	if p.Max != nil && newValue.GT(*p.Max) {
+		return fmt.Errorf("%s is above the max %s", newValue, p.Max)
+	}
+	if p.MaxRelativeChange == nil || len(current) == 0 {
+		return nil
+	}
+	currentValue, err := parseNumericParam(current)
+	if err != nil {
+		return err
+	}
+	if currentValue.IsZero() {
+		return nil
+	}
+	relativeChange := newValue.Sub(currentValue).Abs().Quo(currentValue.Abs())
+	if relativeChange.GT(*p.MaxRelativeChange) {
+		return fmt.Errorf("changing %s to %s is a relative change of %s, above the max %s", currentValue, newValue, relativeChange, p.MaxRelativeChange)
+	}
+	return nil
+}
+
+// parseNumericParam parses a JSON encoded numeric parameter. Amino JSON
+// encodes 64 bit integers and decimals as strings and smaller integers as
+// numbers so both are accepted.
+func parseNumericParam(bz []byte) (sdk.Dec, error) {
+	raw := strings.TrimSpace(string(bz))
+	if strings.HasPrefix(raw, `"`) {
+		if err := json.Unmarshal([]byte(raw), &raw); err != nil {
+			return sdk.Dec{}, err
+		}
+	}
+	value, err := sdk.NewDecFromStr(raw)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("value %s is not numeric: %w", bz, err)
+	}
+	return value, nil
+}
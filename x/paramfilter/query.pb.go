@@ -0,0 +1,1525 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: celestia/paramfilter/v1/query.proto
+
+package paramfilter
+
+import (
+	context "context"
+	fmt "fmt"
+	proposal "github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BlockedParam is a parameter that can't be changed by governance.
+type BlockedParam struct {
+	Subspace string `protobuf:"bytes,1,opt,name=subspace,proto3" json:"subspace,omitempty"`
+	Key      string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+}
+
+func (m *BlockedParam) Reset()         { *m = BlockedParam{} }
+func (m *BlockedParam) String() string { return proto.CompactTextString(m) }
+func (*BlockedParam) ProtoMessage()    {}
+func (*BlockedParam) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7e89f8360e6682, []int{0}
+}
+func (m *BlockedParam) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockedParam) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockedParam.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockedParam) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockedParam.Merge(m, src)
+}
+func (m *BlockedParam) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockedParam) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockedParam.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockedParam proto.InternalMessageInfo
+
+func (m *BlockedParam) GetSubspace() string {
+	if m != nil {
+		return m.Subspace
+	}
+	return ""
+}
+
+func (m *BlockedParam) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+// QueryPolicies is the request type for the Query/Policies RPC method.
+type QueryPolicies struct {
+}
+
+func (m *QueryPolicies) Reset()         { *m = QueryPolicies{} }
+func (m *QueryPolicies) String() string { return proto.CompactTextString(m) }
+func (*QueryPolicies) ProtoMessage()    {}
+func (*QueryPolicies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7e89f8360e6682, []int{1}
+}
+func (m *QueryPolicies) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPolicies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPolicies.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPolicies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPolicies.Merge(m, src)
+}
+func (m *QueryPolicies) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPolicies) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPolicies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPolicies proto.InternalMessageInfo
+
+// QueryPoliciesResponse is the response type for Query/Policies RPC method.
+type QueryPoliciesResponse struct {
+	BlockedParams  []BlockedParam       `protobuf:"bytes,1,rep,name=blocked_params,json=blockedParams,proto3" json:"blocked_params"`
+	Policies       []ParamPolicy        `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies"`
+	PendingChanges []PendingParamChange `protobuf:"bytes,3,rep,name=pending_changes,json=pendingChanges,proto3" json:"pending_changes"`
+}
+
+func (m *QueryPoliciesResponse) Reset()         { *m = QueryPoliciesResponse{} }
+func (m *QueryPoliciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoliciesResponse) ProtoMessage()    {}
+func (*QueryPoliciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7e89f8360e6682, []int{2}
+}
+func (m *QueryPoliciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoliciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoliciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoliciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoliciesResponse.Merge(m, src)
+}
+func (m *QueryPoliciesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoliciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoliciesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoliciesResponse proto.InternalMessageInfo
+
+func (m *QueryPoliciesResponse) GetBlockedParams() []BlockedParam {
+	if m != nil {
+		return m.BlockedParams
+	}
+	return nil
+}
+
+func (m *QueryPoliciesResponse) GetPolicies() []ParamPolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+func (m *QueryPoliciesResponse) GetPendingChanges() []PendingParamChange {
+	if m != nil {
+		return m.PendingChanges
+	}
+	return nil
+}
+
+// QuerySimulateParamChange is the request type for the
+// Query/SimulateParamChange RPC method.
+type QuerySimulateParamChange struct {
+	Changes []proposal.ParamChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes"`
+}
+
+func (m *QuerySimulateParamChange) Reset()         { *m = QuerySimulateParamChange{} }
+func (m *QuerySimulateParamChange) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateParamChange) ProtoMessage()    {}
+func (*QuerySimulateParamChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7e89f8360e6682, []int{3}
+}
+func (m *QuerySimulateParamChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateParamChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateParamChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateParamChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateParamChange.Merge(m, src)
+}
+func (m *QuerySimulateParamChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateParamChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateParamChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateParamChange proto.InternalMessageInfo
+
+func (m *QuerySimulateParamChange) GetChanges() []proposal.ParamChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
+}
+
+// QuerySimulateParamChangeResponse is the response type for
+// Query/SimulateParamChange RPC method.
+type QuerySimulateParamChangeResponse struct {
+	// Error is the error with which the proposal would fail. It is empty if the
+	// proposal would succeed.
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// Codespace and Code identify the error.
+	Codespace string `protobuf:"bytes,2,opt,name=codespace,proto3" json:"codespace,omitempty"`
+	Code      uint32 `protobuf:"varint,3,opt,name=code,proto3" json:"code,omitempty"`
+	// Values are the values of the changed parameters after the proposal has
+	// been executed. A delayed change is not reflected until it takes effect.
+	Values []proposal.ParamChange `protobuf:"bytes,4,rep,name=values,proto3" json:"values"`
+	// PendingChanges are the changes of the proposal that are delayed by their
+	// policy.
+	PendingChanges []PendingParamChange `protobuf:"bytes,5,rep,name=pending_changes,json=pendingChanges,proto3" json:"pending_changes"`
+}
+
+func (m *QuerySimulateParamChangeResponse) Reset()         { *m = QuerySimulateParamChangeResponse{} }
+func (m *QuerySimulateParamChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateParamChangeResponse) ProtoMessage()    {}
+func (*QuerySimulateParamChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e7e89f8360e6682, []int{4}
+}
+func (m *QuerySimulateParamChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateParamChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateParamChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateParamChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateParamChangeResponse.Merge(m, src)
+}
+func (m *QuerySimulateParamChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateParamChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateParamChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateParamChangeResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateParamChangeResponse) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *QuerySimulateParamChangeResponse) GetCodespace() string {
+	if m != nil {
+		return m.Codespace
+	}
+	return ""
+}
+
+func (m *QuerySimulateParamChangeResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *QuerySimulateParamChangeResponse) GetValues() []proposal.ParamChange {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+func (m *QuerySimulateParamChangeResponse) GetPendingChanges() []PendingParamChange {
+	if m != nil {
+		return m.PendingChanges
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BlockedParam)(nil), "celestia.paramfilter.v1.BlockedParam")
+	proto.RegisterType((*QueryPolicies)(nil), "celestia.paramfilter.v1.QueryPolicies")
+	proto.RegisterType((*QueryPoliciesResponse)(nil), "celestia.paramfilter.v1.QueryPoliciesResponse")
+	proto.RegisterType((*QuerySimulateParamChange)(nil), "celestia.paramfilter.v1.QuerySimulateParamChange")
+	proto.RegisterType((*QuerySimulateParamChangeResponse)(nil), "celestia.paramfilter.v1.QuerySimulateParamChangeResponse")
+}
+
+func init() {
+	proto.RegisterFile("celestia/paramfilter/v1/query.proto", fileDescriptor_0e7e89f8360e6682)
+}
+
+var fileDescriptor_0e7e89f8360e6682 = []byte{
+	// 549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x3f, 0x6f, 0xd3, 0x40,
+	0x14, 0xcf, 0x39, 0x49, 0x49, 0x0f, 0xd2, 0xa2, 0xa3, 0x08, 0xcb, 0xaa, 0x4c, 0x70, 0x01, 0xa5,
+	0x20, 0xce, 0x72, 0x3b, 0x15, 0x31, 0xa0, 0x20, 0xb1, 0xb0, 0x14, 0xb3, 0x75, 0xa0, 0x3a, 0x3b,
+	0x87, 0x6b, 0xd5, 0xf1, 0x19, 0x9f, 0x1d, 0x91, 0x95, 0x89, 0x05, 0x81, 0xc4, 0xf7, 0x61, 0xee,
+	0x58, 0x89, 0xa5, 0x13, 0x42, 0x09, 0x1f, 0x04, 0xf9, 0xee, 0x6c, 0x5c, 0x11, 0x23, 0x22, 0xb1,
+	0xdd, 0x7b, 0x7e, 0xbf, 0x3f, 0xf9, 0xbd, 0xbb, 0xc0, 0x1d, 0x9f, 0x46, 0x94, 0x67, 0x21, 0xb1,
+	0x13, 0x92, 0x92, 0xc9, 0x9b, 0x30, 0xca, 0x68, 0x6a, 0x4f, 0x1d, 0xfb, 0x6d, 0x4e, 0xd3, 0x19,
+	0x4e, 0x52, 0x96, 0x31, 0x74, 0xab, 0x1c, 0xc2, 0xb5, 0x21, 0x3c, 0x75, 0x8c, 0xad, 0x80, 0x05,
+	0x4c, 0xcc, 0xd8, 0xc5, 0x49, 0x8e, 0x1b, 0xdb, 0x01, 0x63, 0x41, 0x44, 0x6d, 0x92, 0x84, 0x36,
+	0x89, 0x63, 0x96, 0x91, 0x2c, 0x64, 0x31, 0x57, 0x5f, 0x2d, 0x9f, 0xf1, 0x09, 0xe3, 0x52, 0x8f,
+	0xdb, 0x53, 0xc7, 0xa3, 0x19, 0x71, 0x54, 0xa9, 0x66, 0x76, 0x9b, 0x5c, 0xd5, 0xf5, 0xc5, 0xa8,
+	0xf5, 0x04, 0x5e, 0x1b, 0x45, 0xcc, 0x3f, 0xa5, 0xe3, 0xc3, 0xe2, 0x1b, 0x32, 0x60, 0x8f, 0xe7,
+	0x1e, 0x4f, 0x88, 0x4f, 0x75, 0x30, 0x00, 0xc3, 0x75, 0xb7, 0xaa, 0xd1, 0x75, 0xd8, 0x3e, 0xa5,
+	0x33, 0x5d, 0x13, 0xed, 0xe2, 0x68, 0x6d, 0xc2, 0xfe, 0xcb, 0xe2, 0x87, 0x1e, 0xb2, 0x28, 0xf4,
+	0x43, 0xca, 0xad, 0x4f, 0x1a, 0xbc, 0x79, 0xa9, 0xe3, 0x52, 0x9e, 0xb0, 0x98, 0x53, 0xe4, 0xc2,
+	0x0d, 0x4f, 0x0a, 0x1d, 0x4b, 0xaf, 0x3a, 0x18, 0xb4, 0x87, 0x57, 0xf7, 0xee, 0xe1, 0x86, 0x74,
+	0x70, 0xdd, 0xd7, 0xa8, 0x73, 0xf6, 0xfd, 0x76, 0xcb, 0xed, 0x7b, 0xb5, 0x1e, 0x47, 0xcf, 0x61,
+	0x2f, 0x51, 0x3a, 0xba, 0x26, 0xd8, 0xee, 0x36, 0xb2, 0x09, 0x88, 0x70, 0x35, 0x53, 0x64, 0x15,
+	0x16, 0x1d, 0xc1, 0xcd, 0x84, 0xc6, 0xe3, 0x30, 0x0e, 0x8e, 0xfd, 0x13, 0x12, 0x07, 0x94, 0xeb,
+	0x6d, 0x41, 0xf7, 0xb0, 0x99, 0x4e, 0xce, 0x0b, 0xd6, 0x67, 0x02, 0xa3, 0x58, 0x37, 0x14, 0x93,
+	0x6c, 0x72, 0xeb, 0x35, 0xd4, 0x45, 0x20, 0xaf, 0xc2, 0x49, 0x1e, 0x91, 0x8c, 0xd6, 0x10, 0x68,
+	0x04, 0xaf, 0x94, 0x7a, 0x32, 0x0c, 0x0b, 0xcb, 0xed, 0x62, 0xb5, 0x4e, 0xb5, 0x5d, 0xfc, 0xa7,
+	0x4c, 0x09, 0xb4, 0x3e, 0x68, 0x70, 0xd0, 0x24, 0x50, 0x85, 0xbf, 0x05, 0xbb, 0x34, 0x4d, 0x59,
+	0xaa, 0x56, 0x2a, 0x0b, 0xb4, 0x0d, 0xd7, 0x7d, 0x36, 0xa6, 0x72, 0xd9, 0x72, 0xab, 0xbf, 0x1b,
+	0x08, 0xc1, 0x4e, 0x51, 0xe8, 0xed, 0x01, 0x18, 0xf6, 0x5d, 0x71, 0x46, 0x4f, 0xe1, 0xda, 0x94,
+	0x44, 0x39, 0xe5, 0x7a, 0x67, 0x45, 0xbf, 0x0a, 0xb7, 0x2c, 0xea, 0xee, 0x7f, 0x8a, 0x7a, 0xef,
+	0x42, 0x83, 0x5d, 0x11, 0x05, 0xfa, 0x08, 0x60, 0xaf, 0xbc, 0x81, 0xe8, 0x7e, 0x23, 0xf3, 0xa5,
+	0x9b, 0x6a, 0xe0, 0x7f, 0x9b, 0x2b, 0x43, 0xb5, 0x76, 0xdf, 0x7f, 0xfb, 0xf9, 0x45, 0xdb, 0x41,
+	0x77, 0xec, 0xc6, 0xe7, 0x56, 0x5a, 0xf8, 0x0a, 0xe0, 0x8d, 0x65, 0x17, 0xc0, 0xf9, 0xbb, 0xe4,
+	0x12, 0x88, 0x71, 0xb0, 0x32, 0xa4, 0x32, 0x7c, 0x20, 0x0c, 0xef, 0x5b, 0xb8, 0xd1, 0x30, 0x57,
+	0x68, 0xf9, 0x44, 0xd5, 0x86, 0x1e, 0x83, 0x07, 0xa3, 0x17, 0x67, 0x73, 0x13, 0x9c, 0xcf, 0x4d,
+	0xf0, 0x63, 0x6e, 0x82, 0xcf, 0x0b, 0xb3, 0x75, 0xbe, 0x30, 0x5b, 0x17, 0x0b, 0xb3, 0x75, 0xe4,
+	0x04, 0x61, 0x76, 0x92, 0x7b, 0xd8, 0x67, 0x93, 0x8a, 0x96, 0xa5, 0x41, 0x75, 0x7e, 0x44, 0x92,
+	0xc4, 0x7e, 0x57, 0x17, 0xf2, 0xd6, 0xc4, 0x5f, 0xcf, 0xfe, 0xaf, 0x01, 0x00, 0x56, 0x7b, 0x70,
+	0x61, 0x3d, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Policies queries the parameters that can't be changed by governance, the
+	// policies of the parameters that can and the pending parameter changes.
+	Policies(ctx context.Context, in *QueryPolicies, opts ...grpc.CallOption) (*QueryPoliciesResponse, error)
+	// SimulateParamChange executes a ParameterChangeProposal with the given
+	// changes in a branch of the current state and returns the resulting
+	// parameter values or the error with which the proposal would fail.
+	SimulateParamChange(ctx context.Context, in *QuerySimulateParamChange, opts ...grpc.CallOption) (*QuerySimulateParamChangeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Policies(ctx context.Context, in *QueryPolicies, opts ...grpc.CallOption) (*QueryPoliciesResponse, error) {
+	out := new(QueryPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/celestia.paramfilter.v1.Query/Policies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateParamChange(ctx context.Context, in *QuerySimulateParamChange, opts ...grpc.CallOption) (*QuerySimulateParamChangeResponse, error) {
+	out := new(QuerySimulateParamChangeResponse)
+	err := c.cc.Invoke(ctx, "/celestia.paramfilter.v1.Query/SimulateParamChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Policies queries the parameters that can't be changed by governance, the
+	// policies of the parameters that can and the pending parameter changes.
+	Policies(context.Context, *QueryPolicies) (*QueryPoliciesResponse, error)
+	// SimulateParamChange executes a ParameterChangeProposal with the given
+	// changes in a branch of the current state and returns the resulting
+	// parameter values or the error with which the proposal would fail.
+	SimulateParamChange(context.Context, *QuerySimulateParamChange) (*QuerySimulateParamChangeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Policies(ctx context.Context, req *QueryPolicies) (*QueryPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Policies not implemented")
+}
+func (*UnimplementedQueryServer) SimulateParamChange(ctx context.Context, req *QuerySimulateParamChange) (*QuerySimulateParamChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateParamChange not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Policies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPolicies)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Policies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.paramfilter.v1.Query/Policies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Policies(ctx, req.(*QueryPolicies))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateParamChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateParamChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateParamChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/celestia.paramfilter.v1.Query/SimulateParamChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateParamChange(ctx, req.(*QuerySimulateParamChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "celestia.paramfilter.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Policies",
+			Handler:    _Query_Policies_Handler,
+		},
+		{
+			MethodName: "SimulateParamChange",
+			Handler:    _Query_SimulateParamChange_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "celestia/paramfilter/v1/query.proto",
+}
+
+func (m *BlockedParam) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockedParam) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockedParam) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Subspace) > 0 {
+		i -= len(m.Subspace)
+		copy(dAtA[i:], m.Subspace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Subspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPolicies) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPolicies) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPolicies) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoliciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoliciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoliciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingChanges) > 0 {
+		for iNdEx := len(m.PendingChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BlockedParams) > 0 {
+		for iNdEx := len(m.BlockedParams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockedParams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateParamChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateParamChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateParamChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateParamChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateParamChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateParamChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingChanges) > 0 {
+		for iNdEx := len(m.PendingChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Values[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Code != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Codespace) > 0 {
+		i -= len(m.Codespace)
+		copy(dAtA[i:], m.Codespace)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Codespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlockedParam) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Subspace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPolicies) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPoliciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockedParams) > 0 {
+		for _, e := range m.BlockedParams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PendingChanges) > 0 {
+		for _, e := range m.PendingChanges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateParamChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulateParamChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Codespace)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovQuery(uint64(m.Code))
+	}
+	if len(m.Values) > 0 {
+		for _, e := range m.Values {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PendingChanges) > 0 {
+		for _, e := range m.PendingChanges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlockedParam) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockedParam: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockedParam: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPolicies) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPolicies: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPolicies: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoliciesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoliciesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoliciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedParams = append(m.BlockedParams, BlockedParam{})
+			if err := m.BlockedParams[len(m.BlockedParams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, ParamPolicy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingChanges = append(m.PendingChanges, PendingParamChange{})
+			if err := m.PendingChanges[len(m.PendingChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateParamChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateParamChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateParamChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Changes = append(m.Changes, proposal.ParamChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateParamChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateParamChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateParamChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Codespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Codespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Values = append(m.Values, proposal.ParamChange{})
+			if err := m.Values[len(m.Values)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingChanges = append(m.PendingChanges, PendingParamChange{})
+			if err := m.PendingChanges[len(m.PendingChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
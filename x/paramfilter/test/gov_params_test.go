@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/celestiaorg/celestia-app/v3/app"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
 	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
 	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
 	bsmoduletypes "github.com/celestiaorg/celestia-app/v3/x/blobstream/types"
@@ -26,6 +27,7 @@ import (
 	ibcconnectiontypes "github.com/cosmos/ibc-go/v6/modules/core/03-connection/types"
 	"github.com/stretchr/testify/suite"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
 )
 
 type GovParamsTestSuite struct {
@@ -637,3 +639,61 @@ func (suite *GovParamsTestSuite) TestUnmodifiableParams() {
 		})
 	}
 }
+
+// TestPolicyParams verifies that from app version 3 onwards the params with a
+// policy can only be modified within the bounds of their policy and that the
+// modification only takes effect after the policy's delay.
+func (suite *GovParamsTestSuite) TestPolicyParams() {
+	require := suite.Require()
+
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newCtx := func(blockTime time.Time) sdk.Context {
+		header := tmproto.Header{Version: version.Consensus{App: v3.Version}, Time: blockTime}
+		return suite.app.BaseApp.NewContext(false, header)
+	}
+	govHandler := paramfilter.NewParamBlockList(suite.app.BlockedParams()...).
+		WithKeeper(suite.app.ParamFilterKeeper).
+		GovHandler(suite.app.ParamsKeeper)
+	ctx := newCtx(blockTime)
+	suite.app.MinFeeKeeper.InitRecordedNetworkMinGasPrice(ctx)
+	wantNetworkMinGasPrice := suite.app.MinFeeKeeper.GetNetworkMinGasPrice(ctx)
+
+	suite.Run("changes larger than the max relative change are rejected", func() {
+		err := govHandler(ctx, testProposal(proposal.ParamChange{
+			Subspace: minfeetypes.ModuleName,
+			Key:      string(minfeetypes.KeyNetworkMinGasPrice),
+			Value:    `"1"`,
+		}))
+		require.ErrorIs(err, paramfilter.ErrPolicyViolation)
+		require.Empty(suite.app.ParamFilterKeeper.GetPendingChanges(ctx))
+	})
+
+	suite.Run("changes are applied after the delay", func() {
+		newNetworkMinGasPrice := wantNetworkMinGasPrice.MulInt64(2)
+		err := govHandler(ctx, testProposal(proposal.ParamChange{
+			Subspace: minfeetypes.ModuleName,
+			Key:      string(minfeetypes.KeyNetworkMinGasPrice),
+			Value:    `"` + newNetworkMinGasPrice.String() + `"`,
+		}))
+		require.NoError(err)
+		// the gov module calls its hooks after executing the proposal
+		const proposalID = 7
+		suite.app.ParamFilterKeeper.Hooks().AfterProposalVotingPeriodEnded(ctx, proposalID)
+		require.Equal(wantNetworkMinGasPrice, suite.app.MinFeeKeeper.GetNetworkMinGasPrice(ctx))
+
+		pending := suite.app.ParamFilterKeeper.GetPendingChanges(ctx)
+		require.Len(pending, 1)
+		require.Equal(uint64(proposalID), pending[0].ProposalId)
+
+		ctx = newCtx(pending[0].EffectiveTime)
+		suite.app.ParamFilterKeeper.ApplyPendingChanges(ctx)
+		require.Equal(newNetworkMinGasPrice, suite.app.MinFeeKeeper.GetNetworkMinGasPrice(ctx))
+		require.Empty(suite.app.ParamFilterKeeper.GetPendingChanges(ctx))
+
+		history := suite.app.MinFeeKeeper.GetNetworkMinGasPriceHistory(ctx)
+		require.Len(history, 1)
+		require.Equal(uint64(proposalID), history[0].ProposalId)
+		require.Equal(wantNetworkMinGasPrice, history[0].OldNetworkMinGasPrice)
+		require.Equal(newNetworkMinGasPrice, history[0].NewNetworkMinGasPrice)
+	})
+}
@@ -0,0 +1,91 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/celestia-app/v3/x/paramfilter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+func TestQueryPolicies(t *testing.T) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	pbl := paramfilter.NewParamBlockList(testApp.BlockedParams()...).WithKeeper(testApp.ParamFilterKeeper)
+	queryServer := paramfilter.NewQueryServerImpl(pbl, testApp.ParamsKeeper)
+	ctx := testApp.NewContext(false, tmproto.Header{Version: version.Consensus{App: v3.Version}})
+
+	resp, err := queryServer.Policies(sdk.WrapSDKContext(ctx), &paramfilter.QueryPolicies{})
+	require.NoError(t, err)
+	require.Len(t, resp.BlockedParams, len(testApp.BlockedParams()))
+	for i, param := range testApp.BlockedParams() {
+		require.Equal(t, paramfilter.BlockedParam{Subspace: param[0], Key: param[1]}, resp.BlockedParams[i])
+	}
+	require.Len(t, resp.Policies, len(app.DefaultParamPolicies()))
+	require.Equal(t, blobtypes.ModuleName, resp.Policies[0].Subspace)
+	require.Empty(t, resp.PendingChanges)
+}
+
+func TestSimulateParamChange(t *testing.T) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	pbl := paramfilter.NewParamBlockList(testApp.BlockedParams()...).WithKeeper(testApp.ParamFilterKeeper)
+	queryServer := paramfilter.NewQueryServerImpl(pbl, testApp.ParamsKeeper)
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := testApp.NewContext(false, tmproto.Header{Version: version.Consensus{App: v3.Version}, Time: blockTime})
+	simulate := func(changes ...proposal.ParamChange) *paramfilter.QuerySimulateParamChangeResponse {
+		resp, err := queryServer.SimulateParamChange(sdk.WrapSDKContext(ctx), &paramfilter.QuerySimulateParamChange{Changes: changes})
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("immediate change", func(t *testing.T) {
+		resp := simulate(proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "5"))
+		require.Empty(t, resp.Error)
+		require.Equal(t, []proposal.ParamChange{proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), "5")}, resp.Values)
+		require.Empty(t, resp.PendingChanges)
+		// the simulation doesn't affect the state
+		require.NotEqual(t, uint32(5), testApp.StakingKeeper.MaxValidators(ctx))
+	})
+
+	t.Run("delayed change", func(t *testing.T) {
+		resp := simulate(proposal.NewParamChange(blobtypes.ModuleName, string(blobtypes.KeyGovMaxSquareSize), `"128"`))
+		require.Empty(t, resp.Error)
+		require.Equal(t, `"64"`, resp.Values[0].Value)
+		require.Len(t, resp.PendingChanges, 1)
+		require.Equal(t, blockTime.Add(7*24*time.Hour), resp.PendingChanges[0].EffectiveTime)
+		require.Empty(t, testApp.ParamFilterKeeper.GetPendingChanges(ctx))
+	})
+
+	t.Run("blocked parameter", func(t *testing.T) {
+		blocked := testApp.BlockedParams()[0]
+		resp := simulate(proposal.NewParamChange(blocked[0], blocked[1], "value"))
+		require.Equal(t, paramfilter.ErrBlockedParameter.Error(), resp.Error)
+		require.Equal(t, paramfilter.ModuleName, resp.Codespace)
+		require.Equal(t, paramfilter.ErrBlockedParameter.ABCICode(), resp.Code)
+	})
+
+	t.Run("policy violation", func(t *testing.T) {
+		resp := simulate(proposal.NewParamChange(blobtypes.ModuleName, string(blobtypes.KeyGovMaxSquareSize), `"256"`))
+		require.Contains(t, resp.Error, paramfilter.ErrPolicyViolation.Error())
+		require.Equal(t, paramfilter.ErrPolicyViolation.ABCICode(), resp.Code)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		resp := simulate(proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), `"invalid"`))
+		require.Contains(t, resp.Error, proposal.ErrSettingParameter.Error())
+		require.Equal(t, proposal.ErrSettingParameter.ABCICode(), resp.Code)
+	})
+
+	t.Run("empty value", func(t *testing.T) {
+		resp := simulate(proposal.NewParamChange(stakingtypes.ModuleName, string(stakingtypes.KeyMaxValidators), ""))
+		require.NotEmpty(t, resp.Error)
+	})
+}
@@ -0,0 +1,143 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/celestiaorg/celestia-app/v3/app"
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	v3 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v3"
+	testutil "github.com/celestiaorg/celestia-app/v3/test/util"
+	blobtypes "github.com/celestiaorg/celestia-app/v3/x/blob/types"
+	"github.com/celestiaorg/celestia-app/v3/x/minfee"
+	"github.com/celestiaorg/celestia-app/v3/x/paramfilter"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params/types/proposal"
+	"github.com/stretchr/testify/require"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/proto/tendermint/types"
+	"github.com/tendermint/tendermint/proto/tendermint/version"
+)
+
+func TestParamPolicyCheck(t *testing.T) {
+	minValue, maxValue, maxRelativeChange := sdk.NewDec(2), sdk.NewDec(100), sdk.NewDecWithPrec(5, 1)
+	policy := paramfilter.ParamPolicy{
+		Subspace:          "subspace",
+		Key:               "key",
+		Min:               &minValue,
+		Max:               &maxValue,
+		MaxRelativeChange: &maxRelativeChange,
+	}
+	require.NoError(t, policy.Validate())
+
+	testCases := []struct {
+		name    string
+		current string
+		value   string
+		wantErr bool
+	}{
+		{name: "string encoded integer", current: `"10"`, value: `"15"`},
+		{name: "number", current: `10`, value: `5`},
+		{name: "decimal", current: `"10.000000000000000000"`, value: `"12.5"`},
+		{name: "unset current value", current: ``, value: `"100"`},
+		{name: "zero current value", current: `"0"`, value: `"100"`},
+		{name: "below min", current: `"2"`, value: `"1"`, wantErr: true},
+		{name: "above max", current: `"100"`, value: `"101"`, wantErr: true},
+		{name: "relative increase too large", current: `"10"`, value: `"16"`, wantErr: true},
+		{name: "relative decrease too large", current: `"10"`, value: `"4"`, wantErr: true},
+		{name: "not numeric", current: `"10"`, value: `true`, wantErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := policy.Check([]byte(tc.current), []byte(tc.value))
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// a policy that only delays changes accepts any value
+	require.NoError(t, paramfilter.ParamPolicy{Subspace: "subspace", Key: "key", Delay: time.Hour}.Check([]byte(`"10"`), []byte(`true`)))
+
+	one, negativeOne := sdk.OneDec(), sdk.NewDec(-1)
+	require.Error(t, paramfilter.ParamPolicy{Subspace: "subspace", Key: "key", Min: &minValue, Max: &one}.Validate())
+	require.Error(t, paramfilter.ParamPolicy{Subspace: "subspace", Key: "key", MaxRelativeChange: &negativeOne}.Validate())
+	require.Error(t, paramfilter.ParamPolicy{Key: "key"}.Validate())
+}
+
+func TestParamPolicies(t *testing.T) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	pbl := paramfilter.NewParamBlockList(testApp.BlockedParams()...).WithKeeper(testApp.ParamFilterKeeper)
+	handler := pbl.GovHandler(testApp.ParamsKeeper)
+	blockTime := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newCtx := func(appVersion uint64, blockTime time.Time) sdk.Context {
+		header := types.Header{Version: version.Consensus{App: appVersion}, Time: blockTime}
+		return sdk.NewContext(testApp.CommitMultiStore(), header, false, tmlog.NewNopLogger())
+	}
+	squareSizeChange := func(value string) *proposal.ParameterChangeProposal {
+		return testProposal(proposal.NewParamChange(blobtypes.ModuleName, string(blobtypes.KeyGovMaxSquareSize), value))
+	}
+	ctx := newCtx(v3.Version, blockTime)
+	require.Equal(t, uint64(64), testApp.BlobKeeper.GovMaxSquareSize(ctx))
+
+	t.Run("bounds and relative change are enforced", func(t *testing.T) {
+		err := handler(ctx, squareSizeChange(`"256"`))
+		require.ErrorIs(t, err, paramfilter.ErrPolicyViolation)
+
+		err = handler(ctx, testProposal(proposal.NewParamChange(minfee.ModuleName, string(minfee.KeyNetworkMinGasPrice), `"0.005"`)))
+		require.ErrorIs(t, err, paramfilter.ErrPolicyViolation)
+		require.Empty(t, testApp.ParamFilterKeeper.GetPendingChanges(ctx))
+	})
+
+	t.Run("policies are not enforced before app version 3", func(t *testing.T) {
+		cacheCtx, _ := newCtx(v2.Version, blockTime).CacheContext()
+		require.NoError(t, handler(cacheCtx, squareSizeChange(`"32"`)))
+		require.Equal(t, uint64(32), testApp.BlobKeeper.GovMaxSquareSize(cacheCtx))
+	})
+
+	t.Run("changes take effect after the delay", func(t *testing.T) {
+		require.NoError(t, handler(ctx, squareSizeChange(`"128"`)))
+		require.Equal(t, uint64(64), testApp.BlobKeeper.GovMaxSquareSize(ctx))
+		pending := testApp.ParamFilterKeeper.GetPendingChanges(ctx)
+		require.Len(t, pending, 1)
+		require.Equal(t, blockTime.Add(7*24*time.Hour), pending[0].EffectiveTime)
+
+		// the parameter can't be changed again while a change is pending
+		err := handler(ctx, squareSizeChange(`"32"`))
+		require.ErrorIs(t, err, paramfilter.ErrPolicyViolation)
+
+		ctx = newCtx(v3.Version, pending[0].EffectiveTime.Add(-time.Second))
+		testApp.ParamFilterKeeper.ApplyPendingChanges(ctx)
+		require.Equal(t, uint64(64), testApp.BlobKeeper.GovMaxSquareSize(ctx))
+
+		ctx = newCtx(v3.Version, pending[0].EffectiveTime)
+		testApp.ParamFilterKeeper.ApplyPendingChanges(ctx)
+		require.Equal(t, uint64(128), testApp.BlobKeeper.GovMaxSquareSize(ctx))
+		require.Empty(t, testApp.ParamFilterKeeper.GetPendingChanges(ctx))
+	})
+
+	t.Run("delayed changes are validated when the proposal is executed", func(t *testing.T) {
+		// 100 is within the policy but not a power of two
+		err := handler(ctx, squareSizeChange(`"100"`))
+		require.ErrorIs(t, err, proposal.ErrSettingParameter)
+		require.Empty(t, testApp.ParamFilterKeeper.GetPendingChanges(ctx))
+	})
+}
+
+func TestPolicyGenesis(t *testing.T) {
+	testApp, _ := testutil.SetupTestAppWithGenesisValSet(app.DefaultConsensusParams())
+	ctx := testApp.NewContext(false, types.Header{Version: version.Consensus{App: v3.Version}})
+	require.Equal(t, app.DefaultParamPolicies(), testApp.ParamFilterKeeper.GetPolicies(ctx))
+
+	exported := paramfilter.ExportGenesis(ctx, testApp.ParamFilterKeeper)
+	require.NoError(t, exported.Validate())
+	require.Equal(t, app.DefaultParamPolicies(), exported.Policies)
+
+	// the policies are not part of the state before app version 3
+	require.Empty(t, testApp.ParamFilterKeeper.GetPolicies(testApp.NewContext(false, types.Header{Version: version.Consensus{App: v2.Version}})))
+
+	duplicate := paramfilter.GenesisState{Policies: append(app.DefaultParamPolicies(), app.DefaultParamPolicies()[0])}
+	require.Error(t, duplicate.Validate())
+}
@@ -8,8 +8,16 @@ const (
 	// ModuleName is the name of the module
 	ModuleName    = "paramfilter"
 	baseErrorCode = 91710
+
+	// StoreKey is the store key used to persist the pending parameter
+	// changes. It is only mounted from app version 3 onwards.
+	StoreKey = ModuleName
 )
 
 // ErrBlockedParameter is the error wrapped when a proposal to change a
 // blocked parameter is submitted.
 var ErrBlockedParameter = sdkerrors.Register(ModuleName, baseErrorCode, "parameter can not be modified")
+
+// ErrPolicyViolation is the error wrapped when a proposal changes a parameter
+// in a way that is not permitted by its policy.
+var ErrPolicyViolation = sdkerrors.Register(ModuleName, baseErrorCode+1, "parameter change violates policy")
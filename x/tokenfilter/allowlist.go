@@ -0,0 +1,70 @@
+package tokenfilter
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+// KeyAllowlist is the param key of the allowlist of non-native denoms.
+var KeyAllowlist = []byte("Allowlist")
+
+// RegisterTokenFilterParamTable returns a subspace with a key table attached.
+func RegisterTokenFilterParamTable(subspace paramtypes.Subspace) paramtypes.Subspace {
+	if subspace.HasKeyTable() {
+		return subspace
+	}
+	return subspace.WithKeyTable(ParamKeyTable())
+}
+
+// ParamKeyTable returns the param key table for the token filter.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().
+		RegisterType(paramtypes.NewParamSetPair(KeyAllowlist, []AllowedDenom{}, ValidateAllowlist)).
+		RegisterType(paramtypes.NewParamSetPair(KeyRateLimits, []RateLimit{}, ValidateRateLimits))
+}
+
+// ValidateAllowlist validates the allowlist of non-native denoms. Every base
+// denom must be a valid denom without a trace path, every channel id must be
+// valid and no entry may be duplicated.
+func ValidateAllowlist(i interface{}) error {
+	allowlist, ok := i.([]AllowedDenom)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	seen := make(map[AllowedDenom]struct{}, len(allowlist))
+	for _, allowed := range allowlist {
+		if err := sdk.ValidateDenom(allowed.BaseDenom); err != nil {
+			return err
+		}
+		if transfertypes.ParseDenomTrace(allowed.BaseDenom).Path != "" {
+			return fmt.Errorf("allowed denom %s must be a base denom without a trace path", allowed.BaseDenom)
+		}
+		if err := host.ChannelIdentifierValidator(allowed.ChannelId); err != nil {
+			return err
+		}
+		if _, exists := seen[allowed]; exists {
+			return fmt.Errorf("duplicate allowed denom %s on %s", allowed.BaseDenom, allowed.ChannelId)
+		}
+		seen[allowed] = struct{}{}
+	}
+	return nil
+}
+
+// isAllowed returns true if denom, as it appears in a packet received on
+// channelID, is a token native to the counterparty chain that is in the
+// allowlist.
+func isAllowed(allowlist []AllowedDenom, channelID, denom string) bool {
+	if transfertypes.ParseDenomTrace(denom).Path != "" {
+		return false
+	}
+	for _, allowed := range allowlist {
+		if allowed.BaseDenom == denom && allowed.ChannelId == channelID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,83 @@
+package tokenfilter_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/v3/x/tokenfilter"
+)
+
+func TestValidateAllowlist(t *testing.T) {
+	testCases := []struct {
+		name      string
+		allowlist []tokenfilter.AllowedDenom
+		wantErr   bool
+	}{
+		{
+			name:      "empty allowlist",
+			allowlist: []tokenfilter.AllowedDenom{},
+		},
+		{
+			name: "same denom on different channels",
+			allowlist: []tokenfilter.AllowedDenom{
+				{BaseDenom: "uusdc", ChannelId: "channel-0"},
+				{BaseDenom: "uusdc", ChannelId: "channel-1"},
+			},
+		},
+		{
+			name:      "invalid denom",
+			allowlist: []tokenfilter.AllowedDenom{{BaseDenom: "1", ChannelId: "channel-0"}},
+			wantErr:   true,
+		},
+		{
+			name:      "denom with a trace path",
+			allowlist: []tokenfilter.AllowedDenom{{BaseDenom: "transfer/channel-1/uusdc", ChannelId: "channel-0"}},
+			wantErr:   true,
+		},
+		{
+			name:      "invalid channel",
+			allowlist: []tokenfilter.AllowedDenom{{BaseDenom: "uusdc", ChannelId: "ch"}},
+			wantErr:   true,
+		},
+		{
+			name: "duplicate entry",
+			allowlist: []tokenfilter.AllowedDenom{
+				{BaseDenom: "uusdc", ChannelId: "channel-0"},
+				{BaseDenom: "uusdc", ChannelId: "channel-0"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tokenfilter.ValidateAllowlist(tc.allowlist)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestQueryAllowlist(t *testing.T) {
+	keeper, ctx := createKeeper(t, 3)
+	queryServer := tokenfilter.NewQueryServerImpl(keeper)
+
+	resp, err := queryServer.Allowlist(sdk.WrapSDKContext(ctx), &tokenfilter.QueryAllowlist{})
+	require.NoError(t, err)
+	require.Empty(t, resp.Allowlist)
+
+	allowlist := []tokenfilter.AllowedDenom{{BaseDenom: "uusdc", ChannelId: "channel-0"}}
+	keeper.SetAllowlist(ctx, allowlist)
+	resp, err = queryServer.Allowlist(sdk.WrapSDKContext(ctx), &tokenfilter.QueryAllowlist{})
+	require.NoError(t, err)
+	require.Equal(t, allowlist, resp.Allowlist)
+
+	require.Panics(t, func() {
+		keeper.SetAllowlist(ctx, []tokenfilter.AllowedDenom{{BaseDenom: "uusdc", ChannelId: "ch"}})
+	})
+}
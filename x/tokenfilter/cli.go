@@ -0,0 +1,76 @@
+package tokenfilter
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+)
+
+// GetQueryCmd returns the CLI query commands for the token filter.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        ModuleName,
+		Short:                      fmt.Sprintf("Querying commands for the %s middleware", ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdQueryAllowlist())
+	cmd.AddCommand(CmdQueryRateLimits())
+	return cmd
+}
+
+func CmdQueryAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "allowlist",
+		Short:   "Query for the non-native denoms that may be received over IBC",
+		Args:    cobra.NoArgs,
+		Example: "allowlist",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := NewQueryClient(clientCtx)
+			resp, err := queryClient.Allowlist(cmd.Context(), &QueryAllowlist{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limits",
+		Short:   "Query for the transfer quotas and how much of them has been used in the current window",
+		Args:    cobra.NoArgs,
+		Example: "rate-limits",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := NewQueryClient(clientCtx)
+			resp, err := queryClient.RateLimits(cmd.Context(), &QueryRateLimits{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,9 @@
+package tokenfilter
+
+import (
+	"cosmossdk.io/errors"
+)
+
+// ErrRateLimitExceeded is returned when a transfer would exceed the quota of
+// its channel and denom.
+var ErrRateLimitExceeded = errors.Register(ModuleName, 2, "rate limit exceeded")
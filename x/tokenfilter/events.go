@@ -0,0 +1,9 @@
+package tokenfilter
+
+const (
+	EventTypeDenomAllowed = "tokenfilter_denom_allowed"
+	EventTypeDenomDenied  = "tokenfilter_denom_denied"
+	EventTypeRateLimited  = "tokenfilter_rate_limited"
+
+	AttributeKeyChannel = "channel"
+)
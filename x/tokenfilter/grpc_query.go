@@ -0,0 +1,54 @@
+package tokenfilter
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ QueryServer = &QueryServerImpl{}
+
+// QueryServerImpl wraps the tokenfilter keeper and implements the tokenfilter
+// gRPC query server.
+type QueryServerImpl struct {
+	keeper Keeper
+}
+
+// NewQueryServerImpl creates a new QueryServerImpl.
+func NewQueryServerImpl(k Keeper) *QueryServerImpl {
+	return &QueryServerImpl{keeper: k}
+}
+
+// Allowlist returns the non-native denoms that may be received over IBC.
+func (q *QueryServerImpl) Allowlist(ctx context.Context, _ *QueryAllowlist) (*QueryAllowlistResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return &QueryAllowlistResponse{Allowlist: q.keeper.GetAllowlist(sdkCtx)}, nil
+}
+
+// RateLimits returns the transfer quotas and the flows of their current
+// windows.
+func (q *QueryServerImpl) RateLimits(ctx context.Context, _ *QueryRateLimits) (*QueryRateLimitsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if !storeMounted(sdkCtx) {
+		return nil, status.Errorf(codes.NotFound, "rate limits are only available in app version 3 and onwards")
+	}
+	rateLimits := q.keeper.GetRateLimits(sdkCtx)
+	usages := make([]RateLimitUsage, 0, len(rateLimits))
+	for _, rateLimit := range rateLimits {
+		usages = append(usages, RateLimitUsage{RateLimit: rateLimit, Flow: q.keeper.GetCurrentFlow(sdkCtx, rateLimit)})
+	}
+	return &QueryRateLimitsResponse{RateLimits: usages}, nil
+}
+
+// RegisterGRPCGatewayRoutes mounts the tokenfilter query service's GRPC-gateway
+// routes on the given mux object.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	err := RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientConn))
+	if err != nil {
+		panic(err)
+	}
+}
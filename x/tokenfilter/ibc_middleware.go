@@ -14,19 +14,21 @@ const ModuleName = "tokenfilter"
 
 // tokenFilterMiddleware directly inherits the IBCModule and ICS4Wrapper interfaces.
 // Only with OnRecvPacket, does it wrap the underlying implementation with additional
-// stateless logic for rejecting the inbound transfer of non-native tokens. This
-// middleware is unilateral and no handshake is required. If using this middleware
-// on an existing chain, tokens that have been routed through this chain will still
-// be allowed to unwrap.
+// logic for rejecting the inbound transfer of non-native tokens that are not in
+// the allowlist of the keeper. This middleware is unilateral and no handshake is
+// required. If using this middleware on an existing chain, tokens that have been
+// routed through this chain will still be allowed to unwrap.
 type tokenFilterMiddleware struct {
 	porttypes.IBCModule
+	keeper Keeper
 }
 
 // NewIBCMiddleware creates a new instance of the token filter middleware for
 // the transfer module.
-func NewIBCMiddleware(ibcModule porttypes.IBCModule) porttypes.IBCModule {
+func NewIBCMiddleware(ibcModule porttypes.IBCModule, keeper Keeper) porttypes.IBCModule {
 	return &tokenFilterMiddleware{
 		IBCModule: ibcModule,
+		keeper:    keeper,
 	}
 }
 
@@ -34,7 +36,10 @@ func NewIBCMiddleware(ibcModule porttypes.IBCModule) porttypes.IBCModule {
 // from another chain is received on this chain. Here, the token filter middleware
 // unmarshals the FungibleTokenPacketData and checks to see if the denomination being
 // transferred to this chain originally came from this chain i.e. is a native token.
-// If not, it returns an ErrorAcknowledgement.
+// From app version 3 onwards, a token native to the counterparty chain is also
+// accepted if its base denom and the receiving channel are in the allowlist.
+// Otherwise, it returns an ErrorAcknowledgement. Accepted tokens are subject to
+// the rate limit of the receiving channel.
 func (m *tokenFilterMiddleware) OnRecvPacket(
 	ctx sdk.Context,
 	packet channeltypes.Packet,
@@ -55,10 +60,23 @@ func (m *tokenFilterMiddleware) OnRecvPacket(
 	// chain. Note that this firewall prevents routing of other transactions through
 	// the chain so from this logic, the denom has to be a native denom.
 	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
-		return m.IBCModule.OnRecvPacket(ctx, packet, relayer)
+		return m.receive(ctx, packet, data, relayer)
+	}
+
+	allowlistEnabled := storeMounted(ctx)
+	if allowlistEnabled && isAllowed(m.keeper.GetAllowlist(ctx), packet.GetDestChannel(), data.Denom) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeDenomAllowed,
+				sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+				sdk.NewAttribute(transfertypes.AttributeKeyDenom, data.Denom),
+				sdk.NewAttribute(AttributeKeyChannel, packet.GetDestChannel()),
+			),
+		)
+		return m.receive(ctx, packet, data, relayer)
 	}
 
-	ackErr := errors.Wrapf(sdkerrors.ErrInvalidType, "only native denom transfers accepted, got %s", data.Denom)
+	ackErr := errors.Wrapf(sdkerrors.ErrInvalidType, "only native and allowlisted denom transfers accepted, got %s", data.Denom)
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -73,6 +91,95 @@ func (m *tokenFilterMiddleware) OnRecvPacket(
 			sdk.NewAttribute(transfertypes.AttributeKeyAckError, ackErr.Error()),
 		),
 	)
+	if allowlistEnabled {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeDenomDenied,
+				sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+				sdk.NewAttribute(transfertypes.AttributeKeyDenom, data.Denom),
+				sdk.NewAttribute(AttributeKeyChannel, packet.GetDestChannel()),
+			),
+		)
+	}
 
 	return channeltypes.NewErrorAcknowledgement(ackErr)
 }
+
+// receive records the inflow of an accepted packet against its rate limit and
+// passes it on down the stack. If the quota is exceeded, it returns an
+// ErrorAcknowledgement instead. The inflow is discarded along with every
+// other state change if the packet is not acknowledged successfully.
+func (m *tokenFilterMiddleware) receive(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	if !storeMounted(ctx) {
+		return m.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err := m.keeper.RecordInflow(ctx, packet.GetDestChannel(), data.Denom, data.Amount); err != nil {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeRateLimited,
+				sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+				sdk.NewAttribute(transfertypes.AttributeKeyDenom, data.Denom),
+				sdk.NewAttribute(transfertypes.AttributeKeyAmount, data.Amount),
+				sdk.NewAttribute(AttributeKeyChannel, packet.GetDestChannel()),
+				sdk.NewAttribute(transfertypes.AttributeKeyAckError, err.Error()),
+			),
+		)
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return m.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. From app version
+// 3 onwards, if the counterparty rejected a rate limited transfer, its outflow
+// is refunded to the quota of the window that it was recorded in.
+func (m *tokenFilterMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := m.IBCModule.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+	if !storeMounted(ctx) {
+		return nil
+	}
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil || ack.Success() {
+		m.keeper.DeleteSentPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+		return nil
+	}
+	return m.refund(ctx, packet)
+}
+
+// OnTimeoutPacket implements the IBCModule interface. From app version 3
+// onwards, the outflow of a rate limited transfer that timed out is refunded to
+// the quota of the window that it was recorded in.
+func (m *tokenFilterMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := m.IBCModule.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+	if !storeMounted(ctx) {
+		return nil
+	}
+	return m.refund(ctx, packet)
+}
+
+// refund refunds the outflow of a failed outgoing transfer.
+func (m *tokenFilterMiddleware) refund(ctx sdk.Context, packet channeltypes.Packet) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		m.keeper.DeleteSentPacket(ctx, packet.GetSourceChannel(), packet.GetSequence())
+		return nil
+	}
+	return m.keeper.RefundOutflow(ctx, packet.GetSourceChannel(), packet.GetSequence(), data.Denom, data.Amount)
+}
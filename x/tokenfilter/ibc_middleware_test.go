@@ -3,8 +3,17 @@ package tokenfilter_test
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	tmdb "github.com/tendermint/tm-db"
 
 	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
@@ -14,6 +23,29 @@ import (
 	"github.com/celestiaorg/celestia-app/v3/x/tokenfilter"
 )
 
+// createKeeper returns a tokenfilter keeper wrapping a MockICS4Wrapper and
+// backed by in-memory stores along with a context at the given app version.
+func createKeeper(t *testing.T, appVersion uint64) (tokenfilter.Keeper, sdk.Context) {
+	storeKey := sdk.NewKVStoreKey(paramtypes.StoreKey)
+	tStoreKey := storetypes.NewTransientStoreKey(paramtypes.TStoreKey)
+	tokenFilterKey := sdk.NewKVStoreKey(tokenfilter.StoreKey)
+
+	db := tmdb.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db)
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(tStoreKey, storetypes.StoreTypeTransient, nil)
+	stateStore.MountStoreWithDB(tokenFilterKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	ctx := sdk.NewContext(stateStore, tmproto.Header{
+		Version: tmversion.Consensus{Block: 1, App: appVersion},
+	}, false, nil)
+
+	subspace := paramtypes.NewSubspace(cdc, codec.NewLegacyAmino(), storeKey, tStoreKey, tokenfilter.ModuleName)
+	return tokenfilter.NewKeeper(cdc, tokenFilterKey, &MockICS4Wrapper{}, subspace), ctx
+}
+
 func TestOnRecvPacket(t *testing.T) {
 	data := transfertypes.NewFungibleTokenPacketData("portid/channelid/utia", sdk.NewInt(100).String(), "alice", "bob", "gm")
 	packet := channeltypes.NewPacket(data.GetBytes(), 1, "portid", "channelid", "counterpartyportid", "counterpartychannelid", clienttypes.Height{}, 0)
@@ -45,9 +77,9 @@ func TestOnRecvPacket(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			module := &MockIBCModule{t: t, called: false}
-			middleware := tokenfilter.NewIBCMiddleware(module)
+			keeper, ctx := createKeeper(t, 1)
+			middleware := tokenfilter.NewIBCMiddleware(module, keeper)
 
-			ctx := sdk.Context{}
 			ctx = ctx.WithEventManager(sdk.NewEventManager())
 			ack := middleware.OnRecvPacket(
 				ctx,
@@ -66,9 +98,82 @@ func TestOnRecvPacket(t *testing.T) {
 	}
 }
 
+func TestOnRecvPacketAllowlist(t *testing.T) {
+	usdc := transfertypes.NewFungibleTokenPacketData("uusdc", sdk.NewInt(100).String(), "alice", "bob", "")
+	wrappedUsdc := transfertypes.NewFungibleTokenPacketData("transfer/channel-7/uusdc", sdk.NewInt(100).String(), "alice", "bob", "")
+	newPacket := func(data transfertypes.FungibleTokenPacketData, destChannel string) channeltypes.Packet {
+		return channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-9", "transfer", destChannel, clienttypes.Height{}, 0)
+	}
+	allowlist := []tokenfilter.AllowedDenom{{BaseDenom: "uusdc", ChannelId: "channel-0"}}
+
+	testCases := []struct {
+		name       string
+		appVersion uint64
+		packet     channeltypes.Packet
+		allowed    bool
+		wantEvent  string
+	}{
+		{
+			name:       "allowlisted denom on allowlisted channel",
+			appVersion: 3,
+			packet:     newPacket(usdc, "channel-0"),
+			allowed:    true,
+			wantEvent:  tokenfilter.EventTypeDenomAllowed,
+		},
+		{
+			name:       "allowlisted denom on another channel",
+			appVersion: 3,
+			packet:     newPacket(usdc, "channel-1"),
+			allowed:    false,
+			wantEvent:  tokenfilter.EventTypeDenomDenied,
+		},
+		{
+			name:       "allowlisted base denom that is not native to the counterparty",
+			appVersion: 3,
+			packet:     newPacket(wrappedUsdc, "channel-0"),
+			allowed:    false,
+			wantEvent:  tokenfilter.EventTypeDenomDenied,
+		},
+		{
+			name:       "allowlist is ignored before app version 3",
+			appVersion: 2,
+			packet:     newPacket(usdc, "channel-0"),
+			allowed:    false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			module := &MockIBCModule{t: t, called: false}
+			keeper, ctx := createKeeper(t, tc.appVersion)
+			keeper.SetAllowlist(ctx, allowlist)
+			middleware := tokenfilter.NewIBCMiddleware(module, keeper)
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			ack := middleware.OnRecvPacket(ctx, tc.packet, []byte{})
+			require.Equal(t, tc.allowed, ack.Success())
+			require.Equal(t, tc.allowed, module.MethodCalled())
+
+			var eventTypes []string
+			for _, event := range ctx.EventManager().Events() {
+				eventTypes = append(eventTypes, event.Type)
+			}
+			if tc.wantEvent != "" {
+				require.Contains(t, eventTypes, tc.wantEvent)
+			} else {
+				require.NotContains(t, eventTypes, tokenfilter.EventTypeDenomAllowed)
+				require.NotContains(t, eventTypes, tokenfilter.EventTypeDenomDenied)
+			}
+		})
+	}
+}
+
 type MockIBCModule struct {
 	t      *testing.T
 	called bool
+	// allowPacketCallbacks opts in to calls to OnAcknowledgementPacket and
+	// OnTimeoutPacket which are otherwise unexpected.
+	allowPacketCallbacks bool
 }
 
 func (m *MockIBCModule) MethodCalled() bool {
@@ -156,7 +261,10 @@ func (m *MockIBCModule) OnAcknowledgementPacket(
 	_ []byte,
 	_ sdk.AccAddress,
 ) error {
-	m.t.Fatalf("unexpected call to OnAcknowledgementPacket")
+	if !m.allowPacketCallbacks {
+		m.t.Fatalf("unexpected call to OnAcknowledgementPacket")
+	}
+	m.called = true
 	return nil
 }
 
@@ -165,6 +273,9 @@ func (m *MockIBCModule) OnTimeoutPacket(
 	_ channeltypes.Packet,
 	_ sdk.AccAddress,
 ) error {
-	m.t.Fatalf("unexpected call to OnTimeoutPacket")
+	if !m.allowPacketCallbacks {
+		m.t.Fatalf("unexpected call to OnTimeoutPacket")
+	}
+	m.called = true
 	return nil
 }
@@ -1,18 +1,96 @@
 package tokenfilter
 
 import (
+	v2 "github.com/celestiaorg/celestia-app/v3/pkg/appconsts/v2"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
 	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
 )
 
-// Keeper is so far a noop as the tokenfilter doesn't have any need to
-// act as middleware for outgoing messages (only inbound ones).
+// StoreKey is the store key used to persist the transfer flows tracked for
+// rate limiting. It is only mounted from app version 3 onwards.
+const StoreKey = ModuleName
+
+// Keeper stores the governance controlled allowlist of non-native denoms and
+// the rate limits on transfers. It tracks outgoing transfers in SendPacket and
+// passes every other outgoing message straight to the wrapped ICS4Wrapper.
 type Keeper struct {
 	porttypes.ICS4Wrapper
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+	subspace paramtypes.Subspace
 }
 
 // NewKeeper creates a new tokenfilter Keeper instance.
-func NewKeeper(wrapper porttypes.ICS4Wrapper) Keeper {
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey storetypes.StoreKey,
+	wrapper porttypes.ICS4Wrapper,
+	subspace paramtypes.Subspace,
+) Keeper {
 	return Keeper{
 		ICS4Wrapper: wrapper,
+		cdc:         cdc,
+		storeKey:    storeKey,
+		subspace:    RegisterTokenFilterParamTable(subspace),
 	}
 }
+
+// GetAllowlist returns the non-native denoms that may be received. It returns
+// an empty list if none has been set.
+func (k Keeper) GetAllowlist(ctx sdk.Context) []AllowedDenom {
+	allowlist := []AllowedDenom{}
+	k.subspace.GetIfExists(ctx, KeyAllowlist, &allowlist)
+	return allowlist
+}
+
+// SetAllowlist sets the non-native denoms that may be received. It panics if
+// the allowlist is invalid.
+func (k Keeper) SetAllowlist(ctx sdk.Context, allowlist []AllowedDenom) {
+	if err := ValidateAllowlist(allowlist); err != nil {
+		panic(err)
+	}
+	k.subspace.Set(ctx, KeyAllowlist, allowlist)
+}
+
+// SendPacket implements the ICS4Wrapper interface. From app version 3
+// onwards, it records the amount of an outgoing transfer against the rate
+// limit of its channel and denom and rejects the transfer if the quota is
+// exceeded. The height at which a rate limited transfer is sent is recorded
+// so that its outflow can be refunded if the transfer fails.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	var packetData transfertypes.FungibleTokenPacketData
+	rateLimited := storeMounted(ctx) && transfertypes.ModuleCdc.UnmarshalJSON(data, &packetData) == nil
+	if rateLimited {
+		if err := k.RecordOutflow(ctx, sourceChannel, packetData.Denom, packetData.Amount); err != nil {
+			return 0, err
+		}
+	}
+	sequence, err := k.ICS4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+	if rateLimited {
+		k.RecordSentPacket(ctx, sourceChannel, sequence, packetData.Denom)
+	}
+	return sequence, nil
+}
+
+// storeMounted returns true if the tokenfilter store is mounted at the
+// app version of ctx.
+func storeMounted(ctx sdk.Context) bool {
+	return ctx.BlockHeader().Version.App > v2.Version
+}
@@ -0,0 +1,286 @@
+package tokenfilter
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+// KeyRateLimits is the param key of the transfer quotas.
+var KeyRateLimits = []byte("RateLimits")
+
+// flowKeyPrefix is the store key prefix for the flows of the current windows.
+// Flows are keyed by channel id and denom.
+var flowKeyPrefix = []byte{0x01}
+
+// blockFlowKeyPrefix is the store key prefix for the flows of the individual
+// blocks of the current windows. Block flows are keyed by channel id, denom
+// and height.
+var blockFlowKeyPrefix = []byte{0x02}
+
+// sentPacketKeyPrefix is the store key prefix for the heights at which rate
+// limited packets were sent. They are keyed by channel id and sequence.
+var sentPacketKeyPrefix = []byte{0x03}
+
+func flowKey(channelID, denom string) []byte {
+	return append(append([]byte{}, flowKeyPrefix...), []byte(channelID+"/"+denom)...)
+}
+
+func blockFlowsKey(channelID, denom string) []byte {
+	return append(append([]byte{}, blockFlowKeyPrefix...), address.MustLengthPrefix([]byte(channelID+"/"+denom))...)
+}
+
+func sentPacketKey(channelID string, sequence uint64) []byte {
+	key := append(append([]byte{}, sentPacketKeyPrefix...), address.MustLengthPrefix([]byte(channelID))...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// ValidateRateLimits validates the transfer quotas. Every channel id must be
+// valid, every denom must be a valid denom without a trace path, every window
+// must be positive, no quota may be negative and no channel and denom may
+// have more than one rate limit.
+func ValidateRateLimits(i interface{}) error {
+	rateLimits, ok := i.([]RateLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	type channelDenom struct{ channelID, denom string }
+	seen := make(map[channelDenom]struct{}, len(rateLimits))
+	for _, rateLimit := range rateLimits {
+		if err := host.ChannelIdentifierValidator(rateLimit.ChannelId); err != nil {
+			return err
+		}
+		if err := sdk.ValidateDenom(rateLimit.Denom); err != nil {
+			return err
+		}
+		if transfertypes.ParseDenomTrace(rateLimit.Denom).Path != "" {
+			return fmt.Errorf("rate limited denom %s must be a base denom without a trace path", rateLimit.Denom)
+		}
+		if rateLimit.Window == 0 {
+			return fmt.Errorf("window of the rate limit of %s on %s must be positive", rateLimit.Denom, rateLimit.ChannelId)
+		}
+		if rateLimit.MaxInflow.IsNil() || rateLimit.MaxInflow.IsNegative() {
+			return fmt.Errorf("max inflow of %s on %s cannot be negative: %s", rateLimit.Denom, rateLimit.ChannelId, rateLimit.MaxInflow)
+		}
+		if rateLimit.MaxOutflow.IsNil() || rateLimit.MaxOutflow.IsNegative() {
+			return fmt.Errorf("max outflow of %s on %s cannot be negative: %s", rateLimit.Denom, rateLimit.ChannelId, rateLimit.MaxOutflow)
+		}
+		key := channelDenom{rateLimit.ChannelId, rateLimit.Denom}
+		if _, exists := seen[key]; exists {
+			return fmt.Errorf("duplicate rate limit of %s on %s", rateLimit.Denom, rateLimit.ChannelId)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// GetRateLimits returns the transfer quotas. It returns an empty list if none
+// has been set.
+func (k Keeper) GetRateLimits(ctx sdk.Context) []RateLimit {
+	rateLimits := []RateLimit{}
+	k.subspace.GetIfExists(ctx, KeyRateLimits, &rateLimits)
+	return rateLimits
+}
+
+// SetRateLimits sets the transfer quotas. It panics if the rate limits are
+// invalid.
+func (k Keeper) SetRateLimits(ctx sdk.Context, rateLimits []RateLimit) {
+	if err := ValidateRateLimits(rateLimits); err != nil {
+		panic(err)
+	}
+	k.subspace.Set(ctx, KeyRateLimits, rateLimits)
+}
+
+// GetRateLimit returns the rate limit of channelID and denom.
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (RateLimit, bool) {
+	for _, rateLimit := range k.GetRateLimits(ctx) {
+		if rateLimit.ChannelId == channelID && rateLimit.Denom == denom {
+			return rateLimit, true
+		}
+	}
+	return RateLimit{}, false
+}
+
+// GetCurrentFlow returns the flow of the current window of rateLimit, which
+// consists of the last Window blocks including the current one.
+func (k Keeper) GetCurrentFlow(ctx sdk.Context, rateLimit RateLimit) Flow {
+	return k.currentFlow(ctx, rateLimit, false)
+}
+
+// currentFlow returns the flow of the current window of rateLimit. The stored
+// flow still contains the block flows that have fallen out of the window since
+// it was last updated, so they are subtracted from it. If prune is true, those
+// block flows are deleted.
+func (k Keeper) currentFlow(ctx sdk.Context, rateLimit RateLimit, prune bool) Flow {
+	flow := Flow{
+		ChannelId: rateLimit.ChannelId,
+		Denom:     rateLimit.Denom,
+		Inflow:    math.ZeroInt(),
+		Outflow:   math.ZeroInt(),
+	}
+	if bz := ctx.KVStore(k.storeKey).Get(flowKey(rateLimit.ChannelId, rateLimit.Denom)); bz != nil {
+		k.cdc.MustUnmarshal(bz, &flow)
+	}
+	flow.WindowStartHeight = ctx.BlockHeight() - int64(rateLimit.Window) + 1
+	if flow.WindowStartHeight <= 0 {
+		return flow
+	}
+
+	store := k.blockFlowStore(ctx, rateLimit.ChannelId, rateLimit.Denom)
+	iterator := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(flow.WindowStartHeight)))
+	var expired [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var blockFlow Flow
+		k.cdc.MustUnmarshal(iterator.Value(), &blockFlow)
+		flow.Inflow = flow.Inflow.Sub(blockFlow.Inflow)
+		flow.Outflow = flow.Outflow.Sub(blockFlow.Outflow)
+		expired = append(expired, iterator.Key())
+	}
+	iterator.Close()
+	if prune {
+		for _, key := range expired {
+			store.Delete(key)
+		}
+	}
+	return flow
+}
+
+// getBlockFlow returns the flow of channelID and denom in the block at height.
+func (k Keeper) getBlockFlow(ctx sdk.Context, channelID, denom string, height int64) Flow {
+	bz := k.blockFlowStore(ctx, channelID, denom).Get(sdk.Uint64ToBigEndian(uint64(height)))
+	if bz == nil {
+		return Flow{
+			ChannelId:         channelID,
+			Denom:             denom,
+			WindowStartHeight: height,
+			Inflow:            math.ZeroInt(),
+			Outflow:           math.ZeroInt(),
+		}
+	}
+	var blockFlow Flow
+	k.cdc.MustUnmarshal(bz, &blockFlow)
+	return blockFlow
+}
+
+// setFlows stores the flow of the current window and the flow of the block at
+// blockFlow.WindowStartHeight.
+func (k Keeper) setFlows(ctx sdk.Context, flow, blockFlow Flow) {
+	ctx.KVStore(k.storeKey).Set(flowKey(flow.ChannelId, flow.Denom), k.cdc.MustMarshal(&flow))
+	k.blockFlowStore(ctx, blockFlow.ChannelId, blockFlow.Denom).Set(sdk.Uint64ToBigEndian(uint64(blockFlow.WindowStartHeight)), k.cdc.MustMarshal(&blockFlow))
+}
+
+// blockFlowStore returns a prefix store over the block flows of channelID and
+// denom.
+func (k Keeper) blockFlowStore(ctx sdk.Context, channelID, denom string) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), blockFlowsKey(channelID, denom))
+}
+
+// RecordInflow records amount of denom, as it appears in a packet received on
+// channelID, against the rate limit of the channel and the base denom. It
+// returns ErrRateLimitExceeded, without recording the amount, if the inflow
+// of the current window would exceed the quota.
+func (k Keeper) RecordInflow(ctx sdk.Context, channelID, denom, amount string) error {
+	return k.recordFlow(ctx, channelID, denom, amount, true)
+}
+
+// RecordOutflow records amount of denom, as it appears in a packet sent on
+// channelID, against the rate limit of the channel and the base denom. It
+// returns ErrRateLimitExceeded, without recording the amount, if the outflow
+// of the current window would exceed the quota.
+func (k Keeper) RecordOutflow(ctx sdk.Context, channelID, denom, amount string) error {
+	return k.recordFlow(ctx, channelID, denom, amount, false)
+}
+
+func (k Keeper) recordFlow(ctx sdk.Context, channelID, denom, amount string, inbound bool) error {
+	baseDenom := transfertypes.ParseDenomTrace(denom).BaseDenom
+	rateLimit, found := k.GetRateLimit(ctx, channelID, baseDenom)
+	if !found {
+		return nil
+	}
+	value, ok := math.NewIntFromString(amount)
+	if !ok {
+		return errors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount %s", amount)
+	}
+
+	flow := k.currentFlow(ctx, rateLimit, true)
+	blockFlow := k.getBlockFlow(ctx, channelID, baseDenom, ctx.BlockHeight())
+	if inbound {
+		flow.Inflow = flow.Inflow.Add(value)
+		if rateLimit.MaxInflow.IsPositive() && flow.Inflow.GT(rateLimit.MaxInflow) {
+			return errors.Wrapf(ErrRateLimitExceeded, "inflow of %s on %s would be %s, quota is %s", baseDenom, channelID, flow.Inflow, rateLimit.MaxInflow)
+		}
+		blockFlow.Inflow = blockFlow.Inflow.Add(value)
+	} else {
+		flow.Outflow = flow.Outflow.Add(value)
+		if rateLimit.MaxOutflow.IsPositive() && flow.Outflow.GT(rateLimit.MaxOutflow) {
+			return errors.Wrapf(ErrRateLimitExceeded, "outflow of %s on %s would be %s, quota is %s", baseDenom, channelID, flow.Outflow, rateLimit.MaxOutflow)
+		}
+		blockFlow.Outflow = blockFlow.Outflow.Add(value)
+	}
+	k.setFlows(ctx, flow, blockFlow)
+	return nil
+}
+
+// RecordSentPacket stores the current height as the height at which the packet
+// with sequence was sent on channelID so that its outflow can be refunded if
+// the packet fails. It is a no-op if denom, as it appears in the packet, isn't
+// rate limited on channelID.
+func (k Keeper) RecordSentPacket(ctx sdk.Context, channelID string, sequence uint64, denom string) {
+	if _, found := k.GetRateLimit(ctx, channelID, transfertypes.ParseDenomTrace(denom).BaseDenom); !found {
+		return
+	}
+	ctx.KVStore(k.storeKey).Set(sentPacketKey(channelID, sequence), sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight())))
+}
+
+// DeleteSentPacket deletes the record of the packet with sequence sent on
+// channelID and returns the height at which it was sent, if it was recorded.
+func (k Keeper) DeleteSentPacket(ctx sdk.Context, channelID string, sequence uint64) (int64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := sentPacketKey(channelID, sequence)
+	bz := store.Get(key)
+	if bz == nil {
+		return 0, false
+	}
+	store.Delete(key)
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// RefundOutflow removes amount of denom, as it appears in the packet with
+// sequence sent on channelID, from the outflow of the block that the packet
+// was sent in. It is called when the packet times out or is rejected by the
+// counterparty. Nothing is refunded if the block has already fallen out of the
+// window.
+func (k Keeper) RefundOutflow(ctx sdk.Context, channelID string, sequence uint64, denom, amount string) error {
+	height, found := k.DeleteSentPacket(ctx, channelID, sequence)
+	if !found {
+		return nil
+	}
+	baseDenom := transfertypes.ParseDenomTrace(denom).BaseDenom
+	rateLimit, found := k.GetRateLimit(ctx, channelID, baseDenom)
+	if !found {
+		return nil
+	}
+	value, ok := math.NewIntFromString(amount)
+	if !ok {
+		return errors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount %s", amount)
+	}
+
+	flow := k.currentFlow(ctx, rateLimit, true)
+	if height < flow.WindowStartHeight {
+		return nil
+	}
+	blockFlow := k.getBlockFlow(ctx, channelID, baseDenom, height)
+	// the rate limit may have been replaced since the packet was sent, so the
+	// refund is capped at the outflow that is still recorded.
+	value = math.MinInt(value, blockFlow.Outflow)
+	blockFlow.Outflow = blockFlow.Outflow.Sub(value)
+	flow.Outflow = flow.Outflow.Sub(value)
+	k.setFlows(ctx, flow, blockFlow)
+	return nil
+}
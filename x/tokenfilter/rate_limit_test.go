@@ -0,0 +1,278 @@
+package tokenfilter_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	"github.com/celestiaorg/celestia-app/v3/x/tokenfilter"
+)
+
+func TestValidateRateLimits(t *testing.T) {
+	valid := tokenfilter.RateLimit{ChannelId: "channel-0", Denom: "utia", MaxInflow: math.NewInt(100), MaxOutflow: math.ZeroInt(), Window: 10}
+	modify := func(f func(*tokenfilter.RateLimit)) []tokenfilter.RateLimit {
+		rateLimit := valid
+		f(&rateLimit)
+		return []tokenfilter.RateLimit{rateLimit}
+	}
+
+	testCases := []struct {
+		name       string
+		rateLimits []tokenfilter.RateLimit
+		wantErr    bool
+	}{
+		{name: "empty", rateLimits: []tokenfilter.RateLimit{}},
+		{name: "valid", rateLimits: []tokenfilter.RateLimit{valid}},
+		{name: "invalid channel", rateLimits: modify(func(r *tokenfilter.RateLimit) { r.ChannelId = "ch" }), wantErr: true},
+		{name: "denom with a trace path", rateLimits: modify(func(r *tokenfilter.RateLimit) { r.Denom = "transfer/channel-1/uusdc" }), wantErr: true},
+		{name: "zero window", rateLimits: modify(func(r *tokenfilter.RateLimit) { r.Window = 0 }), wantErr: true},
+		{name: "negative max inflow", rateLimits: modify(func(r *tokenfilter.RateLimit) { r.MaxInflow = math.NewInt(-1) }), wantErr: true},
+		{name: "nil max outflow", rateLimits: modify(func(r *tokenfilter.RateLimit) { r.MaxOutflow = math.Int{} }), wantErr: true},
+		{name: "duplicate", rateLimits: []tokenfilter.RateLimit{valid, valid}, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tokenfilter.ValidateRateLimits(tc.rateLimits)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRecordFlow(t *testing.T) {
+	keeper, ctx := createKeeper(t, 3)
+	ctx = ctx.WithBlockHeight(10)
+	keeper.SetRateLimits(ctx, []tokenfilter.RateLimit{
+		{ChannelId: "channel-0", Denom: "utia", MaxInflow: math.NewInt(100), MaxOutflow: math.NewInt(50), Window: 5},
+	})
+
+	// flows of other channels and denoms are not limited
+	require.NoError(t, keeper.RecordInflow(ctx, "channel-1", "utia", "1000"))
+	require.NoError(t, keeper.RecordInflow(ctx, "channel-0", "uusdc", "1000"))
+
+	require.NoError(t, keeper.RecordInflow(ctx, "channel-0", "transfer/channel-7/utia", "60"))
+	require.NoError(t, keeper.RecordInflow(ctx, "channel-0", "utia", "40"))
+	err := keeper.RecordInflow(ctx, "channel-0", "utia", "1")
+	require.True(t, errors.IsOf(err, tokenfilter.ErrRateLimitExceeded))
+
+	require.NoError(t, keeper.RecordOutflow(ctx, "channel-0", "utia", "50"))
+	err = keeper.RecordOutflow(ctx, "channel-0", "utia", "1")
+	require.True(t, errors.IsOf(err, tokenfilter.ErrRateLimitExceeded))
+	require.Error(t, keeper.RecordOutflow(ctx, "channel-0", "utia", "invalid"))
+
+	rateLimit, found := keeper.GetRateLimit(ctx, "channel-0", "utia")
+	require.True(t, found)
+	flow := keeper.GetCurrentFlow(ctx, rateLimit)
+	require.Equal(t, int64(6), flow.WindowStartHeight)
+	require.Equal(t, math.NewInt(100), flow.Inflow)
+	require.Equal(t, math.NewInt(50), flow.Outflow)
+
+	// the flows of a block stop counting once it has fallen out of the window
+	ctx = ctx.WithBlockHeight(14)
+	require.Error(t, keeper.RecordInflow(ctx, "channel-0", "utia", "1"))
+	ctx = ctx.WithBlockHeight(15)
+	require.NoError(t, keeper.RecordInflow(ctx, "channel-0", "utia", "1"))
+	flow = keeper.GetCurrentFlow(ctx, rateLimit)
+	require.Equal(t, int64(11), flow.WindowStartHeight)
+	require.Equal(t, math.NewInt(1), flow.Inflow)
+	require.Equal(t, math.ZeroInt(), flow.Outflow)
+}
+
+func TestRecordFlowRollingWindow(t *testing.T) {
+	keeper, ctx := createKeeper(t, 3)
+	rateLimit := tokenfilter.RateLimit{ChannelId: "channel-0", Denom: "utia", MaxInflow: math.NewInt(100), MaxOutflow: math.ZeroInt(), Window: 10}
+	keeper.SetRateLimits(ctx, []tokenfilter.RateLimit{rateLimit})
+
+	// the quota is used up at the end of one window
+	require.NoError(t, keeper.RecordInflow(ctx.WithBlockHeight(9), "channel-0", "utia", "100"))
+
+	// and can't be used again at the start of the next one
+	for height := int64(10); height < 19; height++ {
+		err := keeper.RecordInflow(ctx.WithBlockHeight(height), "channel-0", "utia", "1")
+		require.True(t, errors.IsOf(err, tokenfilter.ErrRateLimitExceeded), "height %d", height)
+	}
+
+	// the quota frees up once the block has fallen out of the window
+	require.NoError(t, keeper.RecordInflow(ctx.WithBlockHeight(19), "channel-0", "utia", "60"))
+	require.NoError(t, keeper.RecordInflow(ctx.WithBlockHeight(20), "channel-0", "utia", "40"))
+	require.Error(t, keeper.RecordInflow(ctx.WithBlockHeight(28), "channel-0", "utia", "1"))
+	require.NoError(t, keeper.RecordInflow(ctx.WithBlockHeight(29), "channel-0", "utia", "60"))
+	require.Error(t, keeper.RecordInflow(ctx.WithBlockHeight(29), "channel-0", "utia", "1"))
+
+	flow := keeper.GetCurrentFlow(ctx.WithBlockHeight(30), rateLimit)
+	require.Equal(t, int64(21), flow.WindowStartHeight)
+	require.Equal(t, math.NewInt(60), flow.Inflow)
+}
+
+func TestRefundOutflow(t *testing.T) {
+	rateLimit := tokenfilter.RateLimit{ChannelId: "channel-0", Denom: "utia", MaxInflow: math.ZeroInt(), MaxOutflow: math.NewInt(50), Window: 5}
+	data := transfertypes.NewFungibleTokenPacketData("utia", "30", "alice", "bob", "").GetBytes()
+	send := func(keeper tokenfilter.Keeper, ctx sdk.Context) (channeltypes.Packet, error) {
+		sequence, err := keeper.SendPacket(ctx, nil, "transfer", "channel-0", clienttypes.Height{}, 0, data)
+		return channeltypes.NewPacket(data, sequence, "transfer", "channel-0", "transfer", "channel-9", clienttypes.Height{}, 0), err
+	}
+	errorAck := channeltypes.NewErrorAcknowledgement(tokenfilter.ErrRateLimitExceeded).Acknowledgement()
+	successAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement()
+
+	keeper, ctx := createKeeper(t, 3)
+	ctx = ctx.WithBlockHeight(10)
+	keeper.SetRateLimits(ctx, []tokenfilter.RateLimit{rateLimit})
+	module := &MockIBCModule{t: t, allowPacketCallbacks: true}
+	middleware := tokenfilter.NewIBCMiddleware(module, keeper)
+
+	t.Run("timeout", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		packet, err := send(keeper, ctx)
+		require.NoError(t, err)
+		_, err = send(keeper, ctx)
+		require.Error(t, err)
+
+		require.NoError(t, middleware.OnTimeoutPacket(ctx, packet, nil))
+		require.True(t, module.MethodCalled())
+		require.Equal(t, math.ZeroInt(), keeper.GetCurrentFlow(ctx, rateLimit).Outflow)
+		_, err = send(keeper, ctx)
+		require.NoError(t, err)
+
+		// the outflow is only refunded once
+		require.NoError(t, middleware.OnTimeoutPacket(ctx, packet, nil))
+		require.Equal(t, math.NewInt(30), keeper.GetCurrentFlow(ctx, rateLimit).Outflow)
+	})
+
+	t.Run("error acknowledgement", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		packet, err := send(keeper, ctx)
+		require.NoError(t, err)
+		require.NoError(t, middleware.OnAcknowledgementPacket(ctx, packet, errorAck, nil))
+		require.Equal(t, math.ZeroInt(), keeper.GetCurrentFlow(ctx, rateLimit).Outflow)
+	})
+
+	t.Run("success acknowledgement", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		packet, err := send(keeper, ctx)
+		require.NoError(t, err)
+		require.NoError(t, middleware.OnAcknowledgementPacket(ctx, packet, successAck, nil))
+		require.Equal(t, math.NewInt(30), keeper.GetCurrentFlow(ctx, rateLimit).Outflow)
+		_, found := keeper.DeleteSentPacket(ctx, "channel-0", packet.GetSequence())
+		require.False(t, found)
+	})
+
+	t.Run("block outside the window", func(t *testing.T) {
+		ctx, _ := ctx.CacheContext()
+		packet, err := send(keeper, ctx)
+		require.NoError(t, err)
+		ctx = ctx.WithBlockHeight(15)
+		_, err = send(keeper, ctx)
+		require.NoError(t, err)
+
+		require.NoError(t, middleware.OnTimeoutPacket(ctx, packet, nil))
+		require.Equal(t, math.NewInt(30), keeper.GetCurrentFlow(ctx, rateLimit).Outflow)
+	})
+}
+
+func TestSendPacketRateLimit(t *testing.T) {
+	data := transfertypes.NewFungibleTokenPacketData("utia", "30", "alice", "bob", "").GetBytes()
+	send := func(keeper tokenfilter.Keeper, ctx sdk.Context) error {
+		_, err := keeper.SendPacket(ctx, nil, "transfer", "channel-0", clienttypes.Height{}, 0, data)
+		return err
+	}
+	rateLimits := []tokenfilter.RateLimit{
+		{ChannelId: "channel-0", Denom: "utia", MaxInflow: math.ZeroInt(), MaxOutflow: math.NewInt(50), Window: 5},
+	}
+
+	keeper, ctx := createKeeper(t, 3)
+	keeper.SetRateLimits(ctx, rateLimits)
+	require.NoError(t, send(keeper, ctx))
+	err := send(keeper, ctx)
+	require.True(t, errors.IsOf(err, tokenfilter.ErrRateLimitExceeded))
+	require.Equal(t, 1, keeper.ICS4Wrapper.(*MockICS4Wrapper).sent)
+
+	// rate limits are not enforced before app version 3
+	keeper, ctx = createKeeper(t, 2)
+	keeper.SetRateLimits(ctx, rateLimits)
+	require.NoError(t, send(keeper, ctx))
+	require.NoError(t, send(keeper, ctx))
+	require.Equal(t, 2, keeper.ICS4Wrapper.(*MockICS4Wrapper).sent)
+}
+
+func TestOnRecvPacketRateLimit(t *testing.T) {
+	data := transfertypes.NewFungibleTokenPacketData("transfer/channel-9/utia", "30", "alice", "bob", "")
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-9", "transfer", "channel-0", clienttypes.Height{}, 0)
+
+	keeper, ctx := createKeeper(t, 3)
+	keeper.SetRateLimits(ctx, []tokenfilter.RateLimit{
+		{ChannelId: "channel-0", Denom: "utia", MaxInflow: math.NewInt(50), MaxOutflow: math.ZeroInt(), Window: 5},
+	})
+	module := &MockIBCModule{t: t}
+	middleware := tokenfilter.NewIBCMiddleware(module, keeper)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.True(t, middleware.OnRecvPacket(ctx, packet, []byte{}).Success())
+	require.True(t, module.MethodCalled())
+
+	module.called = false
+	ack := middleware.OnRecvPacket(ctx, packet, []byte{})
+	require.False(t, ack.Success())
+	require.False(t, module.MethodCalled())
+	events := ctx.EventManager().Events()
+	require.Equal(t, tokenfilter.EventTypeRateLimited, events[len(events)-1].Type)
+}
+
+type MockICS4Wrapper struct {
+	sent int
+}
+
+func (m *MockICS4Wrapper) SendPacket(
+	_ sdk.Context,
+	_ *capabilitytypes.Capability,
+	_ string,
+	_ string,
+	_ clienttypes.Height,
+	_ uint64,
+	_ []byte,
+) (uint64, error) {
+	m.sent++
+	return uint64(m.sent), nil
+}
+
+func (m *MockICS4Wrapper) WriteAcknowledgement(
+	_ sdk.Context,
+	_ *capabilitytypes.Capability,
+	_ exported.PacketI,
+	_ exported.Acknowledgement,
+) error {
+	return nil
+}
+
+func (m *MockICS4Wrapper) GetAppVersion(_ sdk.Context, _, _ string) (string, bool) {
+	return "", false
+}
+
+func TestQueryRateLimits(t *testing.T) {
+	keeper, ctx := createKeeper(t, 3)
+	queryServer := tokenfilter.NewQueryServerImpl(keeper)
+	rateLimit := tokenfilter.RateLimit{ChannelId: "channel-0", Denom: "utia", MaxInflow: math.NewInt(50), MaxOutflow: math.ZeroInt(), Window: 5}
+	keeper.SetRateLimits(ctx, []tokenfilter.RateLimit{rateLimit})
+	require.NoError(t, keeper.RecordInflow(ctx, "channel-0", "utia", "20"))
+
+	resp, err := queryServer.RateLimits(sdk.WrapSDKContext(ctx), &tokenfilter.QueryRateLimits{})
+	require.NoError(t, err)
+	require.Len(t, resp.RateLimits, 1)
+	require.Equal(t, rateLimit, resp.RateLimits[0].RateLimit)
+	require.Equal(t, math.NewInt(20), resp.RateLimits[0].Flow.Inflow)
+
+	_, ctx = createKeeper(t, 2)
+	_, err = queryServer.RateLimits(sdk.WrapSDKContext(ctx), &tokenfilter.QueryRateLimits{})
+	require.Error(t, err)
+}